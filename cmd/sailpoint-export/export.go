@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/access_profile"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/form_definition"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/identity_profile"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/launcher"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/lifecycle_state"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/role"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/segment"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/source"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/transform"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/workflow"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// providerTypeName is the type name prefix of every resource of the provider.
+const providerTypeName = "sailpoint"
+
+// objectType describes an object type the command exports, through the resource and
+// list resource the provider already implements for it.
+type objectType struct {
+	// name is the resource type name without the provider prefix, which also names
+	// the generated file.
+	name        string
+	newResource func() resource.Resource
+	newList     func() list.ListResource
+	// perIdentityProfile is set for object types listed once per identity profile.
+	perIdentityProfile bool
+	// importIDAttributes are the identity attributes joined with "/" to build the
+	// import ID.
+	importIDAttributes []string
+}
+
+// objectTypes are the exported object types, in the order they are crawled. Identity
+// profiles come before lifecycle states, which are listed per identity profile.
+var objectTypes = []objectType{
+	{name: "source", newResource: source.NewSourceResource, newList: source.NewSourceListResource},
+	{name: "transform", newResource: transform.NewTransformResource, newList: transform.NewTransformListResource},
+	{name: "access_profile", newResource: access_profile.NewAccessProfileResource, newList: access_profile.NewAccessProfileListResource},
+	{name: "role", newResource: role.NewRoleResource, newList: role.NewRoleListResource},
+	{name: "segment", newResource: segment.NewSegmentResource, newList: segment.NewSegmentListResource},
+	{name: "identity_profile", newResource: identity_profile.NewIdentityProfileResource, newList: identity_profile.NewIdentityProfileListResource},
+	{
+		name:               "lifecycle_state",
+		newResource:        lifecycle_state.NewLifecycleStateResource,
+		newList:            lifecycle_state.NewLifecycleStateListResource,
+		perIdentityProfile: true,
+		importIDAttributes: []string{"identity_profile_id", "id"},
+	},
+	{name: "workflow", newResource: workflow.NewWorkflowResource, newList: workflow.NewWorkflowListResource},
+	{name: "launcher", newResource: launcher.NewLauncherResource, newList: launcher.NewLauncherListResource},
+	{name: "form_definition", newResource: form_definition.NewFormDefinitionResource, newList: form_definition.NewFormDefinitionListResource},
+}
+
+// lookupObjectType returns the object type with the given name.
+func lookupObjectType(name string) (*objectType, bool) {
+	for i := range objectTypes {
+		if objectTypes[i].name == name {
+			return &objectTypes[i], true
+		}
+	}
+	return nil, false
+}
+
+// exportedObject is an object crawled from the tenant, with its state as the resource
+// would store it after an import.
+type exportedObject struct {
+	objectType *objectType
+	id         string
+	importID   string
+	name       string
+	// parentID is the ID of the identity profile of a per-identity-profile object.
+	parentID string
+	// label is the resource name of the object in the generated configuration.
+	label  string
+	schema schema.Schema
+	state  tftypes.Value
+}
+
+// address returns the resource address of the object in the generated configuration.
+func (o *exportedObject) address() string {
+	return providerTypeName + "_" + o.objectType.name + "." + o.label
+}
+
+// exporter crawls a tenant through the list resources of the provider.
+type exporter struct {
+	client *client.Client
+}
+
+// crawl lists every object of the given type. Per-identity-profile types are listed
+// once for each of the given identity profiles.
+func (e *exporter) crawl(ctx context.Context, t *objectType, identityProfiles []*exportedObject) ([]*exportedObject, error) {
+	if !t.perIdentityProfile {
+		return e.list(ctx, t, nil)
+	}
+
+	var objects []*exportedObject
+	for _, profile := range identityProfiles {
+		items, err := e.list(ctx, t, map[string]tftypes.Value{
+			"identity_profile_id": tftypes.NewValue(tftypes.String, profile.id),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			item.parentID = profile.id
+		}
+		objects = append(objects, items...)
+	}
+	return objects, nil
+}
+
+// list runs the list resource of the given type with the given configuration, leaving
+// the attributes missing from it null, and returns the listed objects.
+func (e *exporter) list(ctx context.Context, t *objectType, config map[string]tftypes.Value) ([]*exportedObject, error) {
+	r := t.newResource()
+	lr := t.newList()
+
+	var configureResp resource.ConfigureResponse
+	lr.(list.ListResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: e.client}, &configureResp)
+	if err := diagnosticsError(configureResp.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp resource.IdentitySchemaResponse
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+	var listSchemaResp list.ListResourceSchemaResponse
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &listSchemaResp)
+	if err := diagnosticsError(append(append(schemaResp.Diagnostics, identitySchemaResp.Diagnostics...), listSchemaResp.Diagnostics...)); err != nil {
+		return nil, err
+	}
+
+	configType := listSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	configValues := make(map[string]tftypes.Value, len(configType.AttributeTypes))
+	for name, typ := range configType.AttributeTypes {
+		configValues[name] = tftypes.NewValue(typ, nil)
+		if v, ok := config[name]; ok {
+			configValues[name] = v
+		}
+	}
+
+	req := list.ListRequest{
+		Config: tfsdk.Config{
+			Raw:    tftypes.NewValue(configType, configValues),
+			Schema: listSchemaResp.Schema,
+		},
+		IncludeResource:        true,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}
+	stream := &list.ListResultsStream{}
+	lr.List(ctx, req, stream)
+	if stream.Results == nil {
+		return nil, nil
+	}
+
+	var objects []*exportedObject
+	for result := range stream.Results {
+		if err := diagnosticsError(result.Diagnostics); err != nil {
+			return nil, fmt.Errorf("listing %s objects: %w", t.name, err)
+		}
+
+		identity, err := stringAttributes(result.Identity.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("reading the identity of %s %q: %w", t.name, result.DisplayName, err)
+		}
+
+		importIDAttributes := t.importIDAttributes
+		if len(importIDAttributes) == 0 {
+			importIDAttributes = []string{"id"}
+		}
+		importID := make([]string, 0, len(importIDAttributes))
+		for _, name := range importIDAttributes {
+			importID = append(importID, identity[name])
+		}
+
+		objects = append(objects, &exportedObject{
+			objectType: t,
+			id:         identity["id"],
+			importID:   strings.Join(importID, "/"),
+			name:       result.DisplayName,
+			schema:     schemaResp.Schema,
+			state:      result.Resource.Raw,
+		})
+	}
+	return objects, nil
+}
+
+// stringAttributes returns the known string attributes of an object value.
+func stringAttributes(v tftypes.Value) (map[string]string, error) {
+	var values map[string]tftypes.Value
+	if err := v.As(&values); err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]string, len(values))
+	for name, value := range values {
+		if !value.Type().Is(tftypes.String) || !value.IsKnown() || value.IsNull() {
+			continue
+		}
+		var s string
+		if err := value.As(&s); err != nil {
+			return nil, err
+		}
+		attributes[name] = s
+	}
+	return attributes, nil
+}
+
+// diagnosticsError returns the error diagnostics as an error, or nil when there are none.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+	return errors.Join(errs...)
+}
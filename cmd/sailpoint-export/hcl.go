@@ -0,0 +1,441 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// fileHeader is written at the top of every generated file.
+const fileHeader = "# Generated by sailpoint-export. Run `terraform plan` to check that it matches the tenant.\n\n"
+
+// resourceLabel turns an object name into a valid resource name: lowercase letters,
+// digits and underscores, never starting with a digit.
+func resourceLabel(name string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if underscore && b.Len() > 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(r)
+			underscore = false
+			continue
+		}
+		underscore = true
+	}
+
+	label := b.String()
+	if label == "" {
+		return "unnamed"
+	}
+	if label[0] >= '0' && label[0] <= '9' {
+		return "_" + label
+	}
+	return label
+}
+
+// assignLabels sets the resource name of the objects of a type, adding a numeric suffix
+// to names already taken. Per-identity-profile objects are prefixed with the resource
+// name of their identity profile.
+func assignLabels(objects []*exportedObject, parentLabels map[string]string) {
+	used := make(map[string]bool, len(objects))
+	for _, o := range objects {
+		base := resourceLabel(o.name)
+		if parent, ok := parentLabels[o.parentID]; ok && o.parentID != "" {
+			base = parent + "_" + base
+		}
+
+		label := base
+		for i := 2; used[label]; i++ {
+			label = fmt.Sprintf("%s_%d", base, i)
+		}
+		used[label] = true
+		o.label = label
+	}
+}
+
+// references maps the values other objects refer to exported objects by, to the
+// address of the exported object.
+type references struct {
+	ids            map[string]string
+	sourceNames    map[string]string
+	transformNames map[string]string
+}
+
+// newReferences indexes the exported objects. Names shared by several objects are left
+// out, as they can't be resolved to a single object.
+func newReferences(objects []*exportedObject) *references {
+	refs := &references{
+		ids:            make(map[string]string, len(objects)),
+		sourceNames:    map[string]string{},
+		transformNames: map[string]string{},
+	}
+
+	counts := map[string]int{}
+	for _, o := range objects {
+		refs.ids[o.id] = o.address()
+
+		switch o.objectType.name {
+		case "source":
+			refs.sourceNames[o.name] = o.address()
+		case "transform":
+			refs.transformNames[o.name] = o.address()
+		default:
+			continue
+		}
+		counts[o.objectType.name+"/"+o.name]++
+	}
+
+	for name := range refs.sourceNames {
+		if counts["source/"+name] > 1 {
+			delete(refs.sourceNames, name)
+		}
+	}
+	for name := range refs.transformNames {
+		if counts["transform/"+name] > 1 {
+			delete(refs.transformNames, name)
+		}
+	}
+	return refs
+}
+
+// renderer renders the state of exported objects as configuration, rewriting the IDs and
+// names of other exported objects into references to them.
+type renderer struct {
+	refs *references
+	// self is the address of the object being rendered, which never refers to itself.
+	self string
+}
+
+// renderFile renders the resource and import blocks of the objects.
+func renderFile(ctx context.Context, refs *references, objects []*exportedObject) ([]byte, error) {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	for i, o := range objects {
+		if i > 0 {
+			body.AppendNewline()
+		}
+
+		r := &renderer{refs: refs, self: o.address()}
+		var values map[string]tftypes.Value
+		if err := o.state.As(&values); err != nil {
+			return nil, fmt.Errorf("reading the state of %s: %w", o.address(), err)
+		}
+		attrs, err := r.attributes(ctx, o.schema.Attributes, values)
+		if err != nil {
+			return nil, fmt.Errorf("rendering %s: %w", o.address(), err)
+		}
+
+		block := body.AppendNewBlock("resource", []string{providerTypeName + "_" + o.objectType.name, o.label})
+		for _, attr := range attrs {
+			block.Body().SetAttributeRaw(string(attr.Name.Bytes()), attr.Value)
+		}
+
+		body.AppendNewline()
+		importBlock := body.AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeRaw("to", traversalTokens(o.address()))
+		importBlock.Body().SetAttributeValue("id", cty.StringVal(o.importID))
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(fileHeader)
+	buf.Write(hclwrite.Format(f.Bytes()))
+	return buf.Bytes(), nil
+}
+
+// attributes renders the configurable attributes with a known, non-null value, in
+// alphabetical order. Computed-only attributes are left out, as they can't be configured.
+func (r *renderer) attributes(ctx context.Context, attrs map[string]schema.Attribute, values map[string]tftypes.Value) ([]hclwrite.ObjectAttrTokens, error) {
+	names := make([]string, 0, len(attrs))
+	for name, attr := range attrs {
+		if attr.IsRequired() || attr.IsOptional() {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	// A transform of type "reference" names the transform it refers to in its attributes.
+	transformReference := stringValue(values["type"]) == "reference"
+
+	var tokens []hclwrite.ObjectAttrTokens
+	for _, name := range names {
+		v, ok := values[name]
+		if !ok || !v.IsKnown() || v.IsNull() || attrs[name].IsWriteOnly() {
+			continue
+		}
+
+		value, err := r.attribute(ctx, attrs[name], v, name == "attributes" && transformReference)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		tokens = append(tokens, hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForIdentifier(name), Value: value})
+	}
+	return tokens, nil
+}
+
+// attribute renders the value of an attribute, following the schema of nested attributes.
+func (r *renderer) attribute(ctx context.Context, attr schema.Attribute, v tftypes.Value, transformReference bool) (hclwrite.Tokens, error) {
+	switch a := attr.(type) {
+	case schema.SingleNestedAttribute:
+		return r.object(ctx, a.Attributes, v)
+	case schema.ListNestedAttribute:
+		return r.objects(ctx, a.NestedObject.Attributes, v)
+	case schema.SetNestedAttribute:
+		return r.objects(ctx, a.NestedObject.Attributes, v)
+	case schema.StringAttribute:
+		if isJSON(ctx, a) {
+			return r.json(v, transformReference)
+		}
+	}
+	return r.value(v)
+}
+
+// object renders a nested object.
+func (r *renderer) object(ctx context.Context, attrs map[string]schema.Attribute, v tftypes.Value) (hclwrite.Tokens, error) {
+	var values map[string]tftypes.Value
+	if err := v.As(&values); err != nil {
+		return nil, err
+	}
+	tokens, err := r.attributes(ctx, attrs, values)
+	if err != nil {
+		return nil, err
+	}
+	return hclwrite.TokensForObject(tokens), nil
+}
+
+// objects renders a list or set of nested objects.
+func (r *renderer) objects(ctx context.Context, attrs map[string]schema.Attribute, v tftypes.Value) (hclwrite.Tokens, error) {
+	var elems []tftypes.Value
+	if err := v.As(&elems); err != nil {
+		return nil, err
+	}
+	tokens := make([]hclwrite.Tokens, 0, len(elems))
+	for _, elem := range elems {
+		object, err := r.object(ctx, attrs, elem)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, object)
+	}
+	return hclwrite.TokensForTuple(tokens), nil
+}
+
+// value renders a value without a nested schema.
+func (r *renderer) value(v tftypes.Value) (hclwrite.Tokens, error) {
+	if v.IsNull() {
+		return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType)), nil
+	}
+
+	switch typ := v.Type(); {
+	case typ.Is(tftypes.String):
+		var s string
+		if err := v.As(&s); err != nil {
+			return nil, err
+		}
+		return r.string(s), nil
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		if err := v.As(&n); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.NumberVal(n)), nil
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := v.As(&b); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.BoolVal(b)), nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		tokens := make([]hclwrite.Tokens, 0, len(elems))
+		for _, elem := range elems {
+			t, err := r.value(elem)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, t)
+		}
+		return hclwrite.TokensForTuple(tokens), nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		keys := make([]string, 0, len(elems))
+		for key := range elems {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		tokens := make([]hclwrite.ObjectAttrTokens, 0, len(keys))
+		for _, key := range keys {
+			t, err := r.value(elems[key])
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, hclwrite.ObjectAttrTokens{Name: objectKeyTokens(key), Value: t})
+		}
+		return hclwrite.TokensForObject(tokens), nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", typ)
+	}
+}
+
+// json renders a JSON string as a jsonencode call, so that the generated configuration
+// can refer to other exported objects from within the JSON document.
+func (r *renderer) json(v tftypes.Value, transformReference bool) (hclwrite.Tokens, error) {
+	var s string
+	if err := v.As(&s); err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	tokens, err := r.jsonValue(doc, transformReference)
+	if err != nil {
+		return nil, err
+	}
+	return hclwrite.TokensForFunctionCall("jsonencode", tokens), nil
+}
+
+// jsonValue renders a decoded JSON value. The IDs of exported objects are rewritten
+// wherever they appear, while source and transform names only are under the keys
+// SailPoint uses for them: "sourceName", and "id" in the attributes of a transform of
+// type "reference".
+func (r *renderer) jsonValue(doc any, transformReference bool) (hclwrite.Tokens, error) {
+	switch v := doc.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		reference := v["type"] == "reference"
+		tokens := make([]hclwrite.ObjectAttrTokens, 0, len(keys))
+		for _, key := range keys {
+			var t hclwrite.Tokens
+			name, isString := v[key].(string)
+			switch {
+			case key == "sourceName" && isString && r.nameReference(r.refs.sourceNames, name):
+				t = traversalTokens(r.refs.sourceNames[name] + ".name")
+			case key == "id" && transformReference && isString && r.nameReference(r.refs.transformNames, name):
+				t = traversalTokens(r.refs.transformNames[name] + ".name")
+			default:
+				var err error
+				if t, err = r.jsonValue(v[key], key == "attributes" && reference); err != nil {
+					return nil, err
+				}
+			}
+			tokens = append(tokens, hclwrite.ObjectAttrTokens{Name: objectKeyTokens(key), Value: t})
+		}
+		return hclwrite.TokensForObject(tokens), nil
+	case []any:
+		tokens := make([]hclwrite.Tokens, 0, len(v))
+		for _, elem := range v {
+			t, err := r.jsonValue(elem, false)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, t)
+		}
+		return hclwrite.TokensForTuple(tokens), nil
+	case string:
+		return r.string(v), nil
+	case json.Number:
+		n, err := cty.ParseNumberVal(v.String())
+		if err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(n), nil
+	case bool:
+		return hclwrite.TokensForValue(cty.BoolVal(v)), nil
+	case nil:
+		return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType)), nil
+	default:
+		return nil, fmt.Errorf("unsupported JSON value %T", doc)
+	}
+}
+
+// string renders a string, as a reference to the ID of an exported object when it is one.
+func (r *renderer) string(s string) hclwrite.Tokens {
+	if address, ok := r.refs.ids[s]; ok && address != r.self {
+		return traversalTokens(address + ".id")
+	}
+	return hclwrite.TokensForValue(cty.StringVal(s))
+}
+
+// nameReference reports whether the name refers to an exported object other than the
+// one being rendered.
+func (r *renderer) nameReference(names map[string]string, name string) bool {
+	address, ok := names[name]
+	return ok && address != r.self
+}
+
+// isJSON reports whether a string attribute holds a JSON document.
+func isJSON(ctx context.Context, a schema.StringAttribute) bool {
+	if a.CustomType == nil {
+		return false
+	}
+	_, ok := a.CustomType.ValueType(ctx).(interface {
+		Unmarshal(target any) diag.Diagnostics
+	})
+	return ok
+}
+
+// objectKeyTokens renders an object key, quoted unless it is a plain identifier.
+func objectKeyTokens(key string) hclwrite.Tokens {
+	switch key {
+	case "null", "true", "false":
+	default:
+		if hclsyntax.ValidIdentifier(key) {
+			return hclwrite.TokensForIdentifier(key)
+		}
+	}
+	return hclwrite.TokensForValue(cty.StringVal(key))
+}
+
+// traversalTokens renders a dotted reference such as sailpoint_source.ad.id.
+func traversalTokens(reference string) hclwrite.Tokens {
+	parts := strings.Split(reference, ".")
+	traversal := hcl.Traversal{hcl.TraverseRoot{Name: parts[0]}}
+	for _, part := range parts[1:] {
+		traversal = append(traversal, hcl.TraverseAttr{Name: part})
+	}
+	return hclwrite.TokensForTraversal(traversal)
+}
+
+// stringValue returns the value of a known string, or an empty string.
+func stringValue(v tftypes.Value) string {
+	var s string
+	if !v.IsKnown() || v.IsNull() || !v.Type().Is(tftypes.String) || v.As(&s) != nil {
+		return ""
+	}
+	return s
+}
@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestResourceLabel(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		name string
+		want string
+	}{
+		"spaces and capitals": {
+			name: "Active Directory",
+			want: "active_directory",
+		},
+		"punctuation runs": {
+			name: "HR - Workday (prod)",
+			want: "hr_workday_prod",
+		},
+		"leading digit": {
+			name: "2FA Users",
+			want: "_2fa_users",
+		},
+		"no usable characters": {
+			name: "---",
+			want: "unnamed",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := resourceLabel(tt.name); got != tt.want {
+				t.Errorf("resourceLabel(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssignLabels(t *testing.T) {
+	t.Parallel()
+
+	objects := []*exportedObject{
+		{name: "Active"},
+		{name: "active"},
+		{name: "Inactive", parentID: "ip1"},
+	}
+	assignLabels(objects, map[string]string{"ip1": "employees"})
+
+	want := []string{"active", "active_2", "employees_inactive"}
+	for i, o := range objects {
+		if o.label != want[i] {
+			t.Errorf("objects[%d].label = %q, want %q", i, o.label, want[i])
+		}
+	}
+}
+
+func TestRenderFile(t *testing.T) {
+	t.Parallel()
+
+	sourceType, _ := lookupObjectType("source")
+	transformType, _ := lookupObjectType("transform")
+
+	refSchema := map[string]schema.Attribute{
+		"id":   schema.StringAttribute{Required: true},
+		"name": schema.StringAttribute{Computed: true},
+	}
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Computed: true},
+			"name":        schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Optional: true},
+			"type":        schema.StringAttribute{Required: true},
+			"owner":       schema.SingleNestedAttribute{Optional: true, Attributes: refSchema},
+			"attributes":  schema.StringAttribute{Optional: true, CustomType: jsontypes.NormalizedType{}},
+		},
+	}
+	refType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String, "name": tftypes.String}}
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":          tftypes.String,
+		"name":        tftypes.String,
+		"description": tftypes.String,
+		"type":        tftypes.String,
+		"owner":       refType,
+		"attributes":  tftypes.String,
+	}}
+	state := func(id, name, description, typ, owner, attributes string) tftypes.Value {
+		values := map[string]tftypes.Value{
+			"id":          tftypes.NewValue(tftypes.String, id),
+			"name":        tftypes.NewValue(tftypes.String, name),
+			"description": tftypes.NewValue(tftypes.String, nil),
+			"type":        tftypes.NewValue(tftypes.String, typ),
+			"owner":       tftypes.NewValue(refType, nil),
+			"attributes":  tftypes.NewValue(tftypes.String, nil),
+		}
+		if description != "" {
+			values["description"] = tftypes.NewValue(tftypes.String, description)
+		}
+		if owner != "" {
+			values["owner"] = tftypes.NewValue(refType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, owner),
+				"name": tftypes.NewValue(tftypes.String, "Computed Name"),
+			})
+		}
+		if attributes != "" {
+			values["attributes"] = tftypes.NewValue(tftypes.String, attributes)
+		}
+		return tftypes.NewValue(stateType, values)
+	}
+
+	ad := &exportedObject{
+		objectType: sourceType, id: "s1", importID: "s1", name: "AD", label: "ad", schema: s,
+		state: state("s1", "AD", "Uses ${var} syntax", "direct", "identity1", ""),
+	}
+	base := &exportedObject{
+		objectType: transformType, id: "t1", importID: "t1", name: "Base", label: "base", schema: s,
+		state: state("t1", "Base", "", "lower", "", `{"input":{"attributes":{"sourceName":"AD","sourceId":"s1"},"type":"accountAttribute"}}`),
+	}
+	ref := &exportedObject{
+		objectType: transformType, id: "t2", importID: "t2", name: "Ref", label: "ref", schema: s,
+		state: state("t2", "Ref", "", "reference", "", `{"id":"Base","count":1.5,"flags":[true,null]}`),
+	}
+	refs := newReferences([]*exportedObject{ad, base, ref})
+
+	got, err := renderFile(context.Background(), refs, []*exportedObject{ad, base, ref})
+	if err != nil {
+		t.Fatalf("renderFile() error = %v", err)
+	}
+
+	want := fileHeader + `resource "sailpoint_source" "ad" {
+  description = "Uses $${var} syntax"
+  name        = "AD"
+  owner = {
+    id = "identity1"
+  }
+  type = "direct"
+}
+
+import {
+  to = sailpoint_source.ad
+  id = "s1"
+}
+
+resource "sailpoint_transform" "base" {
+  attributes = jsonencode({
+    input = {
+      attributes = {
+        sourceId   = sailpoint_source.ad.id
+        sourceName = sailpoint_source.ad.name
+      }
+      type = "accountAttribute"
+    }
+  })
+  name = "Base"
+  type = "lower"
+}
+
+import {
+  to = sailpoint_transform.base
+  id = "t1"
+}
+
+resource "sailpoint_transform" "ref" {
+  attributes = jsonencode({
+    count = 1.5
+    flags = [true, null]
+    id    = sailpoint_transform.base.name
+  })
+  name = "Ref"
+  type = "reference"
+}
+
+import {
+  to = sailpoint_transform.ref
+  id = "t2"
+}
+`
+	if string(got) != want {
+		t.Errorf("renderFile() =\n%s\nwant\n%s", got, want)
+	}
+}
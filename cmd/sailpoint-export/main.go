@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Command sailpoint-export crawls a SailPoint Identity Security Cloud tenant and writes
+// the Terraform configuration managing its objects: one file per object type, with a
+// resource block and an import block for each object.
+//
+// The command authenticates with the same environment variables as the provider:
+// SAILPOINT_BASE_URL, SAILPOINT_CLIENT_ID and SAILPOINT_CLIENT_SECRET.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+)
+
+func main() {
+	var out, types string
+
+	flag.StringVar(&out, "out", ".", "directory the generated files are written to")
+	flag.StringVar(&types, "types", "", "comma-separated object types to export, such as source,transform (default all)")
+	flag.Parse()
+
+	if err := run(context.Background(), out, types); err != nil {
+		log.Fatal(err.Error())
+	}
+}
+
+func run(ctx context.Context, out, types string) error {
+	selected, err := selectObjectTypes(types)
+	if err != nil {
+		return err
+	}
+
+	var (
+		baseURL      = os.Getenv("SAILPOINT_BASE_URL")
+		clientID     = os.Getenv("SAILPOINT_CLIENT_ID")
+		clientSecret = os.Getenv("SAILPOINT_CLIENT_SECRET")
+	)
+	if baseURL == "" || clientID == "" || clientSecret == "" {
+		return errors.New("set the SAILPOINT_BASE_URL, SAILPOINT_CLIENT_ID and SAILPOINT_CLIENT_SECRET environment variables")
+	}
+
+	apiClient, err := client.NewClient(baseURL, clientID, clientSecret)
+	if err != nil {
+		return fmt.Errorf("creating the SailPoint client: %w", err)
+	}
+	e := &exporter{client: apiClient}
+
+	// Lifecycle states are listed per identity profile, so identity profiles are crawled
+	// whenever lifecycle states are exported, even when they aren't exported themselves.
+	var (
+		crawled  = map[string][]*exportedObject{}
+		profiles []*exportedObject
+		exported []*exportedObject
+	)
+	for i := range objectTypes {
+		t := &objectTypes[i]
+		needed := selected[t.name] || (t.name == "identity_profile" && selected["lifecycle_state"])
+		if !needed {
+			continue
+		}
+
+		objects, err := e.crawl(ctx, t, profiles)
+		if err != nil {
+			return err
+		}
+
+		parentLabels := make(map[string]string, len(profiles))
+		for _, profile := range profiles {
+			parentLabels[profile.id] = profile.label
+		}
+		assignLabels(objects, parentLabels)
+
+		if t.name == "identity_profile" {
+			profiles = objects
+		}
+		if selected[t.name] {
+			crawled[t.name] = objects
+			exported = append(exported, objects...)
+		}
+	}
+
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return err
+	}
+
+	refs := newReferences(exported)
+	for _, t := range objectTypes {
+		objects := crawled[t.name]
+		if len(objects) == 0 {
+			continue
+		}
+
+		content, err := renderFile(ctx, refs, objects)
+		if err != nil {
+			return err
+		}
+		filename := filepath.Join(out, t.name+".tf")
+		if err := os.WriteFile(filename, content, 0o644); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Wrote %d %s objects to %s\n", len(objects), t.name, filename)
+	}
+	return nil
+}
+
+// selectObjectTypes parses the -types flag into the set of object types to export.
+func selectObjectTypes(types string) (map[string]bool, error) {
+	selected := map[string]bool{}
+	if types == "" {
+		for _, t := range objectTypes {
+			selected[t.name] = true
+		}
+		return selected, nil
+	}
+
+	for _, name := range strings.Split(types, ",") {
+		name = strings.TrimSpace(name)
+		if _, ok := lookupObjectType(name); !ok {
+			names := make([]string, 0, len(objectTypes))
+			for _, t := range objectTypes {
+				names = append(names, t.name)
+			}
+			return nil, fmt.Errorf("unknown object type %q, expected one of %s", name, strings.Join(names, ", "))
+		}
+		selected[name] = true
+	}
+	return selected, nil
+}
@@ -0,0 +1,20 @@
+# Process the identities of a profile once it is created and its mappings are in place
+action "sailpoint_identity_profile_process" "employees" {
+  config {
+    identity_profile_id = sailpoint_identity_profile.employees.id
+    timeout             = "2h"
+  }
+}
+
+resource "sailpoint_identity_profile_attribute_mapping" "department" {
+  identity_profile_id     = sailpoint_identity_profile.employees.id
+  identity_attribute_name = "department"
+  # ...
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.sailpoint_identity_profile_process.employees]
+    }
+  }
+}
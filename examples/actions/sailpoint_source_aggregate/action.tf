@@ -0,0 +1,30 @@
+# Aggregate accounts once the account schema is in place
+action "sailpoint_source_aggregate" "accounts" {
+  config {
+    source_id = sailpoint_source.active_directory.id
+    type      = "ACCOUNTS"
+    timeout   = "1h"
+  }
+}
+
+# Full entitlement aggregation, run on demand:
+#   terraform apply -invoke=action.sailpoint_source_aggregate.entitlements
+action "sailpoint_source_aggregate" "entitlements" {
+  config {
+    source_id = sailpoint_source.active_directory.id
+    type      = "ENTITLEMENTS"
+  }
+}
+
+resource "sailpoint_source_schema" "account" {
+  source_id = sailpoint_source.active_directory.id
+  name      = "account"
+  # ...
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.sailpoint_source_aggregate.accounts]
+    }
+  }
+}
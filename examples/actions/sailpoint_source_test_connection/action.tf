@@ -0,0 +1,20 @@
+# Test the connection whenever the source configuration changes
+action "sailpoint_source_test_connection" "active_directory" {
+  config {
+    source_id = sailpoint_source.active_directory.id
+  }
+}
+
+resource "terraform_data" "active_directory_config" {
+  input = sailpoint_source.active_directory.modified
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.sailpoint_source_test_connection.active_directory]
+    }
+  }
+}
+
+# Or run it on demand:
+#   terraform apply -invoke=action.sailpoint_source_test_connection.active_directory
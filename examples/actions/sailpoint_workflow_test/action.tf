@@ -0,0 +1,34 @@
+# Run the workflow with a sample identity attribute change after each update:
+#   terraform apply -invoke=action.sailpoint_workflow_test.onboarding
+action "sailpoint_workflow_test" "onboarding" {
+  config {
+    workflow_id = sailpoint_workflow.onboarding.id
+    input = jsonencode({
+      identity = {
+        id   = "2c9180857182305e0171993735622948"
+        name = "john.doe"
+        type = "IDENTITY"
+      }
+      changes = [
+        {
+          attribute = "department"
+          oldValue  = "Sales"
+          newValue  = "Engineering"
+        }
+      ]
+    })
+    timeout = "10m"
+  }
+}
+
+resource "sailpoint_workflow" "onboarding" {
+  name = "Onboarding"
+  # ...
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.sailpoint_workflow_test.onboarding]
+    }
+  }
+}
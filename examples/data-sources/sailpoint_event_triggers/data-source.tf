@@ -0,0 +1,6 @@
+# List the available event triggers
+data "sailpoint_event_triggers" "all" {}
+
+output "event_trigger_ids" {
+  value = [for trigger in data.sailpoint_event_triggers.all.triggers : trigger.id]
+}
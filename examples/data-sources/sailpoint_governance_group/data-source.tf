@@ -0,0 +1,12 @@
+# Look up an existing governance group by name
+data "sailpoint_governance_group" "security_team" {
+  name = "Security Team"
+}
+
+output "security_team_id" {
+  value = data.sailpoint_governance_group.security_team.id
+}
+
+output "security_team_member_count" {
+  value = data.sailpoint_governance_group.security_team.member_count
+}
@@ -0,0 +1,54 @@
+# SailPoint Identity Profile Preview Data Source Examples
+#
+# The Identity Profile Preview data source computes the identity attribute values an identity
+# would get with a candidate attribute configuration, without changing anything in SailPoint.
+# Use it to review a change to an identity profile's mappings before applying it.
+
+# Example 1: Preview a candidate mapping against a known identity
+data "sailpoint_identity_profile_preview" "email_change" {
+  identity_id = "2c9180857182305e0171993735622948"
+
+  attribute_transforms = [
+    {
+      identity_attribute_name = "email"
+      transform_definition = {
+        type = "accountAttribute"
+        attributes = jsonencode({
+          sourceName    = "Active Directory"
+          attributeName = "mail"
+        })
+      }
+    },
+    {
+      identity_attribute_name = "displayName"
+      transform_definition = {
+        type = "reference"
+        attributes = jsonencode({
+          id = "Build Display Name"
+        })
+      }
+    }
+  ]
+}
+
+# Example 2: Only surface the attributes the candidate configuration would change
+output "changed_identity_attributes" {
+  value = {
+    for attr in data.sailpoint_identity_profile_preview.email_change.preview_attributes :
+    attr.name => {
+      from = attr.previous_value
+      to   = attr.value
+    } if attr.changed
+  }
+}
+
+# Example 3: Fail the plan if any candidate mapping errors for this identity
+check "identity_profile_preview_has_no_errors" {
+  assert {
+    condition = alltrue([
+      for attr in data.sailpoint_identity_profile_preview.email_change.preview_attributes :
+      length(attr.error_messages) == 0
+    ])
+    error_message = "The candidate identity attribute configuration raises errors for the previewed identity."
+  }
+}
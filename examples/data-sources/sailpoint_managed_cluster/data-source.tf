@@ -0,0 +1,13 @@
+# Look up a managed cluster by name and fail the plan if it is unhealthy
+data "sailpoint_managed_cluster" "datacenter" {
+  name            = "Datacenter VA Cluster"
+  require_healthy = true
+}
+
+output "datacenter_cluster_status" {
+  value = data.sailpoint_managed_cluster.datacenter.status
+}
+
+output "datacenter_va_versions" {
+  value = { for va in data.sailpoint_managed_cluster.datacenter.clients : va.name => va.va_version }
+}
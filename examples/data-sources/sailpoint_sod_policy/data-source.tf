@@ -0,0 +1,8 @@
+# Look up an existing SOD policy by ID
+data "sailpoint_sod_policy" "payments" {
+  id = "REPLACE_WITH_SOD_POLICY_ID"
+}
+
+output "payments_policy_state" {
+  value = data.sailpoint_sod_policy.payments.state
+}
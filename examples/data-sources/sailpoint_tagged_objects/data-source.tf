@@ -0,0 +1,27 @@
+# List the entitlements tagged "sox"
+data "sailpoint_tagged_objects" "sox_entitlements" {
+  tag         = "sox"
+  object_type = "ENTITLEMENT"
+}
+
+# Tagged objects can be used wherever an object reference is expected
+resource "sailpoint_access_profile" "sox_bundle" {
+  name = "SOX Entitlements"
+
+  owner = {
+    type = "IDENTITY"
+    id   = "REPLACE_WITH_OWNER_IDENTITY_ID"
+  }
+
+  source = {
+    type = "SOURCE"
+    id   = "REPLACE_WITH_SOURCE_ID"
+  }
+
+  entitlements = [
+    for object in data.sailpoint_tagged_objects.sox_entitlements.objects : {
+      type = object.object_ref.type
+      id   = object.object_ref.id
+    }
+  ]
+}
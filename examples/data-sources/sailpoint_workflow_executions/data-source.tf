@@ -0,0 +1,13 @@
+# The last five failed executions of a workflow
+data "sailpoint_workflow_executions" "failures" {
+  workflow_id = sailpoint_workflow.onboarding.id
+  status      = "Failed"
+  limit       = 5
+}
+
+output "onboarding_failures" {
+  value = [
+    for execution in data.sailpoint_workflow_executions.failures.executions :
+    "${execution.start_time}: ${execution.failed_step} - ${execution.error}"
+  ]
+}
@@ -0,0 +1,80 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+locals {
+  username_transform = {
+    type = "lower"
+    attributes = {
+      input = {
+        type = "concat"
+        attributes = {
+          values = [
+            {
+              type = "substring"
+              attributes = {
+                begin = 0
+                end   = 1
+                input = {
+                  type       = "identityAttribute"
+                  attributes = { name = "firstname" }
+                }
+              }
+            },
+            {
+              type       = "identityAttribute"
+              attributes = { name = "lastname" }
+            }
+          ]
+        }
+      }
+    }
+  }
+}
+
+resource "sailpoint_transform" "username" {
+  name       = "Username"
+  type       = local.username_transform.type
+  attributes = jsonencode(local.username_transform.attributes)
+}
+
+# Fails the plan if the transform no longer produces the expected output.
+check "username_transform" {
+  assert {
+    condition = provider::sailpoint::evaluate_transform(jsonencode(local.username_transform), {
+      identity_attributes = {
+        firstname = "Ada"
+        lastname  = "Lovelace"
+      }
+    }) == "alovelace"
+    error_message = "The username transform must produce first initial + last name in lowercase."
+  }
+}
+
+# Account attributes are looked up by source name, and `now` pins the
+# reference time used by dateMath and dateCompare.
+output "in_probation" {
+  value = provider::sailpoint::evaluate_transform(jsonencode({
+    type = "dateCompare"
+    attributes = {
+      firstDate = {
+        type = "dateMath"
+        attributes = {
+          expression = "+90d/d"
+          input = {
+            type       = "accountAttribute"
+            attributes = { sourceName = "Workday", attributeName = "hireDate" }
+          }
+        }
+      }
+      secondDate        = "now"
+      operator          = "GT"
+      positiveCondition = "true"
+      negativeCondition = "false"
+    }
+  }), {
+    account_attributes = {
+      Workday = { hireDate = "2024-01-15T00:00:00Z" }
+    }
+    now = "2024-03-01T00:00:00Z"
+  })
+}
@@ -0,0 +1,11 @@
+# In a .tfquery.hcl file. Run `terraform query -generate-config-out=generated.tf`
+# to write a resource block and an import block for every object found.
+
+# Access profiles granting entitlements of one source
+list "sailpoint_access_profile" "active_directory" {
+  provider = sailpoint
+
+  config {
+    filters = "source.id eq \"2c9180835d2e5168015d32f890ca1581\""
+  }
+}
@@ -0,0 +1,11 @@
+# In a .tfquery.hcl file. Run `terraform query -generate-config-out=generated.tf`
+# to write a resource block and an import block for every object found.
+
+# Form definitions whose name starts with "Access"
+list "sailpoint_form_definition" "access" {
+  provider = sailpoint
+
+  config {
+    filters = "name sw \"Access\""
+  }
+}
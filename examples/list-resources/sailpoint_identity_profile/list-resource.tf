@@ -0,0 +1,7 @@
+# In a .tfquery.hcl file. Run `terraform query -generate-config-out=generated.tf`
+# to write a resource block and an import block for every object found.
+
+# All identity profiles
+list "sailpoint_identity_profile" "all" {
+  provider = sailpoint
+}
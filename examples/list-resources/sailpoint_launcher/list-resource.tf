@@ -0,0 +1,11 @@
+# In a .tfquery.hcl file. Run `terraform query -generate-config-out=generated.tf`
+# to write a resource block and an import block for every object found.
+
+# Enabled launchers
+list "sailpoint_launcher" "enabled" {
+  provider = sailpoint
+
+  config {
+    filters = "disabled eq false"
+  }
+}
@@ -0,0 +1,11 @@
+# In a .tfquery.hcl file. Run `terraform query -generate-config-out=generated.tf`
+# to write a resource block and an import block for every object found.
+
+# The lifecycle states of one identity profile
+list "sailpoint_lifecycle_state" "employees" {
+  provider = sailpoint
+
+  config {
+    identity_profile_id = "2c9180835d2e5168015d32f890ca1581"
+  }
+}
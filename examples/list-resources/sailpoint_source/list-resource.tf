@@ -0,0 +1,11 @@
+# In a .tfquery.hcl file. Run `terraform query -generate-config-out=generated.tf`
+# to write a resource block and an import block for every object found.
+
+# Sources whose name starts with "HR"
+list "sailpoint_source" "hr" {
+  provider = sailpoint
+
+  config {
+    filters = "name sw \"HR\""
+  }
+}
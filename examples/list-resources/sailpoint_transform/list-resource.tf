@@ -0,0 +1,11 @@
+# In a .tfquery.hcl file. Run `terraform query -generate-config-out=generated.tf`
+# to write a resource block and an import block for every object found.
+
+# Custom transforms, leaving out SailPoint's built-in ones
+list "sailpoint_transform" "custom" {
+  provider = sailpoint
+
+  config {
+    filters = "internal eq false"
+  }
+}
@@ -19,3 +19,9 @@ provider "sailpoint" {
   client_id     = "your-client-id"
   client_secret = "your-client-secret"
 }
+
+# Example usage of the SailPoint provider
+# with tags added to every source, role, access profile and entitlement it manages
+provider "sailpoint" {
+  default_tags = ["managed-by-terraform"]
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import the tenant's access request configuration (any ID is accepted)
+terraform import sailpoint_access_request_config.this "access-request-config"
@@ -0,0 +1,24 @@
+# Tenant-wide access request settings. Settings left unset keep their current value.
+resource "sailpoint_access_request_config" "this" {
+  approvals_must_be_external = false
+  auto_approval_enabled      = true
+
+  request_on_behalf_of_config = {
+    allow_request_on_behalf_of_anyone_by_anyone    = false
+    allow_request_on_behalf_of_employee_by_manager = true
+  }
+
+  approval_reminder_and_escalation_config = {
+    days_until_escalation  = 3
+    days_between_reminders = 1
+    max_reminders          = 2
+    fallback_approver_id   = "REPLACE_WITH_FALLBACK_APPROVER_IDENTITY_ID"
+  }
+
+  entitlement_request_config = {
+    allow_entitlement_request      = true
+    request_comments_required      = true
+    denied_comments_required       = true
+    grant_request_approval_schemes = "entitlementOwner,manager"
+  }
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing certification campaign template by its ID
+terraform import sailpoint_certification_campaign_template.quarterly_manager "REPLACE_WITH_CAMPAIGN_TEMPLATE_ID"
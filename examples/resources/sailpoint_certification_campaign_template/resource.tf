@@ -0,0 +1,65 @@
+# Quarterly manager certification
+resource "sailpoint_certification_campaign_template" "quarterly_manager" {
+  name              = "Quarterly Manager Certification"
+  description       = "Managers review the access of their direct reports every quarter"
+  deadline_duration = "P2W"
+
+  campaign = {
+    name                          = "Manager Certification $${campaignDate}"
+    description                   = "Review the access of your direct reports"
+    type                          = "MANAGER"
+    email_notification_enabled    = true
+    auto_revoke_allowed           = true
+    mandatory_comment_requirement = "REVOKE_ONLY_DECISIONS"
+  }
+
+  schedule = {
+    type   = "ANNUALLY"
+    months = ["1", "4", "7", "10"]
+    days   = ["1"]
+    hours  = ["8"]
+
+    time_zone_id = "America/Chicago"
+  }
+}
+
+# Source owner certification of the HR and finance sources
+resource "sailpoint_certification_campaign_template" "source_owner" {
+  name        = "Source Owner Certification"
+  description = "Source owners review all accounts on critical sources"
+
+  campaign = {
+    name        = "Source Owner Certification $${campaignDate}"
+    description = "Review the accounts on the sources you own"
+    type        = "SOURCE_OWNER"
+
+    source_owner = {
+      source_ids = [
+        "REPLACE_WITH_HR_SOURCE_ID",
+        "REPLACE_WITH_FINANCE_SOURCE_ID",
+      ]
+    }
+  }
+}
+
+# Search certification of contractors, reviewed by a governance group
+resource "sailpoint_certification_campaign_template" "contractors" {
+  name        = "Contractor Access Review"
+  description = "Security reviews the access of all contractors"
+
+  campaign = {
+    name        = "Contractor Access Review $${campaignDate}"
+    description = "Review the access of contractors"
+    type        = "SEARCH"
+
+    search = {
+      type  = "IDENTITY"
+      query = "attributes.employeeType:contractor"
+
+      reviewer = {
+        type = "GOVERNANCE_GROUP"
+        id   = "REPLACE_WITH_GOVERNANCE_GROUP_ID"
+      }
+    }
+  }
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing connector rule by its ID
+terraform import sailpoint_connector_rule.build_map "REPLACE_WITH_CONNECTOR_RULE_ID"
@@ -0,0 +1,51 @@
+# BuildMap rule used by a delimited file source
+resource "sailpoint_connector_rule" "build_map" {
+  name        = "Build Map - HR Extract"
+  description = "Normalizes the HR extract before aggregation"
+  type        = "BuildMap"
+
+  signature = {
+    input = [
+      {
+        name        = "schema"
+        description = "The schema of the account being built"
+        type        = "Schema"
+      },
+      {
+        name        = "record"
+        description = "The parsed record from the file"
+        type        = "List"
+      },
+    ]
+    output = {
+      name        = "map"
+      description = "The account attributes"
+      type        = "Map"
+    }
+  }
+
+  source_code = {
+    version = "1.0"
+    script  = file("${path.module}/rules/build-map-hr-extract.bsh")
+  }
+}
+
+# Reference the rule by name from a source
+resource "sailpoint_source" "hr_extract" {
+  name      = "HR Extract"
+  connector = "delimited-file-angularsc"
+
+  owner = {
+    type = "IDENTITY"
+    id   = "REPLACE_WITH_OWNER_IDENTITY_ID"
+  }
+
+  cluster = {
+    type = "CLUSTER"
+    id   = "REPLACE_WITH_CLUSTER_ID"
+  }
+
+  connector_attributes = jsonencode({
+    buildMapRule = sailpoint_connector_rule.build_map.name
+  })
+}
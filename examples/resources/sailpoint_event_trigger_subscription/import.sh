@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing event trigger subscription by its ID
+terraform import sailpoint_event_trigger_subscription.attribute_changes "REPLACE_WITH_SUBSCRIPTION_ID"
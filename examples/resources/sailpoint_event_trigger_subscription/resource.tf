@@ -0,0 +1,30 @@
+# Post identity attribute changes for one department to an HTTP endpoint
+resource "sailpoint_event_trigger_subscription" "attribute_changes" {
+  name       = "Identity Attribute Changes"
+  trigger_id = "idn:identity-attributes-changed"
+  type       = "HTTP"
+  filter     = "$[?($.changes[*].attribute == \"department\")]"
+
+  http_config = {
+    url                      = "https://hooks.example.com/sailpoint/identity-changes"
+    http_dispatch_mode       = "ASYNC"
+    http_authentication_type = "BEARER_TOKEN"
+
+    bearer_token_auth_config = {
+      bearer_token_wo         = var.webhook_token
+      bearer_token_wo_version = 1
+    }
+  }
+}
+
+# Forward completed account aggregations to AWS EventBridge
+resource "sailpoint_event_trigger_subscription" "aggregations" {
+  name       = "Account Aggregation Completed"
+  trigger_id = "idn:account-aggregation-completed"
+  type       = "EVENTBRIDGE"
+
+  eventbridge_config = {
+    aws_account = "123456789012"
+    aws_region  = "us-east-1"
+  }
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing governance group by its ID
+terraform import sailpoint_governance_group.finance_approvers "REPLACE_WITH_GOVERNANCE_GROUP_ID"
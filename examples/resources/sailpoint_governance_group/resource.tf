@@ -0,0 +1,44 @@
+# Governance group with managed membership
+resource "sailpoint_governance_group" "finance_approvers" {
+  name        = "Finance Approvers"
+  description = "Approves access to finance applications"
+
+  owner = {
+    type = "IDENTITY"
+    id   = "REPLACE_WITH_OWNER_IDENTITY_ID"
+  }
+
+  members = [
+    "REPLACE_WITH_MEMBER_IDENTITY_ID_1",
+    "REPLACE_WITH_MEMBER_IDENTITY_ID_2",
+  ]
+}
+
+# Use the group as an additional owner of an access profile
+resource "sailpoint_access_profile" "finance_reports" {
+  name = "Finance Reports"
+
+  owner = {
+    type = "IDENTITY"
+    id   = "REPLACE_WITH_OWNER_IDENTITY_ID"
+  }
+
+  source = {
+    type = "SOURCE"
+    id   = "REPLACE_WITH_SOURCE_ID"
+  }
+
+  entitlements = [
+    {
+      type = "ENTITLEMENT"
+      id   = "REPLACE_WITH_ENTITLEMENT_ID"
+    }
+  ]
+
+  additional_owners = [
+    {
+      type = "GOVERNANCE_GROUP"
+      id   = sailpoint_governance_group.finance_approvers.id
+    }
+  ]
+}
@@ -41,6 +41,10 @@ resource "sailpoint_identity_profile" "with_mappings" {
   name        = "Employees - Full Mapping"
   description = "Identity profile with custom attribute mappings"
 
+  # Apply mapping changes to the identities of the profile on each update
+  process_on_change = true
+  process_timeout   = "1h"
+
   authoritative_source {
     type = "SOURCE"
     id   = "2c91808a7813090a017814121e121518"
@@ -86,3 +90,19 @@ resource "sailpoint_identity_profile" "with_mappings" {
     }
   }
 }
+
+# Example 4: Identity Profile whose mappings are managed individually
+# With attribute_transforms unset, mappings on the profile are left to
+# sailpoint_identity_profile_attribute_mapping resources.
+resource "sailpoint_identity_profile" "granular" {
+  name = "Employees - Granular Mappings"
+
+  authoritative_source {
+    type = "SOURCE"
+    id   = "2c91808a7813090a017814121e121518"
+  }
+
+  identity_attribute_config {
+    enabled = true
+  }
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing attribute mapping using identity_profile_id/identity_attribute_name format
+terraform import sailpoint_identity_profile_attribute_mapping.department "REPLACE_WITH_IDENTITY_PROFILE_ID/department"
@@ -0,0 +1,45 @@
+# The profile leaves attribute_transforms unset, so each team owns its own mappings
+resource "sailpoint_identity_profile" "employees" {
+  name = "Employees"
+
+  authoritative_source = {
+    type = "SOURCE"
+    id   = sailpoint_source.hr.id
+  }
+
+  owner = {
+    type = "IDENTITY"
+    id   = "2c91808a7813090a017814121e121519"
+  }
+
+  identity_attribute_config = {
+    enabled = true
+  }
+}
+
+# Owned by the HR configuration
+resource "sailpoint_identity_profile_attribute_mapping" "department" {
+  identity_profile_id     = sailpoint_identity_profile.employees.id
+  identity_attribute_name = "department"
+
+  transform_definition = {
+    type = "accountAttribute"
+    attributes = jsonencode({
+      sourceName    = "HR System"
+      attributeName = "dept"
+    })
+  }
+}
+
+# Owned by the security configuration
+resource "sailpoint_identity_profile_attribute_mapping" "risk_level" {
+  identity_profile_id     = sailpoint_identity_profile.employees.id
+  identity_attribute_name = "riskLevel"
+
+  transform_definition = {
+    type = "reference"
+    attributes = jsonencode({
+      id = "Risk Level"
+    })
+  }
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing managed cluster by its ID
+terraform import sailpoint_managed_cluster.datacenter "REPLACE_WITH_MANAGED_CLUSTER_ID"
@@ -0,0 +1,25 @@
+# Managed cluster for the virtual appliances in the main data center
+resource "sailpoint_managed_cluster" "datacenter" {
+  name        = "Datacenter VA Cluster"
+  description = "Virtual appliances connecting the data center sources"
+
+  configuration = {
+    gmtOffset = "-5"
+  }
+}
+
+# On-premise source connected through the cluster
+resource "sailpoint_source" "active_directory" {
+  name      = "Active Directory"
+  connector = "active-directory"
+
+  owner = {
+    type = "IDENTITY"
+    id   = "REPLACE_WITH_OWNER_IDENTITY_ID"
+  }
+
+  cluster = {
+    type = "CLUSTER"
+    id   = sailpoint_managed_cluster.datacenter.id
+  }
+}
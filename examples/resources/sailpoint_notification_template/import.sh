@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import a notification template by its key, medium and locale
+terraform import sailpoint_notification_template.manual_work_item "cloud_manual_work_item_summary/EMAIL/en"
@@ -0,0 +1,15 @@
+# Customize the email sent when a manual work item is assigned
+resource "sailpoint_notification_template" "manual_work_item" {
+  key    = "cloud_manual_work_item_summary"
+  medium = "EMAIL"
+  locale = "en"
+
+  subject = "$${numberOfPendingTasks} pending task(s) for $${__recipient.name}"
+  body    = <<-EOT
+    <p>Hello $${__recipient.name},</p>
+    #if($numberOfPendingTasks > 0)
+    <p>You have $${numberOfPendingTasks} manual task(s) waiting for you.</p>
+    #end
+  EOT
+  from = sailpoint_verified_from_address.iam_team.email
+}
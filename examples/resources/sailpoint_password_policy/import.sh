@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing password policy by its ID
+terraform import sailpoint_password_policy.directory "REPLACE_WITH_PASSWORD_POLICY_ID"
@@ -0,0 +1,25 @@
+# Password rules for the directory sources
+resource "sailpoint_password_policy" "directory" {
+  name        = "Directory Password Policy"
+  description = "Applies to Active Directory and LDAP"
+  source_ids  = [sailpoint_source.active_directory.id, sailpoint_source.ldap.id]
+
+  min_length          = 12
+  max_length          = 64
+  min_lower           = 1
+  min_upper           = 1
+  min_numeric         = 1
+  min_special         = 1
+  min_character_types = 3
+  max_repeated_chars  = 3
+  use_dictionary      = true
+
+  validate_against_account_id   = true
+  account_id_min_word_length    = 4
+  validate_against_account_name = true
+  account_name_min_word_length  = 4
+
+  enable_password_expiration = true
+  password_expiration        = 90
+  first_expiration_reminder  = 14
+}
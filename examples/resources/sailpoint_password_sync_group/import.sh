@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing password sync group by its ID
+terraform import sailpoint_password_sync_group.directory "REPLACE_WITH_PASSWORD_SYNC_GROUP_ID"
@@ -0,0 +1,6 @@
+# Keep Active Directory and LDAP passwords in sync
+resource "sailpoint_password_sync_group" "directory" {
+  name               = "Directory Sync Group"
+  password_policy_id = sailpoint_password_policy.directory.id
+  source_ids         = [sailpoint_source.active_directory.id, sailpoint_source.ldap.id]
+}
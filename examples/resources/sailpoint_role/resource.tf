@@ -14,6 +14,7 @@ resource "sailpoint_role" "engineering" {
   description = "Grants engineering team access to development tools"
   enabled     = true
   requestable = true
+  tags        = ["engineering"]
 
   owner = {
     type = "IDENTITY"
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing service desk integration by its ID
+terraform import sailpoint_service_desk_integration.servicenow "REPLACE_WITH_SERVICE_DESK_INTEGRATION_ID"
@@ -0,0 +1,31 @@
+# Route provisioning of a disconnected source to ServiceNow
+resource "sailpoint_service_desk_integration" "servicenow" {
+  name        = "ServiceNow"
+  description = "Tickets for disconnected applications"
+  type        = "ServiceNowSDIM"
+
+  owner = {
+    type = "IDENTITY"
+    id   = "REPLACE_WITH_OWNER_IDENTITY_ID"
+  }
+
+  provisioning_config = {
+    managed_resource_refs = [
+      {
+        type = "SOURCE"
+        id   = sailpoint_source.legacy_erp.id
+      },
+    ]
+  }
+
+  attributes = jsonencode({
+    url        = "https://example.service-now.com"
+    username   = "sailpoint-integration"
+    ticketType = "sc_request"
+  })
+
+  secret_attributes_wo = jsonencode({
+    password = var.servicenow_password
+  })
+  secret_attributes_wo_version = 1
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import the tenant's service desk status check configuration (any ID is accepted)
+terraform import sailpoint_service_desk_status_check_config.this "status-check-configuration"
@@ -0,0 +1,5 @@
+# Check service desk tickets every 30 minutes for up to 7 days
+resource "sailpoint_service_desk_status_check_config" "this" {
+  provisioning_status_check_interval_minutes = 30
+  provisioning_max_status_check_days         = 7
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing SOD policy by its ID
+terraform import sailpoint_sod_policy.payments "REPLACE_WITH_SOD_POLICY_ID"
@@ -0,0 +1,70 @@
+# Conflicting access policy: nobody may both create and approve payments
+resource "sailpoint_sod_policy" "payments" {
+  name        = "Payment Creation vs Approval"
+  description = "Users who create payments must not approve them"
+  type        = "CONFLICTING_ACCESS_BASED"
+  state       = "ENFORCED"
+  tags        = ["finance", "sox"]
+
+  owner = {
+    type = "IDENTITY"
+    id   = "REPLACE_WITH_OWNER_IDENTITY_ID"
+  }
+
+  compensating_controls = "Monthly review of payments by the finance controller"
+  correction_advice     = "Request removal of either the payment creation or the payment approval entitlement"
+
+  violation_owner_assignment_config = {
+    assignment_rule = "STATIC"
+    owner = {
+      type = "GOVERNANCE_GROUP"
+      id   = "REPLACE_WITH_GOVERNANCE_GROUP_ID"
+    }
+  }
+
+  conflicting_access_criteria = {
+    left_criteria = {
+      name = "Create Payments"
+      criteria_list = [
+        {
+          type = "ENTITLEMENT"
+          id   = "REPLACE_WITH_CREATE_PAYMENT_ENTITLEMENT_ID"
+        }
+      ]
+    }
+    right_criteria = {
+      name = "Approve Payments"
+      criteria_list = [
+        {
+          type = "ENTITLEMENT"
+          id   = "REPLACE_WITH_APPROVE_PAYMENT_ENTITLEMENT_ID"
+        }
+      ]
+    }
+  }
+
+  # Email the violation report every Monday at 8:00 Chicago time
+  schedule = {
+    type         = "WEEKLY"
+    days         = ["MON"]
+    hours        = ["8"]
+    time_zone_id = "America/Chicago"
+    recipients   = ["REPLACE_WITH_RECIPIENT_IDENTITY_ID"]
+  }
+}
+
+# General policy driven by a search query
+resource "sailpoint_sod_policy" "contractors_admin" {
+  name         = "Contractors With Admin Access"
+  type         = "GENERAL"
+  policy_query = "@access(name:\"Domain Admins\") AND attributes.employeeType:contractor"
+
+  owner = {
+    type = "IDENTITY"
+    id   = "REPLACE_WITH_OWNER_IDENTITY_ID"
+  }
+
+  violation_owner_assignment_config = {
+    assignment_rule = "MANAGER"
+  }
+}
@@ -0,0 +1,14 @@
+# Load the accounts of a delimited file source from a CSV kept next to the configuration.
+# The file is uploaded again whenever its content changes.
+resource "sailpoint_source_account_file" "hr_accounts" {
+  source_id = sailpoint_source.hr_feed.id
+  file_path = "${path.module}/data/hr_accounts.csv"
+}
+
+# Load the entitlements of the same source, allowing a longer aggregation
+resource "sailpoint_source_account_file" "hr_entitlements" {
+  source_id = sailpoint_source.hr_feed.id
+  type      = "ENTITLEMENTS"
+  file_path = "${path.module}/data/hr_entitlements.csv"
+  timeout   = "1h"
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing source schedule using source_id/type format
+terraform import sailpoint_source_schedule.accounts "REPLACE_WITH_SOURCE_ID/ACCOUNT_AGGREGATION"
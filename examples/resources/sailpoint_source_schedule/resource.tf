@@ -0,0 +1,13 @@
+# Aggregate accounts at 5am, 1pm and 9pm every day
+resource "sailpoint_source_schedule" "accounts" {
+  source_id       = sailpoint_source.active_directory.id
+  type            = "ACCOUNT_AGGREGATION"
+  cron_expression = "0 0 5,13,21 * * ?"
+}
+
+# Aggregate entitlements at 2:30am on weekdays
+resource "sailpoint_source_schedule" "entitlements" {
+  source_id       = sailpoint_source.active_directory.id
+  type            = "GROUP_AGGREGATION"
+  cron_expression = "0 30 2 ? * MON-FRI"
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import the tags of an existing object by its type and ID
+terraform import sailpoint_tagged_object.payments_approver "ROLE/REPLACE_WITH_ROLE_ID"
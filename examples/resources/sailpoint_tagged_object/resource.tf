@@ -0,0 +1,9 @@
+# Tag a role so it can be found by compliance reporting
+resource "sailpoint_tagged_object" "payments_approver" {
+  object_ref = {
+    type = "ROLE"
+    id   = "REPLACE_WITH_ROLE_ID"
+  }
+
+  tags = ["finance", "sox"]
+}
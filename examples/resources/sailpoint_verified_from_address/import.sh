@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import a verified sender by its email address
+terraform import sailpoint_verified_from_address.iam_team "iam-team@example.com"
@@ -0,0 +1,5 @@
+# Allow notifications to be sent from a custom address.
+# SailPoint emails a verification link to the address when it is added.
+resource "sailpoint_verified_from_address" "iam_team" {
+  email = "iam-team@example.com"
+}
@@ -13,6 +13,7 @@ import (
 )
 
 const (
+	accessProfileEndpointList   = "/v2025/access-profiles"
 	accessProfileEndpointGet    = "/v2025/access-profiles/{id}"
 	accessProfileEndpointCreate = "/v2025/access-profiles"
 	accessProfileEndpointPatch  = "/v2025/access-profiles/{id}"
@@ -56,11 +57,17 @@ type AccessDurationAPI struct {
 	TimeUnit *string `json:"timeUnit,omitempty"`
 }
 
+// AccessDurationTimeUnits lists the valid AccessDurationAPI.TimeUnit values.
+var AccessDurationTimeUnits = []string{"HOURS", "DAYS", "WEEKS", "MONTHS"}
+
 type ApprovalSchemeAPI struct {
 	ApproverType string  `json:"approverType"`
 	ApproverID   *string `json:"approverId,omitempty"`
 }
 
+// AccessProfileApproverTypes lists the valid ApprovalSchemeAPI.ApproverType values for access profiles.
+var AccessProfileApproverTypes = []string{"APP_OWNER", "OWNER", "SOURCE_OWNER", "MANAGER", ObjectRefTypeGovernanceGroup, ObjectRefTypeWorkflow}
+
 // ProvisioningCriteriaAPI is a recursive tree. Max 3 levels per SailPoint constraints.
 type ProvisioningCriteriaAPI struct {
 	Operation string                    `json:"operation,omitempty"`
@@ -69,6 +76,9 @@ type ProvisioningCriteriaAPI struct {
 	Children  []ProvisioningCriteriaAPI `json:"children,omitempty"`
 }
 
+// ProvisioningCriteriaOperations lists the valid ProvisioningCriteriaAPI.Operation values.
+var ProvisioningCriteriaOperations = []string{"AND", "OR", "EQUALS", "NOT_EQUALS", "CONTAINS", "HAS"}
+
 type accessProfileErrorContext struct {
 	Operation    string
 	ID           string
@@ -76,6 +86,22 @@ type accessProfileErrorContext struct {
 	ResponseBody string
 }
 
+// ListAccessProfiles retrieves all access profiles matching the given filter expression (e.g., `source.id eq "2c9180835d191a86015d28455b4a2329"`),
+// following pagination. Pass an empty string to omit the filter.
+func (c *Client) ListAccessProfiles(ctx context.Context, filters string) ([]AccessProfileAPI, error) {
+	tflog.Debug(ctx, "Listing access profiles", map[string]any{"filters": filters})
+
+	accessProfiles, err := listPages[AccessProfileAPI](ctx, c, accessProfileEndpointList, nil, filters, func(err error, statusCode int, responseBody string) error {
+		return c.formatAccessProfileError(accessProfileErrorContext{Operation: "list", ResponseBody: responseBody}, err, statusCode)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Successfully listed access profiles", map[string]any{"count": len(accessProfiles)})
+	return accessProfiles, nil
+}
+
 func (c *Client) GetAccessProfile(ctx context.Context, id string) (*AccessProfileAPI, error) {
 	if id == "" {
 		return nil, fmt.Errorf("access profile ID cannot be empty")
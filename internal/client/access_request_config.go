@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	accessRequestConfigEndpoint = "/v2025/access-request-config"
+)
+
+// AccessRequestConfigAPI represents the tenant-wide access request configuration from the API.
+type AccessRequestConfigAPI struct {
+	ApprovalsMustBeExternal             bool                                    `json:"approvalsMustBeExternal"`
+	AutoApprovalEnabled                 bool                                    `json:"autoApprovalEnabled"`
+	ReauthorizationEnabled              bool                                    `json:"reauthorizationEnabled"`
+	RequestOnBehalfOfConfig             *RequestOnBehalfOfConfigAPI             `json:"requestOnBehalfOfConfig,omitempty"`
+	ApprovalReminderAndEscalationConfig *ApprovalReminderAndEscalationConfigAPI `json:"approvalReminderAndEscalationConfig,omitempty"`
+	EntitlementRequestConfig            *EntitlementRequestConfigAPI            `json:"entitlementRequestConfig,omitempty"`
+}
+
+// RequestOnBehalfOfConfigAPI controls who may request access for someone else.
+type RequestOnBehalfOfConfigAPI struct {
+	AllowRequestOnBehalfOfAnyoneByAnyone    bool `json:"allowRequestOnBehalfOfAnyoneByAnyone"`
+	AllowRequestOnBehalfOfEmployeeByManager bool `json:"allowRequestOnBehalfOfEmployeeByManager"`
+}
+
+// ApprovalReminderAndEscalationConfigAPI controls approval reminders and the reassignment of
+// pending approvals to a fallback approver.
+type ApprovalReminderAndEscalationConfigAPI struct {
+	DaysUntilEscalation  *int64        `json:"daysUntilEscalation,omitempty"`
+	DaysBetweenReminders *int64        `json:"daysBetweenReminders,omitempty"`
+	MaxReminders         *int64        `json:"maxReminders,omitempty"`
+	FallbackApproverRef  *ObjectRefAPI `json:"fallbackApproverRef,omitempty"`
+}
+
+// EntitlementRequestConfigAPI controls requests for individual entitlements.
+type EntitlementRequestConfigAPI struct {
+	AllowEntitlementRequest     bool    `json:"allowEntitlementRequest"`
+	RequestCommentsRequired     bool    `json:"requestCommentsRequired"`
+	DeniedCommentsRequired      bool    `json:"deniedCommentsRequired"`
+	GrantRequestApprovalSchemes *string `json:"grantRequestApprovalSchemes,omitempty"`
+}
+
+// DefaultAccessRequestConfig returns the access request configuration documented as the tenant default.
+// Reminder and escalation settings have no documented default and are left out.
+func DefaultAccessRequestConfig() *AccessRequestConfigAPI {
+	approvalSchemes := "sourceOwner"
+	return &AccessRequestConfigAPI{
+		RequestOnBehalfOfConfig: &RequestOnBehalfOfConfigAPI{},
+		EntitlementRequestConfig: &EntitlementRequestConfigAPI{
+			GrantRequestApprovalSchemes: &approvalSchemes,
+		},
+	}
+}
+
+// accessRequestConfigErrorContext provides context for error messages.
+type accessRequestConfigErrorContext struct {
+	Operation    string
+	ResponseBody string
+}
+
+// GetAccessRequestConfig retrieves the tenant-wide access request configuration.
+func (c *Client) GetAccessRequestConfig(ctx context.Context) (*AccessRequestConfigAPI, error) {
+	tflog.Debug(ctx, "Getting access request config")
+
+	var config AccessRequestConfigAPI
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&config).
+		Get(accessRequestConfigEndpoint)
+
+	if err != nil {
+		return nil, c.formatAccessRequestConfigError(accessRequestConfigErrorContext{Operation: "get"}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatAccessRequestConfigError(
+			accessRequestConfigErrorContext{Operation: "get", ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully retrieved access request config")
+	return &config, nil
+}
+
+// UpdateAccessRequestConfig replaces the tenant-wide access request configuration (PUT).
+func (c *Client) UpdateAccessRequestConfig(ctx context.Context, config *AccessRequestConfigAPI) (*AccessRequestConfigAPI, error) {
+	if config == nil {
+		return nil, fmt.Errorf("access request config cannot be nil")
+	}
+
+	requestBody, _ := json.Marshal(config)
+	tflog.Debug(ctx, "Updating access request config", map[string]any{
+		"request_body": string(requestBody),
+	})
+
+	var result AccessRequestConfigAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(config).
+		SetResult(&result).
+		Put(accessRequestConfigEndpoint)
+
+	if err != nil {
+		return nil, c.formatAccessRequestConfigError(accessRequestConfigErrorContext{Operation: "update"}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatAccessRequestConfigError(
+			accessRequestConfigErrorContext{Operation: "update", ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully updated access request config")
+	return &result, nil
+}
+
+func (c *Client) formatAccessRequestConfigError(errCtx accessRequestConfigErrorContext, err error, statusCode int) error {
+	baseMsg := fmt.Sprintf("failed to %s access request config", errCtx.Operation)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", baseMsg, err)
+	}
+
+	if statusCode != 0 {
+		detail := ""
+		if errCtx.ResponseBody != "" {
+			detail = fmt.Sprintf(" - response: %s", errCtx.ResponseBody)
+		}
+		switch statusCode {
+		case http.StatusBadRequest:
+			return fmt.Errorf("%s: invalid request (400)%s", baseMsg, detail)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+		case http.StatusForbidden:
+			return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+		case http.StatusConflict:
+			return fmt.Errorf("%s: conflict (409)%s", baseMsg, detail)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+		case http.StatusInternalServerError:
+			return fmt.Errorf("%s: server error (500)%s", baseMsg, detail)
+		default:
+			return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+		}
+	}
+
+	return fmt.Errorf("%s: unknown error", baseMsg)
+}
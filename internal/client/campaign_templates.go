@@ -0,0 +1,399 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	campaignTemplateEndpointGet      = "/v2025/campaign-templates/{id}"
+	campaignTemplateEndpointCreate   = "/v2025/campaign-templates"
+	campaignTemplateEndpointPatch    = "/v2025/campaign-templates/{id}"
+	campaignTemplateEndpointDelete   = "/v2025/campaign-templates/{id}"
+	campaignTemplateEndpointSchedule = "/v2025/campaign-templates/{id}/schedule"
+)
+
+// CampaignTemplateAPI represents a SailPoint certification campaign template from the API.
+type CampaignTemplateAPI struct {
+	ID               string                      `json:"id,omitempty"`
+	Name             string                      `json:"name"`
+	Description      string                      `json:"description"`
+	DeadlineDuration *string                     `json:"deadlineDuration,omitempty"`
+	OwnerRef         *ObjectRefAPI               `json:"ownerRef,omitempty"`
+	Scheduled        *bool                       `json:"scheduled,omitempty"`
+	Campaign         CampaignTemplateCampaignAPI `json:"campaign"`
+	Created          *string                     `json:"created,omitempty"`
+	Modified         *string                     `json:"modified,omitempty"`
+}
+
+// CampaignTemplateCampaignAPI is the campaign generated from a template. Its name and description
+// may contain placeholders (e.g., `${campaignDate}`) resolved when a campaign is generated.
+type CampaignTemplateCampaignAPI struct {
+	Name                        string                          `json:"name"`
+	Description                 string                          `json:"description"`
+	Type                        string                          `json:"type"`
+	EmailNotificationEnabled    *bool                           `json:"emailNotificationEnabled,omitempty"`
+	AutoRevokeAllowed           *bool                           `json:"autoRevokeAllowed,omitempty"`
+	RecommendationsEnabled      *bool                           `json:"recommendationsEnabled,omitempty"`
+	SunsetCommentsRequired      *bool                           `json:"sunsetCommentsRequired,omitempty"`
+	MandatoryCommentRequirement *string                         `json:"mandatoryCommentRequirement,omitempty"`
+	Filter                      *ObjectRefAPI                   `json:"filter,omitempty"`
+	SourceOwnerCampaignInfo     *SourceOwnerCampaignInfoAPI     `json:"sourceOwnerCampaignInfo,omitempty"`
+	SearchCampaignInfo          *SearchCampaignInfoAPI          `json:"searchCampaignInfo,omitempty"`
+	RoleCompositionCampaignInfo *RoleCompositionCampaignInfoAPI `json:"roleCompositionCampaignInfo,omitempty"`
+}
+
+// Campaign types supported by campaign templates.
+const (
+	CampaignTypeManager         = "MANAGER"
+	CampaignTypeSourceOwner     = "SOURCE_OWNER"
+	CampaignTypeSearch          = "SEARCH"
+	CampaignTypeRoleComposition = "ROLE_COMPOSITION"
+)
+
+// CampaignTypes lists the valid CampaignTemplateCampaignAPI.Type values.
+var CampaignTypes = []string{CampaignTypeManager, CampaignTypeSourceOwner, CampaignTypeSearch, CampaignTypeRoleComposition}
+
+// CampaignMandatoryCommentRequirements lists the valid CampaignTemplateCampaignAPI.MandatoryCommentRequirement values.
+var CampaignMandatoryCommentRequirements = []string{"ALL_DECISIONS", "REVOKE_ONLY_DECISIONS", "NO_DECISIONS"}
+
+// CampaignFilterType is the object reference type of a campaign filter.
+const CampaignFilterType = "CAMPAIGN_FILTER"
+
+// CampaignReviewerTypes lists the object types that can review a search or role composition campaign.
+var CampaignReviewerTypes = []string{ObjectRefTypeIdentity, ObjectRefTypeGovernanceGroup}
+
+// SearchCampaignTypes lists the valid SearchCampaignInfoAPI.Type values.
+var SearchCampaignTypes = []string{"IDENTITY", "ACCESS"}
+
+// CampaignTemplateScheduleTypes lists the schedule types accepted for campaign templates.
+var CampaignTemplateScheduleTypes = []string{"WEEKLY", "MONTHLY", "ANNUALLY", "CALENDAR"}
+
+// SourceOwnerCampaignInfoAPI selects the sources certified by a SOURCE_OWNER campaign.
+type SourceOwnerCampaignInfoAPI struct {
+	SourceIDs []string `json:"sourceIds"`
+}
+
+// SearchCampaignInfoAPI selects the identities or access certified by a SEARCH campaign.
+type SearchCampaignInfoAPI struct {
+	Type        string        `json:"type"`
+	Description *string       `json:"description,omitempty"`
+	Reviewer    *ObjectRefAPI `json:"reviewer,omitempty"`
+	Query       *string       `json:"query,omitempty"`
+	IdentityIDs []string      `json:"identityIds,omitempty"`
+}
+
+// RoleCompositionCampaignInfoAPI selects the roles certified by a ROLE_COMPOSITION campaign.
+type RoleCompositionCampaignInfoAPI struct {
+	Reviewer      *ObjectRefAPI `json:"reviewer,omitempty"`
+	RoleIDs       []string      `json:"roleIds,omitempty"`
+	RemediatorRef ObjectRefAPI  `json:"remediatorRef"`
+	Query         *string       `json:"query,omitempty"`
+	Description   *string       `json:"description,omitempty"`
+}
+
+// campaignTemplateErrorContext provides context for error messages.
+type campaignTemplateErrorContext struct {
+	Operation    string
+	ID           string
+	Name         string
+	ResponseBody string
+}
+
+// GetCampaignTemplate retrieves a specific campaign template by ID.
+func (c *Client) GetCampaignTemplate(ctx context.Context, id string) (*CampaignTemplateAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("campaign template ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Getting campaign template", map[string]any{"id": id})
+
+	var template CampaignTemplateAPI
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&template).
+		SetPathParam("id", id).
+		Get(campaignTemplateEndpointGet)
+
+	if err != nil {
+		return nil, c.formatCampaignTemplateError(campaignTemplateErrorContext{Operation: "get", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatCampaignTemplateError(
+			campaignTemplateErrorContext{Operation: "get", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully retrieved campaign template", map[string]any{
+		"id":   id,
+		"name": template.Name,
+	})
+	return &template, nil
+}
+
+// CreateCampaignTemplate creates a new campaign template. The schedule is managed separately
+// through SetCampaignTemplateSchedule.
+func (c *Client) CreateCampaignTemplate(ctx context.Context, template *CampaignTemplateAPI) (*CampaignTemplateAPI, error) {
+	if template == nil {
+		return nil, fmt.Errorf("campaign template cannot be nil")
+	}
+	if template.Name == "" {
+		return nil, fmt.Errorf("campaign template name cannot be empty")
+	}
+
+	requestBody, _ := json.Marshal(template)
+	tflog.Debug(ctx, "Creating campaign template", map[string]any{
+		"name":         template.Name,
+		"request_body": string(requestBody),
+	})
+
+	var result CampaignTemplateAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(template).
+		SetResult(&result).
+		Post(campaignTemplateEndpointCreate)
+
+	if err != nil {
+		return nil, c.formatCampaignTemplateError(campaignTemplateErrorContext{Operation: "create", Name: template.Name}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatCampaignTemplateError(
+			campaignTemplateErrorContext{Operation: "create", Name: template.Name, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully created campaign template", map[string]any{
+		"id":   result.ID,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// PatchCampaignTemplate applies a JSON Patch document to the campaign template and returns the updated state.
+// When patchOps is empty, it simply fetches and returns the current state.
+func (c *Client) PatchCampaignTemplate(ctx context.Context, id string, patchOps []JSONPatchOperation) (*CampaignTemplateAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("campaign template ID cannot be empty")
+	}
+	if len(patchOps) == 0 {
+		return c.GetCampaignTemplate(ctx, id)
+	}
+
+	requestBody, _ := json.Marshal(patchOps)
+	tflog.Debug(ctx, "Updating campaign template (PATCH)", map[string]any{
+		"id":               id,
+		"operations_count": len(patchOps),
+		"request_body":     string(requestBody),
+	})
+
+	var result CampaignTemplateAPI
+	resp, err := c.prepareRequest(ctx).
+		SetHeader("Content-Type", "application/json-patch+json").
+		SetBody(patchOps).
+		SetResult(&result).
+		SetPathParam("id", id).
+		Patch(campaignTemplateEndpointPatch)
+
+	if err != nil {
+		return nil, c.formatCampaignTemplateError(campaignTemplateErrorContext{Operation: "update", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatCampaignTemplateError(
+			campaignTemplateErrorContext{Operation: "update", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully updated campaign template", map[string]any{
+		"id":   id,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// DeleteCampaignTemplate deletes a campaign template by ID. 404 is treated as success.
+func (c *Client) DeleteCampaignTemplate(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("campaign template ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Deleting campaign template", map[string]any{"id": id})
+
+	resp, err := c.prepareRequest(ctx).
+		SetPathParam("id", id).
+		Delete(campaignTemplateEndpointDelete)
+
+	if err != nil {
+		return c.formatCampaignTemplateError(campaignTemplateErrorContext{Operation: "delete", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		if resp.StatusCode() == http.StatusNotFound {
+			tflog.Debug(ctx, "Campaign template not found, treating as already deleted", map[string]any{"id": id})
+			return nil
+		}
+		return c.formatCampaignTemplateError(
+			campaignTemplateErrorContext{Operation: "delete", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully deleted campaign template", map[string]any{"id": id})
+	return nil
+}
+
+// GetCampaignTemplateSchedule retrieves the schedule on which campaigns are generated from a template.
+// Returns an error wrapping ErrNotFound when the template is not scheduled.
+func (c *Client) GetCampaignTemplateSchedule(ctx context.Context, id string) (*ScheduleAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("campaign template ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Getting campaign template schedule", map[string]any{"id": id})
+
+	var schedule ScheduleAPI
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&schedule).
+		SetPathParam("id", id).
+		Get(campaignTemplateEndpointSchedule)
+
+	if err != nil {
+		return nil, c.formatCampaignTemplateError(campaignTemplateErrorContext{Operation: "get schedule of", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatCampaignTemplateError(
+			campaignTemplateErrorContext{Operation: "get schedule of", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully retrieved campaign template schedule", map[string]any{"id": id})
+	return &schedule, nil
+}
+
+// SetCampaignTemplateSchedule creates or replaces the schedule of a campaign template.
+// The API does not return the schedule; use GetCampaignTemplateSchedule to read it back.
+func (c *Client) SetCampaignTemplateSchedule(ctx context.Context, id string, schedule *ScheduleAPI) error {
+	if id == "" {
+		return fmt.Errorf("campaign template ID cannot be empty")
+	}
+	if schedule == nil {
+		return fmt.Errorf("campaign template schedule cannot be nil")
+	}
+
+	requestBody, _ := json.Marshal(schedule)
+	tflog.Debug(ctx, "Setting campaign template schedule", map[string]any{
+		"id":           id,
+		"request_body": string(requestBody),
+	})
+
+	resp, err := c.prepareRequest(ctx).
+		SetBody(schedule).
+		SetPathParam("id", id).
+		Put(campaignTemplateEndpointSchedule)
+
+	if err != nil {
+		return c.formatCampaignTemplateError(campaignTemplateErrorContext{Operation: "set schedule of", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return c.formatCampaignTemplateError(
+			campaignTemplateErrorContext{Operation: "set schedule of", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully set campaign template schedule", map[string]any{"id": id})
+	return nil
+}
+
+// DeleteCampaignTemplateSchedule removes the schedule of a campaign template. 404 is treated as success.
+func (c *Client) DeleteCampaignTemplateSchedule(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("campaign template ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Deleting campaign template schedule", map[string]any{"id": id})
+
+	resp, err := c.prepareRequest(ctx).
+		SetPathParam("id", id).
+		Delete(campaignTemplateEndpointSchedule)
+
+	if err != nil {
+		return c.formatCampaignTemplateError(campaignTemplateErrorContext{Operation: "delete schedule of", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		if resp.StatusCode() == http.StatusNotFound {
+			tflog.Debug(ctx, "Campaign template schedule not found, treating as already deleted", map[string]any{"id": id})
+			return nil
+		}
+		return c.formatCampaignTemplateError(
+			campaignTemplateErrorContext{Operation: "delete schedule of", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully deleted campaign template schedule", map[string]any{"id": id})
+	return nil
+}
+
+func (c *Client) formatCampaignTemplateError(errCtx campaignTemplateErrorContext, err error, statusCode int) error {
+	var baseMsg string
+	switch {
+	case errCtx.ID != "":
+		baseMsg = fmt.Sprintf("failed to %s campaign template '%s'", errCtx.Operation, errCtx.ID)
+	case errCtx.Name != "":
+		baseMsg = fmt.Sprintf("failed to %s campaign template '%s'", errCtx.Operation, errCtx.Name)
+	default:
+		baseMsg = fmt.Sprintf("failed to %s campaign templates", errCtx.Operation)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", baseMsg, err)
+	}
+
+	if statusCode != 0 {
+		detail := ""
+		if errCtx.ResponseBody != "" {
+			detail = fmt.Sprintf(" - response: %s", errCtx.ResponseBody)
+		}
+		switch statusCode {
+		case http.StatusBadRequest:
+			return fmt.Errorf("%s: invalid request (400)%s", baseMsg, detail)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+		case http.StatusForbidden:
+			return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+		case http.StatusConflict:
+			return fmt.Errorf("%s: conflict (409)%s", baseMsg, detail)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+		case http.StatusInternalServerError:
+			return fmt.Errorf("%s: server error (500)%s", baseMsg, detail)
+		default:
+			return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+		}
+	}
+
+	return fmt.Errorf("%s: unknown error", baseMsg)
+}
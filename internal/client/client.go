@@ -26,6 +26,9 @@ type Client struct {
 	ClientID     string
 	ClientSecret string
 
+	// DefaultTags are the tags the provider adds to every taggable object it manages.
+	DefaultTags []string
+
 	token       string
 	tokenExpiry time.Time
 	tokenMutex  sync.RWMutex
@@ -128,3 +131,10 @@ func (c *Client) prepareRequest(ctx context.Context) *resty.Request {
 		SetContext(ctx).
 		SetHeader("Accept", "application/json")
 }
+
+// prepareExperimentalRequest prepares a request to an endpoint SailPoint still flags as
+// experimental, which must be opted into with a header.
+func (c *Client) prepareExperimentalRequest(ctx context.Context) *resty.Request {
+	return c.prepareRequest(ctx).
+		SetHeader("X-SailPoint-Experimental", "true")
+}
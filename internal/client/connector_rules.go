@@ -0,0 +1,349 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	connectorRuleEndpointGet      = "/v2025/connector-rules/{id}"
+	connectorRuleEndpointCreate   = "/v2025/connector-rules"
+	connectorRuleEndpointUpdate   = "/v2025/connector-rules/{id}"
+	connectorRuleEndpointDelete   = "/v2025/connector-rules/{id}"
+	connectorRuleEndpointValidate = "/v2025/connector-rules/validate"
+)
+
+// ConnectorRuleAPI represents a SailPoint cloud connector rule from the API.
+// Connector rules are BeanShell scripts run by the connector on the virtual appliance.
+type ConnectorRuleAPI struct {
+	ID          string                  `json:"id,omitempty"`
+	Name        string                  `json:"name"`
+	Description *string                 `json:"description,omitempty"`
+	Type        string                  `json:"type"`
+	Signature   *ConnectorRuleSignature `json:"signature,omitempty"`
+	SourceCode  ConnectorRuleSourceCode `json:"sourceCode"`
+	Attributes  *map[string]interface{} `json:"attributes,omitempty"`
+	Created     *string                 `json:"created,omitempty"`
+	Modified    *string                 `json:"modified,omitempty"`
+}
+
+// ConnectorRuleTypes lists the valid ConnectorRuleAPI.Type values.
+var ConnectorRuleTypes = []string{
+	"BuildMap",
+	"ConnectorAfterCreate",
+	"ConnectorAfterDelete",
+	"ConnectorAfterModify",
+	"ConnectorBeforeCreate",
+	"ConnectorBeforeDelete",
+	"ConnectorBeforeModify",
+	"JDBCBuildMap",
+	"JDBCOperationProvisioning",
+	"JDBCProvision",
+	"PeopleSoftHRMSBuildMap",
+	"PeopleSoftHRMSOperationProvisioning",
+	"PeopleSoftHRMSProvision",
+	"RACFPermissionCustomization",
+	"ResourceObjectCustomization",
+	"SAPBuildMap",
+	"SapHrManagerRule",
+	"SapHrOperationProvisioning",
+	"SapHrProvision",
+	"SuccessFactorsOperationProvisioning",
+	"WebServiceAfterOperationRule",
+	"WebServiceBeforeOperationRule",
+}
+
+// ConnectorRuleSignature describes the inputs a rule receives and the value it returns.
+type ConnectorRuleSignature struct {
+	Input  []ConnectorRuleArgument `json:"input"`
+	Output *ConnectorRuleArgument  `json:"output,omitempty"`
+}
+
+// ConnectorRuleArgument is a typed rule input or output.
+type ConnectorRuleArgument struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Type        *string `json:"type,omitempty"`
+}
+
+// ConnectorRuleSourceCode holds the rule script and the version of the language it is written in.
+type ConnectorRuleSourceCode struct {
+	Version string `json:"version"`
+	Script  string `json:"script"`
+}
+
+// ConnectorRuleValidationAPI is the result of validating a rule script.
+type ConnectorRuleValidationAPI struct {
+	State   string                              `json:"state"`
+	Details []ConnectorRuleValidationDetailsAPI `json:"details"`
+}
+
+// ConnectorRuleValidationStateOK is the ConnectorRuleValidationAPI.State of a valid script.
+const ConnectorRuleValidationStateOK = "OK"
+
+// ConnectorRuleValidationDetailsAPI is a single problem found in a rule script.
+type ConnectorRuleValidationDetailsAPI struct {
+	Line    int64  `json:"line"`
+	Column  int64  `json:"column"`
+	Message string `json:"message"`
+}
+
+// UnmarshalJSON accepts both "message" and "messsage", the misspelt key documented by the API.
+func (d *ConnectorRuleValidationDetailsAPI) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Line     int64  `json:"line"`
+		Column   int64  `json:"column"`
+		Message  string `json:"message"`
+		Messsage string `json:"messsage"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	d.Line, d.Column, d.Message = raw.Line, raw.Column, raw.Message
+	if d.Message == "" {
+		d.Message = raw.Messsage
+	}
+	return nil
+}
+
+// connectorRuleErrorContext provides context for error messages.
+type connectorRuleErrorContext struct {
+	Operation    string
+	ID           string
+	Name         string
+	ResponseBody string
+}
+
+// GetConnectorRule retrieves a specific connector rule by ID.
+func (c *Client) GetConnectorRule(ctx context.Context, id string) (*ConnectorRuleAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("connector rule ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Getting connector rule", map[string]any{"id": id})
+
+	var rule ConnectorRuleAPI
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&rule).
+		SetPathParam("id", id).
+		Get(connectorRuleEndpointGet)
+
+	if err != nil {
+		return nil, c.formatConnectorRuleError(connectorRuleErrorContext{Operation: "get", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatConnectorRuleError(
+			connectorRuleErrorContext{Operation: "get", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully retrieved connector rule", map[string]any{
+		"id":   id,
+		"name": rule.Name,
+	})
+	return &rule, nil
+}
+
+// CreateConnectorRule creates a new connector rule.
+func (c *Client) CreateConnectorRule(ctx context.Context, rule *ConnectorRuleAPI) (*ConnectorRuleAPI, error) {
+	if rule == nil {
+		return nil, fmt.Errorf("connector rule cannot be nil")
+	}
+	if rule.Name == "" {
+		return nil, fmt.Errorf("connector rule name cannot be empty")
+	}
+
+	requestBody, _ := json.Marshal(rule)
+	tflog.Debug(ctx, "Creating connector rule", map[string]any{
+		"name":         rule.Name,
+		"request_body": string(requestBody),
+	})
+
+	var result ConnectorRuleAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(rule).
+		SetResult(&result).
+		Post(connectorRuleEndpointCreate)
+
+	if err != nil {
+		return nil, c.formatConnectorRuleError(connectorRuleErrorContext{Operation: "create", Name: rule.Name}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatConnectorRuleError(
+			connectorRuleErrorContext{Operation: "create", Name: rule.Name, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully created connector rule", map[string]any{
+		"id":   result.ID,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// UpdateConnectorRule replaces an existing connector rule.
+func (c *Client) UpdateConnectorRule(ctx context.Context, id string, rule *ConnectorRuleAPI) (*ConnectorRuleAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("connector rule ID cannot be empty")
+	}
+	if rule == nil {
+		return nil, fmt.Errorf("connector rule cannot be nil")
+	}
+
+	// The update payload must carry the ID of the rule being replaced.
+	body := *rule
+	body.ID = id
+
+	requestBody, _ := json.Marshal(body)
+	tflog.Debug(ctx, "Updating connector rule", map[string]any{
+		"id":           id,
+		"request_body": string(requestBody),
+	})
+
+	var result ConnectorRuleAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(body).
+		SetResult(&result).
+		SetPathParam("id", id).
+		Put(connectorRuleEndpointUpdate)
+
+	if err != nil {
+		return nil, c.formatConnectorRuleError(connectorRuleErrorContext{Operation: "update", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatConnectorRuleError(
+			connectorRuleErrorContext{Operation: "update", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully updated connector rule", map[string]any{
+		"id":   id,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// DeleteConnectorRule deletes a connector rule by ID. 404 is treated as success.
+func (c *Client) DeleteConnectorRule(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("connector rule ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Deleting connector rule", map[string]any{"id": id})
+
+	resp, err := c.prepareRequest(ctx).
+		SetPathParam("id", id).
+		Delete(connectorRuleEndpointDelete)
+
+	if err != nil {
+		return c.formatConnectorRuleError(connectorRuleErrorContext{Operation: "delete", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		if resp.StatusCode() == http.StatusNotFound {
+			tflog.Debug(ctx, "Connector rule not found, treating as already deleted", map[string]any{"id": id})
+			return nil
+		}
+		return c.formatConnectorRuleError(
+			connectorRuleErrorContext{Operation: "delete", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully deleted connector rule", map[string]any{"id": id})
+	return nil
+}
+
+// ValidateConnectorRule checks a rule script for syntax errors and disallowed constructs without saving it.
+func (c *Client) ValidateConnectorRule(ctx context.Context, sourceCode *ConnectorRuleSourceCode) (*ConnectorRuleValidationAPI, error) {
+	if sourceCode == nil {
+		return nil, fmt.Errorf("connector rule source code cannot be nil")
+	}
+
+	tflog.Debug(ctx, "Validating connector rule source code", map[string]any{
+		"version":       sourceCode.Version,
+		"script_length": len(sourceCode.Script),
+	})
+
+	var result ConnectorRuleValidationAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(sourceCode).
+		SetResult(&result).
+		Post(connectorRuleEndpointValidate)
+
+	if err != nil {
+		return nil, c.formatConnectorRuleError(connectorRuleErrorContext{Operation: "validate"}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatConnectorRuleError(
+			connectorRuleErrorContext{Operation: "validate", ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully validated connector rule source code", map[string]any{
+		"state":   result.State,
+		"details": len(result.Details),
+	})
+	return &result, nil
+}
+
+func (c *Client) formatConnectorRuleError(errCtx connectorRuleErrorContext, err error, statusCode int) error {
+	var baseMsg string
+	switch {
+	case errCtx.ID != "":
+		baseMsg = fmt.Sprintf("failed to %s connector rule '%s'", errCtx.Operation, errCtx.ID)
+	case errCtx.Name != "":
+		baseMsg = fmt.Sprintf("failed to %s connector rule '%s'", errCtx.Operation, errCtx.Name)
+	default:
+		baseMsg = fmt.Sprintf("failed to %s connector rule", errCtx.Operation)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", baseMsg, err)
+	}
+
+	if statusCode != 0 {
+		detail := ""
+		if errCtx.ResponseBody != "" {
+			detail = fmt.Sprintf(" - response: %s", errCtx.ResponseBody)
+		}
+		switch statusCode {
+		case http.StatusBadRequest:
+			return fmt.Errorf("%s: invalid request (400)%s", baseMsg, detail)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+		case http.StatusForbidden:
+			return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+		case http.StatusConflict:
+			return fmt.Errorf("%s: conflict (409)%s", baseMsg, detail)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+		case http.StatusInternalServerError:
+			return fmt.Errorf("%s: server error (500)%s", baseMsg, detail)
+		default:
+			return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+		}
+	}
+
+	return fmt.Errorf("%s: unknown error", baseMsg)
+}
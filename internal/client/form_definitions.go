@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -85,6 +86,24 @@ type FormConditionEffectConfigAPI struct {
 	DefaultValueLabel string `json:"defaultValueLabel,omitempty"`
 }
 
+// Allowed values for the enum-like fields of a form definition.
+var (
+	FormInputTypes             = []string{"STRING", "ARRAY"}
+	FormUsedByTypes            = []string{"WORKFLOW", "SOURCE", "MySailPoint"}
+	FormElementTypes           = []string{"TEXT", "TOGGLE", "TEXTAREA", "HIDDEN", "PHONE", "EMAIL", "SELECT", "DATE", "SECTION", "COLUMN_SET", "IMAGE", "DESCRIPTION"}
+	FormConditionRuleOperators = []string{"AND", "OR"}
+	FormRuleSourceTypes        = []string{"INPUT", "ELEMENT"}
+	FormRuleOperators          = []string{"EQ", "NE", "CO", "NOT_CO", "IN", "NOT_IN", "EM", "NOT_EM", "SW", "NOT_SW", "EW", "NOT_EW"}
+	FormRuleValueTypes         = []string{"STRING", "STRING_LIST", "INPUT", "ELEMENT", "LIST", "BOOLEAN"}
+	FormEffectTypes            = []string{"HIDE", "SHOW", "DISABLE", "ENABLE", "REQUIRE", "OPTIONAL", "SUBMIT_MESSAGE", "SUBMIT_NOTIFICATION", "SET_DEFAULT_VALUE"}
+)
+
+// formDefinitionListAPI is a page of form definitions.
+type formDefinitionListAPI struct {
+	Count   int                 `json:"count"`
+	Results []FormDefinitionAPI `json:"results"`
+}
+
 // formErrorContext provides context for error messages.
 type formErrorContext struct {
 	Operation string
@@ -92,32 +111,34 @@ type formErrorContext struct {
 	Name      string
 }
 
-// ListFormDefinitions retrieves all form definitions from SailPoint.
-// Returns a slice of FormDefinitionAPI and any error encountered.
-func (c *Client) ListFormDefinitions(ctx context.Context) ([]FormDefinitionAPI, error) {
-	tflog.Debug(ctx, "Listing form definitions")
+// ListFormDefinitions retrieves all form definitions matching the given filter expression
+// (e.g., `name sw "Access"`), following pagination. Pass an empty string to omit the filter.
+func (c *Client) ListFormDefinitions(ctx context.Context, filters string) ([]FormDefinitionAPI, error) {
+	tflog.Debug(ctx, "Listing form definitions", map[string]any{"filters": filters})
 
 	var forms []FormDefinitionAPI
+	for offset := 0; ; offset += listPageSize {
+		var page formDefinitionListAPI
+		req := c.prepareRequest(ctx).
+			SetResult(&page).
+			SetQueryParam("limit", strconv.Itoa(listPageSize)).
+			SetQueryParam("offset", strconv.Itoa(offset))
+		if filters != "" {
+			req.SetQueryParam("filters", filters)
+		}
 
-	// Prepare the request
-	resp, err := c.prepareRequest(ctx).
-		SetResult(&forms).
-		Get(formDefinitionsEndpointList)
-
-	if err != nil {
-		return nil, c.formatFormError(
-			formErrorContext{Operation: "list"},
-			err,
-			0,
-		)
-	}
+		resp, err := req.Get(formDefinitionsEndpointList)
+		if err != nil {
+			return nil, c.formatFormError(formErrorContext{Operation: "list"}, err, 0)
+		}
+		if resp.IsError() {
+			return nil, c.formatFormError(formErrorContext{Operation: "list"}, nil, resp.StatusCode())
+		}
 
-	if resp.IsError() {
-		return nil, c.formatFormError(
-			formErrorContext{Operation: "list"},
-			nil,
-			resp.StatusCode(),
-		)
+		forms = append(forms, page.Results...)
+		if len(page.Results) < listPageSize {
+			break
+		}
 	}
 
 	tflog.Debug(ctx, "Successfully listed form definitions", map[string]any{
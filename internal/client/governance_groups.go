@@ -0,0 +1,367 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	governanceGroupEndpointList          = "/v2025/workgroups"
+	governanceGroupEndpointGet           = "/v2025/workgroups/{id}"
+	governanceGroupEndpointCreate        = "/v2025/workgroups"
+	governanceGroupEndpointPatch         = "/v2025/workgroups/{id}"
+	governanceGroupEndpointDelete        = "/v2025/workgroups/{id}"
+	governanceGroupEndpointMembers       = "/v2025/workgroups/{id}/members"
+	governanceGroupEndpointMembersAdd    = "/v2025/workgroups/{id}/members/bulk-add"
+	governanceGroupEndpointMembersRemove = "/v2025/workgroups/{id}/members/bulk-delete"
+
+	// governanceGroupMembersPageSize is the maximum page size accepted by the members endpoint.
+	governanceGroupMembersPageSize = 250
+	// governanceGroupMembersBatchSize is the maximum number of members accepted by a single bulk-add or bulk-delete call.
+	governanceGroupMembersBatchSize = 100
+)
+
+// GovernanceGroupAPI represents a SailPoint Governance Group (workgroup) from the API.
+type GovernanceGroupAPI struct {
+	ID              string        `json:"id,omitempty"`
+	Name            string        `json:"name"`
+	Description     *string       `json:"description,omitempty"`
+	Owner           *ObjectRefAPI `json:"owner,omitempty"`
+	MemberCount     *int64        `json:"memberCount,omitempty"`
+	ConnectionCount *int64        `json:"connectionCount,omitempty"`
+	Created         *string       `json:"created,omitempty"`
+	Modified        *string       `json:"modified,omitempty"`
+}
+
+// governanceGroupErrorContext provides context for error messages.
+type governanceGroupErrorContext struct {
+	Operation    string
+	ID           string
+	Name         string
+	ResponseBody string
+}
+
+// ListGovernanceGroups retrieves governance groups matching the given filter expression
+// (e.g., `name eq "Finance Approvers"`). Pass an empty string to omit the filter.
+func (c *Client) ListGovernanceGroups(ctx context.Context, filters string) ([]GovernanceGroupAPI, error) {
+	tflog.Debug(ctx, "Listing governance groups", map[string]any{"filters": filters})
+
+	var groups []GovernanceGroupAPI
+	req := c.prepareRequest(ctx).
+		SetResult(&groups)
+	if filters != "" {
+		req.SetQueryParam("filters", filters)
+	}
+
+	resp, err := req.Get(governanceGroupEndpointList)
+	if err != nil {
+		return nil, c.formatGovernanceGroupError(governanceGroupErrorContext{Operation: "list"}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatGovernanceGroupError(
+			governanceGroupErrorContext{Operation: "list", ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully listed governance groups", map[string]any{"count": len(groups)})
+	return groups, nil
+}
+
+// GetGovernanceGroup retrieves a specific governance group by ID.
+func (c *Client) GetGovernanceGroup(ctx context.Context, id string) (*GovernanceGroupAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("governance group ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Getting governance group", map[string]any{"id": id})
+
+	var group GovernanceGroupAPI
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&group).
+		SetPathParam("id", id).
+		Get(governanceGroupEndpointGet)
+
+	if err != nil {
+		return nil, c.formatGovernanceGroupError(governanceGroupErrorContext{Operation: "get", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatGovernanceGroupError(
+			governanceGroupErrorContext{Operation: "get", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully retrieved governance group", map[string]any{
+		"id":   id,
+		"name": group.Name,
+	})
+	return &group, nil
+}
+
+// CreateGovernanceGroup creates a new governance group. Members are managed separately
+// through AddGovernanceGroupMembers.
+func (c *Client) CreateGovernanceGroup(ctx context.Context, group *GovernanceGroupAPI) (*GovernanceGroupAPI, error) {
+	if group == nil {
+		return nil, fmt.Errorf("governance group cannot be nil")
+	}
+	if group.Name == "" {
+		return nil, fmt.Errorf("governance group name cannot be empty")
+	}
+
+	requestBody, _ := json.Marshal(group)
+	tflog.Debug(ctx, "Creating governance group", map[string]any{
+		"name":         group.Name,
+		"request_body": string(requestBody),
+	})
+
+	var result GovernanceGroupAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(group).
+		SetResult(&result).
+		Post(governanceGroupEndpointCreate)
+
+	if err != nil {
+		return nil, c.formatGovernanceGroupError(governanceGroupErrorContext{Operation: "create", Name: group.Name}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatGovernanceGroupError(
+			governanceGroupErrorContext{Operation: "create", Name: group.Name, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully created governance group", map[string]any{
+		"id":   result.ID,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// PatchGovernanceGroup applies a JSON Patch document to the governance group and returns the updated state.
+// When patchOps is empty, it simply fetches and returns the current state.
+func (c *Client) PatchGovernanceGroup(ctx context.Context, id string, patchOps []JSONPatchOperation) (*GovernanceGroupAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("governance group ID cannot be empty")
+	}
+	if len(patchOps) == 0 {
+		return c.GetGovernanceGroup(ctx, id)
+	}
+
+	requestBody, _ := json.Marshal(patchOps)
+	tflog.Debug(ctx, "Updating governance group (PATCH)", map[string]any{
+		"id":               id,
+		"operations_count": len(patchOps),
+		"request_body":     string(requestBody),
+	})
+
+	var result GovernanceGroupAPI
+	resp, err := c.prepareRequest(ctx).
+		SetHeader("Content-Type", "application/json-patch+json").
+		SetBody(patchOps).
+		SetResult(&result).
+		SetPathParam("id", id).
+		Patch(governanceGroupEndpointPatch)
+
+	if err != nil {
+		return nil, c.formatGovernanceGroupError(governanceGroupErrorContext{Operation: "update", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatGovernanceGroupError(
+			governanceGroupErrorContext{Operation: "update", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully updated governance group", map[string]any{
+		"id":   id,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// DeleteGovernanceGroup deletes a governance group by ID. 404 is treated as success.
+func (c *Client) DeleteGovernanceGroup(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("governance group ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Deleting governance group", map[string]any{"id": id})
+
+	resp, err := c.prepareRequest(ctx).
+		SetPathParam("id", id).
+		Delete(governanceGroupEndpointDelete)
+
+	if err != nil {
+		return c.formatGovernanceGroupError(governanceGroupErrorContext{Operation: "delete", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		if resp.StatusCode() == http.StatusNotFound {
+			tflog.Debug(ctx, "Governance group not found, treating as already deleted", map[string]any{"id": id})
+			return nil
+		}
+		return c.formatGovernanceGroupError(
+			governanceGroupErrorContext{Operation: "delete", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully deleted governance group", map[string]any{"id": id})
+	return nil
+}
+
+// ListGovernanceGroupMembers retrieves all members of a governance group, following pagination.
+func (c *Client) ListGovernanceGroupMembers(ctx context.Context, id string) ([]ObjectRefAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("governance group ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Listing governance group members", map[string]any{"id": id})
+
+	var members []ObjectRefAPI
+	for offset := 0; ; offset += governanceGroupMembersPageSize {
+		var page []ObjectRefAPI
+		resp, err := c.prepareRequest(ctx).
+			SetResult(&page).
+			SetPathParam("id", id).
+			SetQueryParam("limit", strconv.Itoa(governanceGroupMembersPageSize)).
+			SetQueryParam("offset", strconv.Itoa(offset)).
+			Get(governanceGroupEndpointMembers)
+
+		if err != nil {
+			return nil, c.formatGovernanceGroupError(governanceGroupErrorContext{Operation: "list members of", ID: id}, err, 0)
+		}
+		if resp.IsError() {
+			return nil, c.formatGovernanceGroupError(
+				governanceGroupErrorContext{Operation: "list members of", ID: id, ResponseBody: string(resp.Bytes())},
+				nil, resp.StatusCode(),
+			)
+		}
+
+		members = append(members, page...)
+		if len(page) < governanceGroupMembersPageSize {
+			break
+		}
+	}
+
+	tflog.Debug(ctx, "Successfully listed governance group members", map[string]any{
+		"id":    id,
+		"count": len(members),
+	})
+	return members, nil
+}
+
+// AddGovernanceGroupMembers adds identities to a governance group, in batches accepted by the bulk-add endpoint.
+func (c *Client) AddGovernanceGroupMembers(ctx context.Context, id string, identityIDs []string) error {
+	return c.bulkUpdateGovernanceGroupMembers(ctx, "add members to", governanceGroupEndpointMembersAdd, id, identityIDs)
+}
+
+// RemoveGovernanceGroupMembers removes identities from a governance group, in batches accepted by the bulk-delete endpoint.
+func (c *Client) RemoveGovernanceGroupMembers(ctx context.Context, id string, identityIDs []string) error {
+	return c.bulkUpdateGovernanceGroupMembers(ctx, "remove members from", governanceGroupEndpointMembersRemove, id, identityIDs)
+}
+
+func (c *Client) bulkUpdateGovernanceGroupMembers(ctx context.Context, operation, endpoint, id string, identityIDs []string) error {
+	if id == "" {
+		return fmt.Errorf("governance group ID cannot be empty")
+	}
+
+	for start := 0; start < len(identityIDs); start += governanceGroupMembersBatchSize {
+		end := min(start+governanceGroupMembersBatchSize, len(identityIDs))
+		batch := make([]ObjectRefAPI, 0, end-start)
+		for _, identityID := range identityIDs[start:end] {
+			batch = append(batch, ObjectRefAPI{Type: ObjectRefTypeIdentity, ID: identityID})
+		}
+
+		tflog.Debug(ctx, "Updating governance group members", map[string]any{
+			"id":        id,
+			"operation": operation,
+			"count":     len(batch),
+		})
+
+		resp, err := c.prepareRequest(ctx).
+			SetBody(batch).
+			SetPathParam("id", id).
+			Post(endpoint)
+
+		if err != nil {
+			return c.formatGovernanceGroupError(governanceGroupErrorContext{Operation: operation, ID: id}, err, 0)
+		}
+		if resp.IsError() {
+			tflog.Error(ctx, "SailPoint API error response", map[string]any{
+				"status_code":   resp.StatusCode(),
+				"response_body": string(resp.Bytes()),
+			})
+			return c.formatGovernanceGroupError(
+				governanceGroupErrorContext{Operation: operation, ID: id, ResponseBody: string(resp.Bytes())},
+				nil, resp.StatusCode(),
+			)
+		}
+	}
+
+	tflog.Info(ctx, "Successfully updated governance group members", map[string]any{
+		"id":        id,
+		"operation": operation,
+		"count":     len(identityIDs),
+	})
+	return nil
+}
+
+func (c *Client) formatGovernanceGroupError(errCtx governanceGroupErrorContext, err error, statusCode int) error {
+	var baseMsg string
+	switch {
+	case errCtx.ID != "":
+		baseMsg = fmt.Sprintf("failed to %s governance group '%s'", errCtx.Operation, errCtx.ID)
+	case errCtx.Name != "":
+		baseMsg = fmt.Sprintf("failed to %s governance group '%s'", errCtx.Operation, errCtx.Name)
+	default:
+		baseMsg = fmt.Sprintf("failed to %s governance groups", errCtx.Operation)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", baseMsg, err)
+	}
+
+	if statusCode != 0 {
+		detail := ""
+		if errCtx.ResponseBody != "" {
+			detail = fmt.Sprintf(" - response: %s", errCtx.ResponseBody)
+		}
+		switch statusCode {
+		case http.StatusBadRequest:
+			return fmt.Errorf("%s: invalid request (400)%s", baseMsg, detail)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+		case http.StatusForbidden:
+			return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+		case http.StatusConflict:
+			return fmt.Errorf("%s: conflict (409)%s", baseMsg, detail)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+		case http.StatusInternalServerError:
+			return fmt.Errorf("%s: server error (500)%s", baseMsg, detail)
+		default:
+			return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+		}
+	}
+
+	return fmt.Errorf("%s: unknown error", baseMsg)
+}
@@ -13,11 +13,13 @@ import (
 )
 
 const (
-	identityProfilesEndpointList   = "/v2025/identity-profiles"
-	identityProfilesEndpointGet    = "/v2025/identity-profiles/{profileId}"
-	identityProfilesEndpointCreate = "/v2025/identity-profiles"
-	identityProfilesEndpointPatch  = "/v2025/identity-profiles/{profileId}"
-	identityProfilesEndpointDelete = "/v2025/identity-profiles/{profileId}"
+	identityProfilesEndpointList    = "/v2025/identity-profiles"
+	identityProfilesEndpointGet     = "/v2025/identity-profiles/{profileId}"
+	identityProfilesEndpointCreate  = "/v2025/identity-profiles"
+	identityProfilesEndpointPatch   = "/v2025/identity-profiles/{profileId}"
+	identityProfilesEndpointDelete  = "/v2025/identity-profiles/{profileId}"
+	identityProfilesEndpointPreview = "/v2025/identity-profiles/identity-preview"
+	identityProfilesEndpointProcess = "/v2025/identity-profiles/{profileId}/process-identities"
 )
 
 // IdentityProfileAPI represents a SailPoint Identity Profile from the API.
@@ -71,7 +73,7 @@ type IdentityExceptionReportRefAPI struct {
 	ReportName   string `json:"reportName,omitempty"`
 }
 
-// TaskResultSimplifiedAPI represents the simplified task result returned by delete operations.
+// TaskResultSimplifiedAPI represents the simplified task result returned by delete and process operations.
 type TaskResultSimplifiedAPI struct {
 	ID               string `json:"id,omitempty"`
 	Name             string `json:"name,omitempty"`
@@ -82,6 +84,34 @@ type TaskResultSimplifiedAPI struct {
 	CompletionStatus string `json:"completionStatus,omitempty"`
 }
 
+// IdentityPreviewRequestAPI represents the request body for previewing an identity attribute configuration.
+type IdentityPreviewRequestAPI struct {
+	IdentityID              string                     `json:"identityId"`
+	IdentityAttributeConfig IdentityAttributeConfigAPI `json:"identityAttributeConfig"`
+}
+
+// IdentityPreviewResponseAPI represents the identity attribute values produced by a previewed configuration.
+type IdentityPreviewResponseAPI struct {
+	Identity          *ObjectRefAPI                 `json:"identity,omitempty"`
+	PreviewAttributes []IdentityPreviewAttributeAPI `json:"previewAttributes,omitempty"`
+}
+
+// IdentityPreviewAttributeAPI represents a single previewed identity attribute.
+// Value and PreviousValue are usually strings but can be any JSON value (e.g. lists for multi-valued attributes).
+type IdentityPreviewAttributeAPI struct {
+	Name          string                     `json:"name"`
+	Value         interface{}                `json:"value,omitempty"`
+	PreviousValue interface{}                `json:"previousValue,omitempty"`
+	ErrorMessages []LocalizedErrorMessageAPI `json:"errorMessages,omitempty"`
+}
+
+// LocalizedErrorMessageAPI represents a localized error message returned by the API.
+type LocalizedErrorMessageAPI struct {
+	Locale       string `json:"locale,omitempty"`
+	LocaleOrigin string `json:"localeOrigin,omitempty"`
+	Text         string `json:"text,omitempty"`
+}
+
 // identityProfileErrorContext provides context for error messages.
 type identityProfileErrorContext struct {
 	Operation    string
@@ -90,6 +120,22 @@ type identityProfileErrorContext struct {
 	ResponseBody string
 }
 
+// ListIdentityProfiles retrieves all identity profiles matching the given filter expression (e.g., `name sw "Employees"`),
+// following pagination. Pass an empty string to omit the filter.
+func (c *Client) ListIdentityProfiles(ctx context.Context, filters string) ([]IdentityProfileAPI, error) {
+	tflog.Debug(ctx, "Listing identity profiles", map[string]any{"filters": filters})
+
+	profiles, err := listPages[IdentityProfileAPI](ctx, c, identityProfilesEndpointList, nil, filters, func(err error, statusCode int, responseBody string) error {
+		return c.formatIdentityProfileError(identityProfileErrorContext{Operation: "list", ResponseBody: responseBody}, err, statusCode)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Successfully listed identity profiles", map[string]any{"count": len(profiles)})
+	return profiles, nil
+}
+
 // GetIdentityProfile retrieves a specific identity profile by ID.
 // Returns the IdentityProfileAPI and any error encountered.
 func (c *Client) GetIdentityProfile(ctx context.Context, id string) (*IdentityProfileAPI, error) {
@@ -292,6 +338,97 @@ func (c *Client) DeleteIdentityProfile(ctx context.Context, id string) (*TaskRes
 	return &taskResult, nil
 }
 
+// PreviewIdentityProfile previews the identity attribute values an identity would get
+// with the given identity attribute configuration, without saving anything.
+// Returns the IdentityPreviewResponseAPI and any error encountered.
+func (c *Client) PreviewIdentityProfile(ctx context.Context, preview *IdentityPreviewRequestAPI) (*IdentityPreviewResponseAPI, error) {
+	if preview == nil {
+		return nil, fmt.Errorf("identity preview request cannot be nil")
+	}
+
+	if preview.IdentityID == "" {
+		return nil, fmt.Errorf("identity ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Previewing identity profile attribute configuration", map[string]any{
+		"identity_id":          preview.IdentityID,
+		"attribute_transforms": len(preview.IdentityAttributeConfig.AttributeTransforms),
+	})
+
+	var result IdentityPreviewResponseAPI
+
+	resp, err := c.prepareRequest(ctx).
+		SetBody(preview).
+		SetResult(&result).
+		Post(identityProfilesEndpointPreview)
+
+	if err != nil {
+		return nil, c.formatIdentityProfileError(
+			identityProfileErrorContext{Operation: "preview"},
+			err,
+			0,
+		)
+	}
+
+	if resp.IsError() {
+		return nil, c.formatIdentityProfileError(
+			identityProfileErrorContext{Operation: "preview", ResponseBody: string(resp.Bytes())},
+			nil,
+			resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully previewed identity profile attribute configuration", map[string]any{
+		"identity_id":        preview.IdentityID,
+		"preview_attributes": len(result.PreviewAttributes),
+	})
+
+	return &result, nil
+}
+
+// ProcessIdentityProfile starts the processing of every identity of an identity profile, which applies
+// its current identity attribute configuration to them.
+// Note: The process operation returns 202 Accepted with a task result reference.
+func (c *Client) ProcessIdentityProfile(ctx context.Context, id string) (*TaskResultSimplifiedAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("identity profile ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Processing identity profile", map[string]any{
+		"id": id,
+	})
+
+	var taskResult TaskResultSimplifiedAPI
+
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&taskResult).
+		SetPathParam("profileId", id).
+		Post(identityProfilesEndpointProcess)
+
+	if err != nil {
+		return nil, c.formatIdentityProfileError(
+			identityProfileErrorContext{Operation: "process", ID: id},
+			err,
+			0,
+		)
+	}
+
+	if resp.IsError() {
+		return nil, c.formatIdentityProfileError(
+			identityProfileErrorContext{Operation: "process", ID: id, ResponseBody: string(resp.Bytes())},
+			nil,
+			resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Started identity profile processing", map[string]any{
+		"id":      id,
+		"task_id": taskResult.ID,
+	})
+
+	return &taskResult, nil
+}
+
 // formatIdentityProfileError formats errors with appropriate context for identity profile operations.
 func (c *Client) formatIdentityProfileError(errCtx identityProfileErrorContext, err error, statusCode int) error {
 	var baseMsg string
@@ -8,15 +8,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
+	launchersEndpointList   = "/v2025/launchers"
 	launchersEndpointGet    = "/v2025/launchers/{launcherId}"
 	launchersEndpointCreate = "/v2025/launchers"
 	launchersEndpointUpdate = "/v2025/launchers/{launcherId}"
 	launchersEndpointDelete = "/v2025/launchers/{launcherId}"
+
+	// launchersPageSize is the maximum page size accepted by the cursor-paginated launchers endpoint,
+	// lower than listPageSize.
+	launchersPageSize = 100
 )
 
 // LauncherAPI represents a SailPoint Launcher from the API.
@@ -33,6 +39,13 @@ type LauncherAPI struct {
 	Config      string        `json:"config"`
 }
 
+// LauncherTypes lists the valid LauncherAPI.Type values.
+var LauncherTypes = []string{"INTERACTIVE_PROCESS"}
+
+// LauncherOwnerTypes lists the owner types accepted by the Launchers API.
+// IDENTITY is accepted on input but stored as USER.
+var LauncherOwnerTypes = []string{ObjectRefTypeIdentity, "USER"}
+
 // LauncherCreateAPI represents the request body for creating a Launcher.
 type LauncherCreateAPI struct {
 	Name        string        `json:"name"`
@@ -44,6 +57,12 @@ type LauncherCreateAPI struct {
 	Config      string        `json:"config"`
 }
 
+// launcherListAPI is a page of launchers. Next is the cursor of the following page, empty on the last page.
+type launcherListAPI struct {
+	Next  string        `json:"next,omitempty"`
+	Items []LauncherAPI `json:"items"`
+}
+
 // launcherErrorContext provides context for error messages.
 type launcherErrorContext struct {
 	Operation    string
@@ -52,6 +71,47 @@ type launcherErrorContext struct {
 	ResponseBody string
 }
 
+// ListLaunchers retrieves all launchers matching the given filter expression (e.g., `disabled eq false`),
+// following the pagination cursor. Pass an empty string to omit the filter.
+func (c *Client) ListLaunchers(ctx context.Context, filters string) ([]LauncherAPI, error) {
+	tflog.Debug(ctx, "Listing launchers", map[string]any{"filters": filters})
+
+	var launchers []LauncherAPI
+	next := ""
+	for {
+		var page launcherListAPI
+		req := c.prepareRequest(ctx).
+			SetResult(&page).
+			SetQueryParam("limit", strconv.Itoa(launchersPageSize))
+		if filters != "" {
+			req.SetQueryParam("filters", filters)
+		}
+		if next != "" {
+			req.SetQueryParam("next", next)
+		}
+
+		resp, err := req.Get(launchersEndpointList)
+		if err != nil {
+			return nil, c.formatLauncherError(launcherErrorContext{Operation: "list"}, err, 0)
+		}
+		if resp.IsError() {
+			return nil, c.formatLauncherError(
+				launcherErrorContext{Operation: "list", ResponseBody: string(resp.Bytes())},
+				nil, resp.StatusCode(),
+			)
+		}
+
+		launchers = append(launchers, page.Items...)
+		if page.Next == "" || len(page.Items) == 0 {
+			break
+		}
+		next = page.Next
+	}
+
+	tflog.Debug(ctx, "Successfully listed launchers", map[string]any{"count": len(launchers)})
+	return launchers, nil
+}
+
 // GetLauncher retrieves a specific launcher by ID.
 func (c *Client) GetLauncher(ctx context.Context, id string) (*LauncherAPI, error) {
 	if id == "" {
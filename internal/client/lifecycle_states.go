@@ -13,6 +13,7 @@ import (
 )
 
 const (
+	lifecycleStatesEndpointList   = "/v2025/identity-profiles/{profileId}/lifecycle-states"
 	lifecycleStatesEndpointGet    = "/v2025/identity-profiles/{profileId}/lifecycle-states/{lifecycleStateId}"
 	lifecycleStatesEndpointCreate = "/v2025/identity-profiles/{profileId}/lifecycle-states"
 	lifecycleStatesEndpointPatch  = "/v2025/identity-profiles/{profileId}/lifecycle-states/{lifecycleStateId}"
@@ -67,6 +68,12 @@ type AccountActionAPI struct {
 	AllSources       bool     `json:"allSources"`
 }
 
+// LifecycleStateAccountActions lists the valid AccountActionAPI.Action values.
+var LifecycleStateAccountActions = []string{"ENABLE", "DISABLE", "DELETE"}
+
+// LifecycleStateIdentityStates lists the valid LifecycleStateAPI.IdentityState values.
+var LifecycleStateIdentityStates = []string{"ACTIVE", "INACTIVE_SHORT_TERM", "INACTIVE_LONG_TERM"}
+
 // AccessActionConfigurationAPI represents access action configuration for a lifecycle state.
 type AccessActionConfigurationAPI struct {
 	RemoveAllAccessEnabled bool `json:"removeAllAccessEnabled"`
@@ -81,6 +88,30 @@ type lifecycleStateErrorContext struct {
 	ResponseBody      string
 }
 
+// ListLifecycleStates retrieves all lifecycle states of an identity profile, following pagination.
+// The API does not support filtering lifecycle states.
+func (c *Client) ListLifecycleStates(ctx context.Context, identityProfileID string) ([]LifecycleStateAPI, error) {
+	if identityProfileID == "" {
+		return nil, fmt.Errorf("identity profile ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Listing lifecycle states", map[string]any{"identity_profile_id": identityProfileID})
+
+	pathParams := map[string]string{"profileId": identityProfileID}
+	states, err := listPages[LifecycleStateAPI](ctx, c, lifecycleStatesEndpointList, pathParams, "", func(err error, statusCode int, responseBody string) error {
+		return c.formatLifecycleStateError(
+			lifecycleStateErrorContext{Operation: "list", IdentityProfileID: identityProfileID, ResponseBody: responseBody},
+			err, statusCode,
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Successfully listed lifecycle states", map[string]any{"count": len(states)})
+	return states, nil
+}
+
 // GetLifecycleState retrieves a specific lifecycle state by ID.
 // Returns the LifecycleStateAPI and any error encountered.
 func (c *Client) GetLifecycleState(ctx context.Context, identityProfileID, lifecycleStateID string) (*LifecycleStateAPI, error) {
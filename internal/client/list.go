@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"strconv"
+)
+
+// listPageSize is the maximum page size accepted by the offset-paginated list endpoints.
+const listPageSize = 250
+
+// listPages retrieves every page of an offset-paginated list endpoint. filters, when not empty, is
+// sent as the `filters` query parameter. formatErr turns a request error or an error status into the
+// caller's error.
+func listPages[T any](ctx context.Context, c *Client, endpoint string, pathParams map[string]string, filters string, formatErr func(err error, statusCode int, responseBody string) error) ([]T, error) {
+	var items []T
+	for offset := 0; ; offset += listPageSize {
+		var page []T
+		req := c.prepareRequest(ctx).
+			SetResult(&page).
+			SetPathParams(pathParams).
+			SetQueryParam("limit", strconv.Itoa(listPageSize)).
+			SetQueryParam("offset", strconv.Itoa(offset))
+		if filters != "" {
+			req.SetQueryParam("filters", filters)
+		}
+
+		resp, err := req.Get(endpoint)
+		if err != nil {
+			return nil, formatErr(err, 0, "")
+		}
+		if resp.IsError() {
+			return nil, formatErr(nil, resp.StatusCode(), string(resp.Bytes()))
+		}
+
+		items = append(items, page...)
+		if len(page) < listPageSize {
+			return items, nil
+		}
+	}
+}
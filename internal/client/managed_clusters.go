@@ -0,0 +1,321 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	managedClusterEndpointList   = "/v2025/managed-clusters"
+	managedClusterEndpointGet    = "/v2025/managed-clusters/{id}"
+	managedClusterEndpointCreate = "/v2025/managed-clusters"
+	managedClusterEndpointPatch  = "/v2025/managed-clusters/{id}"
+	managedClusterEndpointDelete = "/v2025/managed-clusters/{id}"
+	managedClientEndpointList    = "/v2025/managed-clients"
+)
+
+// ManagedClusterAPI represents a SailPoint managed cluster (a group of virtual appliances) from the API.
+type ManagedClusterAPI struct {
+	ID            string            `json:"id,omitempty"`
+	Name          string            `json:"name"`
+	Description   *string           `json:"description,omitempty"`
+	Type          string            `json:"type,omitempty"`
+	ClientType    *string           `json:"clientType,omitempty"`
+	Configuration map[string]string `json:"configuration,omitempty"`
+	Status        *string           `json:"status,omitempty"`
+	Operational   *bool             `json:"operational,omitempty"`
+	ClientIDs     []string          `json:"clientIds,omitempty"`
+	CCGVersion    *string           `json:"ccgVersion,omitempty"`
+	CreatedAt     *string           `json:"createdAt,omitempty"`
+	UpdatedAt     *string           `json:"updatedAt,omitempty"`
+}
+
+// ManagedClusterTypes lists the valid ManagedClusterAPI.Type values.
+var ManagedClusterTypes = []string{"idn", "iai"}
+
+// ManagedClusterClientTypes lists the valid ManagedClusterAPI.ClientType values.
+var ManagedClusterClientTypes = []string{"CCG", "VA", "INTERNAL", "IIQ_HARVESTER"}
+
+// ManagedClusterStatusNormal is the status of a cluster whose clients are all healthy.
+const ManagedClusterStatusNormal = "NORMAL"
+
+// ManagedClientAPI represents a SailPoint managed client (a virtual appliance) from the API.
+type ManagedClientAPI struct {
+	ID            string  `json:"id"`
+	ClientID      *string `json:"clientId,omitempty"`
+	ClusterID     *string `json:"clusterId,omitempty"`
+	Name          *string `json:"name,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	Type          *string `json:"type,omitempty"`
+	Status        *string `json:"status,omitempty"`
+	IPAddress     *string `json:"ipAddress,omitempty"`
+	LastSeen      *string `json:"lastSeen,omitempty"`
+	SinceLastSeen *string `json:"sinceLastSeen,omitempty"`
+	VAVersion     *string `json:"vaVersion,omitempty"`
+}
+
+// managedClusterErrorContext provides context for error messages.
+type managedClusterErrorContext struct {
+	Operation    string
+	ID           string
+	Name         string
+	ResponseBody string
+}
+
+// ListManagedClusters retrieves managed clusters matching the given filter expression
+// (e.g., `name eq "Production"`). Pass an empty string to omit the filter.
+func (c *Client) ListManagedClusters(ctx context.Context, filters string) ([]ManagedClusterAPI, error) {
+	tflog.Debug(ctx, "Listing managed clusters", map[string]any{"filters": filters})
+
+	var clusters []ManagedClusterAPI
+	req := c.prepareRequest(ctx).
+		SetResult(&clusters)
+	if filters != "" {
+		req.SetQueryParam("filters", filters)
+	}
+
+	resp, err := req.Get(managedClusterEndpointList)
+	if err != nil {
+		return nil, c.formatManagedClusterError(managedClusterErrorContext{Operation: "list"}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatManagedClusterError(
+			managedClusterErrorContext{Operation: "list", ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully listed managed clusters", map[string]any{"count": len(clusters)})
+	return clusters, nil
+}
+
+// GetManagedCluster retrieves a specific managed cluster by ID.
+func (c *Client) GetManagedCluster(ctx context.Context, id string) (*ManagedClusterAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("managed cluster ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Getting managed cluster", map[string]any{"id": id})
+
+	var cluster ManagedClusterAPI
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&cluster).
+		SetPathParam("id", id).
+		Get(managedClusterEndpointGet)
+
+	if err != nil {
+		return nil, c.formatManagedClusterError(managedClusterErrorContext{Operation: "get", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatManagedClusterError(
+			managedClusterErrorContext{Operation: "get", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully retrieved managed cluster", map[string]any{
+		"id":   id,
+		"name": cluster.Name,
+	})
+	return &cluster, nil
+}
+
+// CreateManagedCluster creates a new managed cluster.
+func (c *Client) CreateManagedCluster(ctx context.Context, cluster *ManagedClusterAPI) (*ManagedClusterAPI, error) {
+	if cluster == nil {
+		return nil, fmt.Errorf("managed cluster cannot be nil")
+	}
+	if cluster.Name == "" {
+		return nil, fmt.Errorf("managed cluster name cannot be empty")
+	}
+
+	requestBody, _ := json.Marshal(cluster)
+	tflog.Debug(ctx, "Creating managed cluster", map[string]any{
+		"name":         cluster.Name,
+		"request_body": string(requestBody),
+	})
+
+	var result ManagedClusterAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(cluster).
+		SetResult(&result).
+		Post(managedClusterEndpointCreate)
+
+	if err != nil {
+		return nil, c.formatManagedClusterError(managedClusterErrorContext{Operation: "create", Name: cluster.Name}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatManagedClusterError(
+			managedClusterErrorContext{Operation: "create", Name: cluster.Name, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully created managed cluster", map[string]any{
+		"id":   result.ID,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// PatchManagedCluster applies a JSON Patch document to the managed cluster and returns the updated state.
+// When patchOps is empty, it simply fetches and returns the current state.
+func (c *Client) PatchManagedCluster(ctx context.Context, id string, patchOps []JSONPatchOperation) (*ManagedClusterAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("managed cluster ID cannot be empty")
+	}
+	if len(patchOps) == 0 {
+		return c.GetManagedCluster(ctx, id)
+	}
+
+	requestBody, _ := json.Marshal(patchOps)
+	tflog.Debug(ctx, "Updating managed cluster (PATCH)", map[string]any{
+		"id":               id,
+		"operations_count": len(patchOps),
+		"request_body":     string(requestBody),
+	})
+
+	var result ManagedClusterAPI
+	resp, err := c.prepareRequest(ctx).
+		SetHeader("Content-Type", "application/json-patch+json").
+		SetBody(patchOps).
+		SetResult(&result).
+		SetPathParam("id", id).
+		Patch(managedClusterEndpointPatch)
+
+	if err != nil {
+		return nil, c.formatManagedClusterError(managedClusterErrorContext{Operation: "update", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatManagedClusterError(
+			managedClusterErrorContext{Operation: "update", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully updated managed cluster", map[string]any{
+		"id":   id,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// DeleteManagedCluster deletes a managed cluster by ID. 404 is treated as success.
+// The API refuses to delete a cluster that still has clients attached.
+func (c *Client) DeleteManagedCluster(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("managed cluster ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Deleting managed cluster", map[string]any{"id": id})
+
+	resp, err := c.prepareRequest(ctx).
+		SetPathParam("id", id).
+		Delete(managedClusterEndpointDelete)
+
+	if err != nil {
+		return c.formatManagedClusterError(managedClusterErrorContext{Operation: "delete", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		if resp.StatusCode() == http.StatusNotFound {
+			tflog.Debug(ctx, "Managed cluster not found, treating as already deleted", map[string]any{"id": id})
+			return nil
+		}
+		return c.formatManagedClusterError(
+			managedClusterErrorContext{Operation: "delete", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully deleted managed cluster", map[string]any{"id": id})
+	return nil
+}
+
+// ListManagedClusterClients retrieves the managed clients (virtual appliances) attached to a managed cluster.
+func (c *Client) ListManagedClusterClients(ctx context.Context, id string) ([]ManagedClientAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("managed cluster ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Listing managed cluster clients", map[string]any{"id": id})
+
+	var clients []ManagedClientAPI
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&clients).
+		SetQueryParam("filters", fmt.Sprintf("clusterId eq %q", id)).
+		Get(managedClientEndpointList)
+
+	if err != nil {
+		return nil, c.formatManagedClusterError(managedClusterErrorContext{Operation: "list clients of", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatManagedClusterError(
+			managedClusterErrorContext{Operation: "list clients of", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully listed managed cluster clients", map[string]any{
+		"id":    id,
+		"count": len(clients),
+	})
+	return clients, nil
+}
+
+func (c *Client) formatManagedClusterError(errCtx managedClusterErrorContext, err error, statusCode int) error {
+	var baseMsg string
+	switch {
+	case errCtx.ID != "":
+		baseMsg = fmt.Sprintf("failed to %s managed cluster '%s'", errCtx.Operation, errCtx.ID)
+	case errCtx.Name != "":
+		baseMsg = fmt.Sprintf("failed to %s managed cluster '%s'", errCtx.Operation, errCtx.Name)
+	default:
+		baseMsg = fmt.Sprintf("failed to %s managed clusters", errCtx.Operation)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", baseMsg, err)
+	}
+
+	if statusCode != 0 {
+		detail := ""
+		if errCtx.ResponseBody != "" {
+			detail = fmt.Sprintf(" - response: %s", errCtx.ResponseBody)
+		}
+		switch statusCode {
+		case http.StatusBadRequest:
+			return fmt.Errorf("%s: invalid request (400)%s", baseMsg, detail)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+		case http.StatusForbidden:
+			return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+		case http.StatusConflict:
+			return fmt.Errorf("%s: conflict (409)%s", baseMsg, detail)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+		case http.StatusInternalServerError:
+			return fmt.Errorf("%s: server error (500)%s", baseMsg, detail)
+		default:
+			return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+		}
+	}
+
+	return fmt.Errorf("%s: unknown error", baseMsg)
+}
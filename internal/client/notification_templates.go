@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	notificationTemplateEndpointList       = "/v2025/notification-templates"
+	notificationTemplateEndpointBulkDelete = "/v2025/notification-templates/bulk-delete"
+)
+
+// NotificationTemplateAPI represents a SailPoint notification template. A template is identified
+// by its key, medium and locale; creating a template for an existing combination replaces it.
+type NotificationTemplateAPI struct {
+	ID          string  `json:"id,omitempty"`
+	Key         string  `json:"key"`
+	Name        *string `json:"name,omitempty"`
+	Medium      string  `json:"medium"`
+	Locale      string  `json:"locale"`
+	Subject     *string `json:"subject,omitempty"`
+	Header      *string `json:"header,omitempty"`
+	Body        *string `json:"body,omitempty"`
+	Footer      *string `json:"footer,omitempty"`
+	From        *string `json:"from,omitempty"`
+	ReplyTo     *string `json:"replyTo,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Created     *string `json:"created,omitempty"`
+	Modified    *string `json:"modified,omitempty"`
+}
+
+// NotificationTemplateKeyAPI identifies a notification template in a bulk delete request.
+type NotificationTemplateKeyAPI struct {
+	Key    string `json:"key"`
+	Medium string `json:"medium"`
+	Locale string `json:"locale"`
+}
+
+// NotificationTemplateMediums lists the delivery mediums of notification templates.
+var NotificationTemplateMediums = []string{"EMAIL", "SLACK", "TEAMS"}
+
+// notificationTemplateErrorContext provides context for error messages.
+type notificationTemplateErrorContext struct {
+	Operation    string
+	ID           string
+	Name         string
+	ResponseBody string
+}
+
+// ListNotificationTemplates retrieves the custom notification templates matching the given filter
+// expression (e.g., `key eq "cloud_manual_work_item_summary"`). Pass an empty string to omit the filter.
+func (c *Client) ListNotificationTemplates(ctx context.Context, filters string) ([]NotificationTemplateAPI, error) {
+	tflog.Debug(ctx, "Listing notification templates", map[string]any{"filters": filters})
+
+	var templates []NotificationTemplateAPI
+	req := c.prepareRequest(ctx).
+		SetResult(&templates)
+	if filters != "" {
+		req.SetQueryParam("filters", filters)
+	}
+
+	resp, err := req.Get(notificationTemplateEndpointList)
+	if err != nil {
+		return nil, c.formatNotificationTemplateError(notificationTemplateErrorContext{Operation: "list"}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatNotificationTemplateError(
+			notificationTemplateErrorContext{Operation: "list", ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully listed notification templates", map[string]any{"count": len(templates)})
+	return templates, nil
+}
+
+// SaveNotificationTemplate creates the notification template for its key, medium and locale,
+// replacing any existing template for the same combination.
+func (c *Client) SaveNotificationTemplate(ctx context.Context, template *NotificationTemplateAPI) (*NotificationTemplateAPI, error) {
+	if template == nil {
+		return nil, fmt.Errorf("notification template cannot be nil")
+	}
+
+	name := notificationTemplateName(template.Key, template.Medium, template.Locale)
+	requestBody, _ := json.Marshal(template)
+	tflog.Debug(ctx, "Saving notification template", map[string]any{
+		"template":     name,
+		"request_body": string(requestBody),
+	})
+
+	var result NotificationTemplateAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(template).
+		SetResult(&result).
+		Post(notificationTemplateEndpointList)
+
+	if err != nil {
+		return nil, c.formatNotificationTemplateError(notificationTemplateErrorContext{Operation: "save", Name: name}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatNotificationTemplateError(
+			notificationTemplateErrorContext{Operation: "save", Name: name, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully saved notification template", map[string]any{
+		"id":       result.ID,
+		"template": name,
+	})
+	return &result, nil
+}
+
+// DeleteNotificationTemplate deletes the custom notification template for a key, medium and locale,
+// so that the default template is used again.
+func (c *Client) DeleteNotificationTemplate(ctx context.Context, key, medium, locale string) error {
+	name := notificationTemplateName(key, medium, locale)
+	tflog.Debug(ctx, "Deleting notification template", map[string]any{"template": name})
+
+	resp, err := c.prepareRequest(ctx).
+		SetBody([]NotificationTemplateKeyAPI{{Key: key, Medium: medium, Locale: locale}}).
+		Post(notificationTemplateEndpointBulkDelete)
+
+	if err != nil {
+		return c.formatNotificationTemplateError(notificationTemplateErrorContext{Operation: "delete", Name: name}, err, 0)
+	}
+	if resp.IsError() {
+		if resp.StatusCode() == http.StatusNotFound {
+			tflog.Debug(ctx, "Notification template not found, treating as already deleted", map[string]any{"template": name})
+			return nil
+		}
+		return c.formatNotificationTemplateError(
+			notificationTemplateErrorContext{Operation: "delete", Name: name, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully deleted notification template", map[string]any{"template": name})
+	return nil
+}
+
+// notificationTemplateName identifies a notification template in logs and errors.
+func notificationTemplateName(key, medium, locale string) string {
+	return key + "/" + medium + "/" + locale
+}
+
+func (c *Client) formatNotificationTemplateError(errCtx notificationTemplateErrorContext, err error, statusCode int) error {
+	var baseMsg string
+	switch {
+	case errCtx.ID != "":
+		baseMsg = fmt.Sprintf("failed to %s notification template '%s'", errCtx.Operation, errCtx.ID)
+	case errCtx.Name != "":
+		baseMsg = fmt.Sprintf("failed to %s notification template '%s'", errCtx.Operation, errCtx.Name)
+	default:
+		baseMsg = fmt.Sprintf("failed to %s notification templates", errCtx.Operation)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", baseMsg, err)
+	}
+
+	if statusCode != 0 {
+		detail := ""
+		if errCtx.ResponseBody != "" {
+			detail = fmt.Sprintf(" - response: %s", errCtx.ResponseBody)
+		}
+		switch statusCode {
+		case http.StatusBadRequest:
+			return fmt.Errorf("%s: invalid request (400)%s", baseMsg, detail)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+		case http.StatusForbidden:
+			return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+		case http.StatusConflict:
+			return fmt.Errorf("%s: conflict (409)%s", baseMsg, detail)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+		case http.StatusInternalServerError:
+			return fmt.Errorf("%s: server error (500)%s", baseMsg, detail)
+		default:
+			return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+		}
+	}
+
+	return fmt.Errorf("%s: unknown error", baseMsg)
+}
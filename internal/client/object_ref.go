@@ -9,3 +9,23 @@ type ObjectRefAPI struct {
 	ID   string `json:"id"`
 	Name string `json:"name,omitempty"` // Optional
 }
+
+// Object reference types shared by owners, approvers and access item references.
+const (
+	ObjectRefTypeIdentity        = "IDENTITY"
+	ObjectRefTypeGovernanceGroup = "GOVERNANCE_GROUP"
+	ObjectRefTypeSource          = "SOURCE"
+	ObjectRefTypeEntitlement     = "ENTITLEMENT"
+	ObjectRefTypeAccessProfile   = "ACCESS_PROFILE"
+	ObjectRefTypeRole            = "ROLE"
+	ObjectRefTypeDimension       = "DIMENSION"
+	ObjectRefTypeCluster         = "CLUSTER"
+	ObjectRefTypeWorkflow        = "WORKFLOW"
+	ObjectRefTypeRule            = "RULE"
+)
+
+// OwnerTypes lists the owner types accepted by objects that can only be owned by an identity.
+var OwnerTypes = []string{ObjectRefTypeIdentity}
+
+// AdditionalOwnerTypes lists the types accepted in the additionalOwners list of roles and access profiles.
+var AdditionalOwnerTypes = []string{ObjectRefTypeIdentity, ObjectRefTypeGovernanceGroup}
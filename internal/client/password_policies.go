@@ -0,0 +1,255 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	passwordPolicyEndpointGet    = "/v2025/password-policies/{id}"
+	passwordPolicyEndpointCreate = "/v2025/password-policies"
+	passwordPolicyEndpointUpdate = "/v2025/password-policies/{id}"
+	passwordPolicyEndpointDelete = "/v2025/password-policies/{id}"
+)
+
+// PasswordPolicyAPI represents a SailPoint password policy from the API.
+// A policy applies to the sources listed in SourceIDs.
+type PasswordPolicyAPI struct {
+	ID                                    string   `json:"id,omitempty"`
+	Name                                  string   `json:"name"`
+	Description                           *string  `json:"description,omitempty"`
+	SourceIDs                             []string `json:"sourceIds"`
+	DefaultPolicy                         *bool    `json:"defaultPolicy,omitempty"`
+	MinLength                             *int64   `json:"minLength,omitempty"`
+	MaxLength                             *int64   `json:"maxLength,omitempty"`
+	MinAlpha                              *int64   `json:"minAlpha,omitempty"`
+	MinLower                              *int64   `json:"minLower,omitempty"`
+	MinUpper                              *int64   `json:"minUpper,omitempty"`
+	MinNumeric                            *int64   `json:"minNumeric,omitempty"`
+	MinSpecial                            *int64   `json:"minSpecial,omitempty"`
+	MinCharacterTypes                     *int64   `json:"minCharacterTypes,omitempty"`
+	MaxRepeatedChars                      *int64   `json:"maxRepeatedChars,omitempty"`
+	UseDictionary                         *bool    `json:"useDictionary,omitempty"`
+	UseIdentityAttributes                 *bool    `json:"useIdentityAttributes,omitempty"`
+	UseAccountAttributes                  *bool    `json:"useAccountAttributes,omitempty"`
+	ValidateAgainstAccountID              *bool    `json:"validateAgainstAccountId,omitempty"`
+	AccountIDMinWordLength                *int64   `json:"accountIdMinWordLength,omitempty"`
+	ValidateAgainstAccountName            *bool    `json:"validateAgainstAccountName,omitempty"`
+	AccountNameMinWordLength              *int64   `json:"accountNameMinWordLength,omitempty"`
+	EnablePasswdExpiration                *bool    `json:"enablePasswdExpiration,omitempty"`
+	PasswordExpiration                    *int64   `json:"passwordExpiration,omitempty"`
+	FirstExpirationReminder               *int64   `json:"firstExpirationReminder,omitempty"`
+	RequireStrongAuthn                    *bool    `json:"requireStrongAuthn,omitempty"`
+	RequireStrongAuthOffNetwork           *bool    `json:"requireStrongAuthOffNetwork,omitempty"`
+	RequireStrongAuthUntrustedGeographies *bool    `json:"requireStrongAuthUntrustedGeographies,omitempty"`
+	Created                               *string  `json:"created,omitempty"`
+	Modified                              *string  `json:"modified,omitempty"`
+}
+
+// passwordPolicyErrorContext provides context for error messages.
+type passwordPolicyErrorContext struct {
+	Operation    string
+	ID           string
+	Name         string
+	ResponseBody string
+}
+
+// GetPasswordPolicy retrieves a specific password policy by ID.
+func (c *Client) GetPasswordPolicy(ctx context.Context, id string) (*PasswordPolicyAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("password policy ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Getting password policy", map[string]any{"id": id})
+
+	var result PasswordPolicyAPI
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&result).
+		SetPathParam("id", id).
+		Get(passwordPolicyEndpointGet)
+
+	if err != nil {
+		return nil, c.formatPasswordPolicyError(passwordPolicyErrorContext{Operation: "get", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatPasswordPolicyError(
+			passwordPolicyErrorContext{Operation: "get", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully retrieved password policy", map[string]any{
+		"id":   id,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// CreatePasswordPolicy creates a new password policy.
+func (c *Client) CreatePasswordPolicy(ctx context.Context, passwordPolicy *PasswordPolicyAPI) (*PasswordPolicyAPI, error) {
+	if passwordPolicy == nil {
+		return nil, fmt.Errorf("password policy cannot be nil")
+	}
+	if passwordPolicy.Name == "" {
+		return nil, fmt.Errorf("password policy name cannot be empty")
+	}
+
+	requestBody, _ := json.Marshal(passwordPolicy)
+	tflog.Debug(ctx, "Creating password policy", map[string]any{
+		"name":         passwordPolicy.Name,
+		"request_body": string(requestBody),
+	})
+
+	var result PasswordPolicyAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(passwordPolicy).
+		SetResult(&result).
+		Post(passwordPolicyEndpointCreate)
+
+	if err != nil {
+		return nil, c.formatPasswordPolicyError(passwordPolicyErrorContext{Operation: "create", Name: passwordPolicy.Name}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatPasswordPolicyError(
+			passwordPolicyErrorContext{Operation: "create", Name: passwordPolicy.Name, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully created password policy", map[string]any{
+		"id":   result.ID,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// UpdatePasswordPolicy replaces a password policy (PUT) and returns the updated state.
+func (c *Client) UpdatePasswordPolicy(ctx context.Context, id string, passwordPolicy *PasswordPolicyAPI) (*PasswordPolicyAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("password policy ID cannot be empty")
+	}
+	if passwordPolicy == nil {
+		return nil, fmt.Errorf("password policy cannot be nil")
+	}
+
+	// The update payload must carry the ID of the password policy being replaced.
+	body := *passwordPolicy
+	body.ID = id
+
+	requestBody, _ := json.Marshal(body)
+	tflog.Debug(ctx, "Updating password policy", map[string]any{
+		"id":           id,
+		"request_body": string(requestBody),
+	})
+
+	var result PasswordPolicyAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(body).
+		SetResult(&result).
+		SetPathParam("id", id).
+		Put(passwordPolicyEndpointUpdate)
+
+	if err != nil {
+		return nil, c.formatPasswordPolicyError(passwordPolicyErrorContext{Operation: "update", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatPasswordPolicyError(
+			passwordPolicyErrorContext{Operation: "update", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully updated password policy", map[string]any{
+		"id":   id,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// DeletePasswordPolicy deletes a password policy by ID. 404 is treated as success.
+func (c *Client) DeletePasswordPolicy(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("password policy ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Deleting password policy", map[string]any{"id": id})
+
+	resp, err := c.prepareRequest(ctx).
+		SetPathParam("id", id).
+		Delete(passwordPolicyEndpointDelete)
+
+	if err != nil {
+		return c.formatPasswordPolicyError(passwordPolicyErrorContext{Operation: "delete", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		if resp.StatusCode() == http.StatusNotFound {
+			tflog.Debug(ctx, "Password policy not found, treating as already deleted", map[string]any{"id": id})
+			return nil
+		}
+		return c.formatPasswordPolicyError(
+			passwordPolicyErrorContext{Operation: "delete", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully deleted password policy", map[string]any{"id": id})
+	return nil
+}
+
+func (c *Client) formatPasswordPolicyError(errCtx passwordPolicyErrorContext, err error, statusCode int) error {
+	var baseMsg string
+	switch {
+	case errCtx.ID != "":
+		baseMsg = fmt.Sprintf("failed to %s password policy '%s'", errCtx.Operation, errCtx.ID)
+	case errCtx.Name != "":
+		baseMsg = fmt.Sprintf("failed to %s password policy '%s'", errCtx.Operation, errCtx.Name)
+	default:
+		baseMsg = fmt.Sprintf("failed to %s password policies", errCtx.Operation)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", baseMsg, err)
+	}
+
+	if statusCode != 0 {
+		detail := ""
+		if errCtx.ResponseBody != "" {
+			detail = fmt.Sprintf(" - response: %s", errCtx.ResponseBody)
+		}
+		switch statusCode {
+		case http.StatusBadRequest:
+			return fmt.Errorf("%s: invalid request (400)%s", baseMsg, detail)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+		case http.StatusForbidden:
+			return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+		case http.StatusConflict:
+			return fmt.Errorf("%s: conflict (409)%s", baseMsg, detail)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+		case http.StatusInternalServerError:
+			return fmt.Errorf("%s: server error (500)%s", baseMsg, detail)
+		default:
+			return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+		}
+	}
+
+	return fmt.Errorf("%s: unknown error", baseMsg)
+}
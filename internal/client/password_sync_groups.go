@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	passwordSyncGroupEndpointGet    = "/v2025/password-sync-groups/{id}"
+	passwordSyncGroupEndpointCreate = "/v2025/password-sync-groups"
+	passwordSyncGroupEndpointUpdate = "/v2025/password-sync-groups/{id}"
+	passwordSyncGroupEndpointDelete = "/v2025/password-sync-groups/{id}"
+)
+
+// PasswordSyncGroupAPI represents a SailPoint password sync group from the API.
+// The sources of a group share one password, governed by the group's password policy.
+type PasswordSyncGroupAPI struct {
+	ID               string   `json:"id,omitempty"`
+	Name             string   `json:"name"`
+	PasswordPolicyID string   `json:"passwordPolicyId"`
+	SourceIDs        []string `json:"sourceIds"`
+	Created          *string  `json:"created,omitempty"`
+	Modified         *string  `json:"modified,omitempty"`
+}
+
+// passwordSyncGroupErrorContext provides context for error messages.
+type passwordSyncGroupErrorContext struct {
+	Operation    string
+	ID           string
+	Name         string
+	ResponseBody string
+}
+
+// GetPasswordSyncGroup retrieves a specific password sync group by ID.
+func (c *Client) GetPasswordSyncGroup(ctx context.Context, id string) (*PasswordSyncGroupAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("password sync group ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Getting password sync group", map[string]any{"id": id})
+
+	var result PasswordSyncGroupAPI
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&result).
+		SetPathParam("id", id).
+		Get(passwordSyncGroupEndpointGet)
+
+	if err != nil {
+		return nil, c.formatPasswordSyncGroupError(passwordSyncGroupErrorContext{Operation: "get", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatPasswordSyncGroupError(
+			passwordSyncGroupErrorContext{Operation: "get", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully retrieved password sync group", map[string]any{
+		"id":   id,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// CreatePasswordSyncGroup creates a new password sync group.
+func (c *Client) CreatePasswordSyncGroup(ctx context.Context, passwordSyncGroup *PasswordSyncGroupAPI) (*PasswordSyncGroupAPI, error) {
+	if passwordSyncGroup == nil {
+		return nil, fmt.Errorf("password sync group cannot be nil")
+	}
+	if passwordSyncGroup.Name == "" {
+		return nil, fmt.Errorf("password sync group name cannot be empty")
+	}
+
+	requestBody, _ := json.Marshal(passwordSyncGroup)
+	tflog.Debug(ctx, "Creating password sync group", map[string]any{
+		"name":         passwordSyncGroup.Name,
+		"request_body": string(requestBody),
+	})
+
+	var result PasswordSyncGroupAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(passwordSyncGroup).
+		SetResult(&result).
+		Post(passwordSyncGroupEndpointCreate)
+
+	if err != nil {
+		return nil, c.formatPasswordSyncGroupError(passwordSyncGroupErrorContext{Operation: "create", Name: passwordSyncGroup.Name}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatPasswordSyncGroupError(
+			passwordSyncGroupErrorContext{Operation: "create", Name: passwordSyncGroup.Name, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully created password sync group", map[string]any{
+		"id":   result.ID,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// UpdatePasswordSyncGroup replaces a password sync group (PUT) and returns the updated state.
+func (c *Client) UpdatePasswordSyncGroup(ctx context.Context, id string, passwordSyncGroup *PasswordSyncGroupAPI) (*PasswordSyncGroupAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("password sync group ID cannot be empty")
+	}
+	if passwordSyncGroup == nil {
+		return nil, fmt.Errorf("password sync group cannot be nil")
+	}
+
+	// The update payload must carry the ID of the password sync group being replaced.
+	body := *passwordSyncGroup
+	body.ID = id
+
+	requestBody, _ := json.Marshal(body)
+	tflog.Debug(ctx, "Updating password sync group", map[string]any{
+		"id":           id,
+		"request_body": string(requestBody),
+	})
+
+	var result PasswordSyncGroupAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(body).
+		SetResult(&result).
+		SetPathParam("id", id).
+		Put(passwordSyncGroupEndpointUpdate)
+
+	if err != nil {
+		return nil, c.formatPasswordSyncGroupError(passwordSyncGroupErrorContext{Operation: "update", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatPasswordSyncGroupError(
+			passwordSyncGroupErrorContext{Operation: "update", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully updated password sync group", map[string]any{
+		"id":   id,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// DeletePasswordSyncGroup deletes a password sync group by ID. 404 is treated as success.
+func (c *Client) DeletePasswordSyncGroup(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("password sync group ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Deleting password sync group", map[string]any{"id": id})
+
+	resp, err := c.prepareRequest(ctx).
+		SetPathParam("id", id).
+		Delete(passwordSyncGroupEndpointDelete)
+
+	if err != nil {
+		return c.formatPasswordSyncGroupError(passwordSyncGroupErrorContext{Operation: "delete", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		if resp.StatusCode() == http.StatusNotFound {
+			tflog.Debug(ctx, "Password sync group not found, treating as already deleted", map[string]any{"id": id})
+			return nil
+		}
+		return c.formatPasswordSyncGroupError(
+			passwordSyncGroupErrorContext{Operation: "delete", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully deleted password sync group", map[string]any{"id": id})
+	return nil
+}
+
+func (c *Client) formatPasswordSyncGroupError(errCtx passwordSyncGroupErrorContext, err error, statusCode int) error {
+	var baseMsg string
+	switch {
+	case errCtx.ID != "":
+		baseMsg = fmt.Sprintf("failed to %s password sync group '%s'", errCtx.Operation, errCtx.ID)
+	case errCtx.Name != "":
+		baseMsg = fmt.Sprintf("failed to %s password sync group '%s'", errCtx.Operation, errCtx.Name)
+	default:
+		baseMsg = fmt.Sprintf("failed to %s password sync groups", errCtx.Operation)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", baseMsg, err)
+	}
+
+	if statusCode != 0 {
+		detail := ""
+		if errCtx.ResponseBody != "" {
+			detail = fmt.Sprintf(" - response: %s", errCtx.ResponseBody)
+		}
+		switch statusCode {
+		case http.StatusBadRequest:
+			return fmt.Errorf("%s: invalid request (400)%s", baseMsg, detail)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+		case http.StatusForbidden:
+			return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+		case http.StatusConflict:
+			return fmt.Errorf("%s: conflict (409)%s", baseMsg, detail)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+		case http.StatusInternalServerError:
+			return fmt.Errorf("%s: server error (500)%s", baseMsg, detail)
+		default:
+			return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+		}
+	}
+
+	return fmt.Errorf("%s: unknown error", baseMsg)
+}
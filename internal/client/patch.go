@@ -26,3 +26,23 @@ func NewRemovePatch(path string) JSONPatchOperation {
 		Path: path,
 	}
 }
+
+// NewAddPatch creates a JSON Patch "add" operation for the given path and value.
+// Unlike "replace", "add" also succeeds when the target object member does not exist yet.
+func NewAddPatch(path string, value any) JSONPatchOperation {
+	return JSONPatchOperation{
+		Op:    "add",
+		Path:  path,
+		Value: value,
+	}
+}
+
+// NewTestPatch creates a JSON Patch "test" operation checking that the given path holds value.
+// Placed before other operations, it makes the whole patch fail if the target has moved since it was read.
+func NewTestPatch(path string, value any) JSONPatchOperation {
+	return JSONPatchOperation{
+		Op:    "test",
+		Path:  path,
+		Value: value,
+	}
+}
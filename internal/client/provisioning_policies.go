@@ -27,6 +27,13 @@ type ProvisioningPolicyAPI struct {
 	Fields      []ProvisioningPolicyFieldAPI `json:"fields,omitempty"`
 }
 
+// ProvisioningPolicyUsageTypes lists the valid ProvisioningPolicyAPI.UsageType values.
+var ProvisioningPolicyUsageTypes = []string{
+	"CREATE", "UPDATE", "DELETE", "ENABLE", "DISABLE", "ASSIGN", "UNASSIGN",
+	"CREATE_GROUP", "UPDATE_GROUP", "DELETE_GROUP", "REGISTER", "CREATE_IDENTITY",
+	"UPDATE_IDENTITY", "EDIT_GROUP", "UNLOCK", "CHANGE_PASSWORD",
+}
+
 // ProvisioningPolicyFieldAPI represents a field definition within a provisioning policy.
 type ProvisioningPolicyFieldAPI struct {
 	Name          string                          `json:"name"`
@@ -13,6 +13,7 @@ import (
 )
 
 const (
+	roleEndpointList   = "/v2025/roles"
 	roleEndpointGet    = "/v2025/roles/{id}"
 	roleEndpointCreate = "/v2025/roles"
 	roleEndpointPatch  = "/v2025/roles/{id}"
@@ -47,6 +48,9 @@ type RoleMembershipAPI struct {
 	Identities []RoleMembershipIdentityAPI `json:"identities,omitempty"`
 }
 
+// RoleMembershipTypes lists the valid RoleMembershipAPI.Type values.
+var RoleMembershipTypes = []string{"STANDARD", "IDENTITY_LIST"}
+
 type RoleMembershipIdentityAPI struct {
 	Type      string `json:"type,omitempty"`
 	ID        string `json:"id"`
@@ -62,12 +66,21 @@ type RoleCriteriaAPI struct {
 	Children    []RoleCriteriaAPI   `json:"children,omitempty"`
 }
 
+// RoleCriteriaOperations lists the valid RoleCriteriaAPI.Operation values.
+var RoleCriteriaOperations = []string{"EQUALS", "NOT_EQUALS", "CONTAINS", "STARTS_WITH", "ENDS_WITH", "AND", "OR"}
+
 type RoleCriteriaKeyAPI struct {
 	Type     string  `json:"type"`
 	Property string  `json:"property"`
 	SourceID *string `json:"sourceId,omitempty"`
 }
 
+// RoleCriteriaKeyTypes lists the valid RoleCriteriaKeyAPI.Type values.
+var RoleCriteriaKeyTypes = []string{ObjectRefTypeIdentity, "ACCOUNT", ObjectRefTypeEntitlement}
+
+// RoleApproverTypes lists the valid ApprovalSchemeAPI.ApproverType values for roles.
+var RoleApproverTypes = []string{"OWNER", "MANAGER", ObjectRefTypeGovernanceGroup, ObjectRefTypeWorkflow}
+
 // RevocabilityForRoleAPI is the role-specific revoke config (extends RevocabilityAPI with comment fields).
 type RevocabilityForRoleAPI struct {
 	CommentsRequired       *bool               `json:"commentsRequired,omitempty"`
@@ -82,6 +95,22 @@ type roleErrorContext struct {
 	ResponseBody string
 }
 
+// ListRoles retrieves all roles matching the given filter expression (e.g., `requestable eq true`),
+// following pagination. Pass an empty string to omit the filter.
+func (c *Client) ListRoles(ctx context.Context, filters string) ([]RoleAPI, error) {
+	tflog.Debug(ctx, "Listing roles", map[string]any{"filters": filters})
+
+	roles, err := listPages[RoleAPI](ctx, c, roleEndpointList, nil, filters, func(err error, statusCode int, responseBody string) error {
+		return c.formatRoleError(roleErrorContext{Operation: "list", ResponseBody: responseBody}, err, statusCode)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Successfully listed roles", map[string]any{"count": len(roles)})
+	return roles, nil
+}
+
 func (c *Client) GetRole(ctx context.Context, id string) (*RoleAPI, error) {
 	if id == "" {
 		return nil, fmt.Errorf("role ID cannot be empty")
@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+// ScheduleAPI represents a recurring schedule, as used by SOD policy reports and campaign templates.
+type ScheduleAPI struct {
+	Type       string               `json:"type"`
+	Months     *ScheduleSelectorAPI `json:"months,omitempty"`
+	Days       *ScheduleSelectorAPI `json:"days,omitempty"`
+	Hours      *ScheduleSelectorAPI `json:"hours,omitempty"`
+	Expiration *string              `json:"expiration,omitempty"`
+	TimeZoneID *string              `json:"timeZoneId,omitempty"`
+}
+
+// ScheduleTypes lists the valid ScheduleAPI.Type values.
+var ScheduleTypes = []string{"DAILY", "WEEKLY", "MONTHLY", "ANNUALLY", "CALENDAR"}
+
+// ScheduleSelectorAPI selects the months, days or hours a schedule runs on.
+type ScheduleSelectorAPI struct {
+	Type     string   `json:"type"`
+	Values   []string `json:"values"`
+	Interval *int64   `json:"interval,omitempty"`
+}
+
+// ScheduleSelectorTypeList is the selector type for an explicit list of values.
+const ScheduleSelectorTypeList = "LIST"
@@ -13,6 +13,7 @@ import (
 )
 
 const (
+	segmentEndpointList   = "/v2025/segments"
 	segmentEndpointGet    = "/v2025/segments/{id}"
 	segmentEndpointCreate = "/v2025/segments"
 	segmentEndpointPatch  = "/v2025/segments/{id}"
@@ -45,6 +46,9 @@ type SegmentExpressionAPI struct {
 	Children  []SegmentExpressionAPI `json:"children,omitempty"`
 }
 
+// SegmentExpressionOperators lists the valid SegmentExpressionAPI.Operator values.
+var SegmentExpressionOperators = []string{"AND", "EQUALS"}
+
 // SegmentValueAPI represents a typed value within an EQUALS expression.
 type SegmentValueAPI struct {
 	Type  string `json:"type"`
@@ -59,6 +63,21 @@ type segmentErrorContext struct {
 	ResponseBody string
 }
 
+// ListSegments retrieves all segments, following pagination. The API does not support filtering segments.
+func (c *Client) ListSegments(ctx context.Context) ([]SegmentAPI, error) {
+	tflog.Debug(ctx, "Listing segments")
+
+	segments, err := listPages[SegmentAPI](ctx, c, segmentEndpointList, nil, "", func(err error, statusCode int, responseBody string) error {
+		return c.formatSegmentError(segmentErrorContext{Operation: "list", ResponseBody: responseBody}, err, statusCode)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Successfully listed segments", map[string]any{"count": len(segments)})
+	return segments, nil
+}
+
 // GetSegment retrieves a specific segment by ID.
 func (c *Client) GetSegment(ctx context.Context, id string) (*SegmentAPI, error) {
 	if id == "" {
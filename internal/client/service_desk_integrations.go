@@ -0,0 +1,315 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	serviceDeskIntegrationEndpointGet    = "/v2025/service-desk-integrations/{id}"
+	serviceDeskIntegrationEndpointCreate = "/v2025/service-desk-integrations"
+	serviceDeskIntegrationEndpointUpdate = "/v2025/service-desk-integrations/{id}"
+	serviceDeskIntegrationEndpointDelete = "/v2025/service-desk-integrations/{id}"
+	serviceDeskStatusCheckEndpoint       = "/v2025/service-desk-integrations/status-check-configuration"
+)
+
+// ServiceDeskIntegrationAPI represents a SailPoint service desk integration (SDIM) from the API.
+// Attributes holds the connection settings of the integration type, including credentials,
+// so request bodies are not logged.
+type ServiceDeskIntegrationAPI struct {
+	ID                     string                            `json:"id,omitempty"`
+	Name                   string                            `json:"name"`
+	Description            string                            `json:"description"`
+	Type                   string                            `json:"type"`
+	OwnerRef               *ObjectRefAPI                     `json:"ownerRef,omitempty"`
+	ClusterRef             *ObjectRefAPI                     `json:"clusterRef,omitempty"`
+	ProvisioningConfig     *ServiceDeskProvisioningConfigAPI `json:"provisioningConfig,omitempty"`
+	Attributes             map[string]interface{}            `json:"attributes"`
+	BeforeProvisioningRule *ObjectRefAPI                     `json:"beforeProvisioningRule,omitempty"`
+	Created                *string                           `json:"created,omitempty"`
+	Modified               *string                           `json:"modified,omitempty"`
+}
+
+// ServiceDeskProvisioningConfigAPI defines which sources a service desk integration provisions.
+type ServiceDeskProvisioningConfigAPI struct {
+	UniversalManager              bool                  `json:"universalManager"`
+	ManagedResourceRefs           []ObjectRefAPI        `json:"managedResourceRefs"`
+	PlanInitializerScript         *ServiceDeskScriptAPI `json:"planInitializerScript,omitempty"`
+	NoProvisioningRequests        bool                  `json:"noProvisioningRequests"`
+	ProvisioningRequestExpiration *int64                `json:"provisioningRequestExpiration,omitempty"`
+}
+
+// ServiceDeskScriptAPI holds the source of a BeanShell script run by a service desk integration.
+type ServiceDeskScriptAPI struct {
+	Source string `json:"source"`
+}
+
+// ServiceDeskStatusCheckConfigAPI is the tenant-wide configuration of the checks that poll
+// service desk tickets for completion. The API encodes both numbers as strings.
+type ServiceDeskStatusCheckConfigAPI struct {
+	ProvisioningStatusCheckIntervalMinutes string `json:"provisioningStatusCheckIntervalMinutes"`
+	ProvisioningMaxStatusCheckDays         string `json:"provisioningMaxStatusCheckDays"`
+}
+
+// serviceDeskIntegrationErrorContext provides context for error messages.
+type serviceDeskIntegrationErrorContext struct {
+	Operation    string
+	ID           string
+	Name         string
+	ResponseBody string
+}
+
+// GetServiceDeskIntegration retrieves a specific service desk integration by ID.
+func (c *Client) GetServiceDeskIntegration(ctx context.Context, id string) (*ServiceDeskIntegrationAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("service desk integration ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Getting service desk integration", map[string]any{"id": id})
+
+	var result ServiceDeskIntegrationAPI
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&result).
+		SetPathParam("id", id).
+		Get(serviceDeskIntegrationEndpointGet)
+
+	if err != nil {
+		return nil, c.formatServiceDeskIntegrationError(serviceDeskIntegrationErrorContext{Operation: "get", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatServiceDeskIntegrationError(
+			serviceDeskIntegrationErrorContext{Operation: "get", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully retrieved service desk integration", map[string]any{
+		"id":   id,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// CreateServiceDeskIntegration creates a new service desk integration.
+func (c *Client) CreateServiceDeskIntegration(ctx context.Context, integration *ServiceDeskIntegrationAPI) (*ServiceDeskIntegrationAPI, error) {
+	if integration == nil {
+		return nil, fmt.Errorf("service desk integration cannot be nil")
+	}
+	if integration.Name == "" {
+		return nil, fmt.Errorf("service desk integration name cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Creating service desk integration", map[string]any{
+		"name": integration.Name,
+		"type": integration.Type,
+	})
+
+	var result ServiceDeskIntegrationAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(integration).
+		SetResult(&result).
+		Post(serviceDeskIntegrationEndpointCreate)
+
+	if err != nil {
+		return nil, c.formatServiceDeskIntegrationError(serviceDeskIntegrationErrorContext{Operation: "create", Name: integration.Name}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatServiceDeskIntegrationError(
+			serviceDeskIntegrationErrorContext{Operation: "create", Name: integration.Name, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully created service desk integration", map[string]any{
+		"id":   result.ID,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// UpdateServiceDeskIntegration replaces a service desk integration (PUT) and returns the updated state.
+func (c *Client) UpdateServiceDeskIntegration(ctx context.Context, id string, integration *ServiceDeskIntegrationAPI) (*ServiceDeskIntegrationAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("service desk integration ID cannot be empty")
+	}
+	if integration == nil {
+		return nil, fmt.Errorf("service desk integration cannot be nil")
+	}
+
+	// The update payload must carry the ID of the service desk integration being replaced.
+	body := *integration
+	body.ID = id
+
+	tflog.Debug(ctx, "Updating service desk integration", map[string]any{
+		"id":   id,
+		"name": body.Name,
+	})
+
+	var result ServiceDeskIntegrationAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(body).
+		SetResult(&result).
+		SetPathParam("id", id).
+		Put(serviceDeskIntegrationEndpointUpdate)
+
+	if err != nil {
+		return nil, c.formatServiceDeskIntegrationError(serviceDeskIntegrationErrorContext{Operation: "update", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatServiceDeskIntegrationError(
+			serviceDeskIntegrationErrorContext{Operation: "update", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully updated service desk integration", map[string]any{
+		"id":   id,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// DeleteServiceDeskIntegration deletes a service desk integration by ID. 404 is treated as success.
+func (c *Client) DeleteServiceDeskIntegration(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("service desk integration ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Deleting service desk integration", map[string]any{"id": id})
+
+	resp, err := c.prepareRequest(ctx).
+		SetPathParam("id", id).
+		Delete(serviceDeskIntegrationEndpointDelete)
+
+	if err != nil {
+		return c.formatServiceDeskIntegrationError(serviceDeskIntegrationErrorContext{Operation: "delete", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		if resp.StatusCode() == http.StatusNotFound {
+			tflog.Debug(ctx, "Service desk integration not found, treating as already deleted", map[string]any{"id": id})
+			return nil
+		}
+		return c.formatServiceDeskIntegrationError(
+			serviceDeskIntegrationErrorContext{Operation: "delete", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully deleted service desk integration", map[string]any{"id": id})
+	return nil
+}
+
+// GetServiceDeskStatusCheckConfig retrieves the tenant's service desk status check configuration.
+func (c *Client) GetServiceDeskStatusCheckConfig(ctx context.Context) (*ServiceDeskStatusCheckConfigAPI, error) {
+	tflog.Debug(ctx, "Getting service desk status check configuration")
+
+	var config ServiceDeskStatusCheckConfigAPI
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&config).
+		Get(serviceDeskStatusCheckEndpoint)
+
+	if err != nil {
+		return nil, c.formatServiceDeskIntegrationError(serviceDeskIntegrationErrorContext{Operation: "get the status check configuration of"}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatServiceDeskIntegrationError(
+			serviceDeskIntegrationErrorContext{Operation: "get the status check configuration of", ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	return &config, nil
+}
+
+// UpdateServiceDeskStatusCheckConfig replaces the tenant's service desk status check configuration.
+func (c *Client) UpdateServiceDeskStatusCheckConfig(ctx context.Context, config *ServiceDeskStatusCheckConfigAPI) (*ServiceDeskStatusCheckConfigAPI, error) {
+	if config == nil {
+		return nil, fmt.Errorf("service desk status check configuration cannot be nil")
+	}
+
+	requestBody, _ := json.Marshal(config)
+	tflog.Debug(ctx, "Updating service desk status check configuration", map[string]any{
+		"request_body": string(requestBody),
+	})
+
+	var result ServiceDeskStatusCheckConfigAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(config).
+		SetResult(&result).
+		Put(serviceDeskStatusCheckEndpoint)
+
+	if err != nil {
+		return nil, c.formatServiceDeskIntegrationError(serviceDeskIntegrationErrorContext{Operation: "update the status check configuration of"}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatServiceDeskIntegrationError(
+			serviceDeskIntegrationErrorContext{Operation: "update the status check configuration of", ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully updated service desk status check configuration")
+	return &result, nil
+}
+
+func (c *Client) formatServiceDeskIntegrationError(errCtx serviceDeskIntegrationErrorContext, err error, statusCode int) error {
+	var baseMsg string
+	switch {
+	case errCtx.ID != "":
+		baseMsg = fmt.Sprintf("failed to %s service desk integration '%s'", errCtx.Operation, errCtx.ID)
+	case errCtx.Name != "":
+		baseMsg = fmt.Sprintf("failed to %s service desk integration '%s'", errCtx.Operation, errCtx.Name)
+	default:
+		baseMsg = fmt.Sprintf("failed to %s service desk integrations", errCtx.Operation)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", baseMsg, err)
+	}
+
+	if statusCode != 0 {
+		detail := ""
+		if errCtx.ResponseBody != "" {
+			detail = fmt.Sprintf(" - response: %s", errCtx.ResponseBody)
+		}
+		switch statusCode {
+		case http.StatusBadRequest:
+			return fmt.Errorf("%s: invalid request (400)%s", baseMsg, detail)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+		case http.StatusForbidden:
+			return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+		case http.StatusConflict:
+			return fmt.Errorf("%s: conflict (409)%s", baseMsg, detail)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+		case http.StatusInternalServerError:
+			return fmt.Errorf("%s: server error (500)%s", baseMsg, detail)
+		default:
+			return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+		}
+	}
+
+	return fmt.Errorf("%s: unknown error", baseMsg)
+}
@@ -0,0 +1,392 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	sodPolicyEndpointGet      = "/v2025/sod-policies/{id}"
+	sodPolicyEndpointCreate   = "/v2025/sod-policies"
+	sodPolicyEndpointUpdate   = "/v2025/sod-policies/{id}"
+	sodPolicyEndpointDelete   = "/v2025/sod-policies/{id}"
+	sodPolicyEndpointSchedule = "/v2025/sod-policies/{id}/schedule"
+)
+
+// SODPolicyAPI represents a SailPoint Separation of Duties (SOD) policy from the API.
+type SODPolicyAPI struct {
+	ID                             string                                 `json:"id,omitempty"`
+	Name                           string                                 `json:"name"`
+	Description                    *string                                `json:"description,omitempty"`
+	Type                           string                                 `json:"type"`
+	OwnerRef                       *ObjectRefAPI                          `json:"ownerRef,omitempty"`
+	ExternalPolicyReference        *string                                `json:"externalPolicyReference,omitempty"`
+	PolicyQuery                    *string                                `json:"policyQuery,omitempty"`
+	CompensatingControls           *string                                `json:"compensatingControls,omitempty"`
+	CorrectionAdvice               *string                                `json:"correctionAdvice,omitempty"`
+	State                          *string                                `json:"state,omitempty"`
+	Tags                           []string                               `json:"tags,omitempty"`
+	ViolationOwnerAssignmentConfig *SODViolationOwnerAssignmentConfigAPI  `json:"violationOwnerAssignmentConfig,omitempty"`
+	Scheduled                      *bool                                  `json:"scheduled,omitempty"`
+	ConflictingAccessCriteria      *SODPolicyConflictingAccessCriteriaAPI `json:"conflictingAccessCriteria,omitempty"`
+	CreatorID                      *string                                `json:"creatorId,omitempty"`
+	ModifierID                     *string                                `json:"modifierId,omitempty"`
+	Created                        *string                                `json:"created,omitempty"`
+	Modified                       *string                                `json:"modified,omitempty"`
+}
+
+// SODPolicyTypes lists the valid SODPolicyAPI.Type values.
+var SODPolicyTypes = []string{SODPolicyTypeGeneral, SODPolicyTypeConflictingAccess}
+
+const (
+	SODPolicyTypeGeneral           = "GENERAL"
+	SODPolicyTypeConflictingAccess = "CONFLICTING_ACCESS_BASED"
+)
+
+// SODPolicyOwnerTypes lists the owner types accepted for a policy and for the owner of its violations.
+var SODPolicyOwnerTypes = []string{ObjectRefTypeIdentity, ObjectRefTypeGovernanceGroup}
+
+// SODPolicyStates lists the valid SODPolicyAPI.State values.
+var SODPolicyStates = []string{"ENFORCED", "NOT_ENFORCED"}
+
+// SODViolationOwnerAssignmentConfigAPI configures who owns the violations raised by a policy.
+type SODViolationOwnerAssignmentConfigAPI struct {
+	AssignmentRule *string       `json:"assignmentRule,omitempty"`
+	OwnerRef       *ObjectRefAPI `json:"ownerRef,omitempty"`
+}
+
+// SODViolationAssignmentRules lists the valid SODViolationOwnerAssignmentConfigAPI.AssignmentRule values.
+// MANAGER assigns violations to the violating identity's manager; STATIC assigns them to OwnerRef.
+var SODViolationAssignmentRules = []string{"MANAGER", "STATIC"}
+
+// SODPolicyConflictingAccessCriteriaAPI holds the two sides of a conflicting access policy.
+// An identity that has access from both sides is in violation.
+type SODPolicyConflictingAccessCriteriaAPI struct {
+	LeftCriteria  *SODAccessCriteriaAPI `json:"leftCriteria,omitempty"`
+	RightCriteria *SODAccessCriteriaAPI `json:"rightCriteria,omitempty"`
+}
+
+// SODAccessCriteriaAPI is one side of a conflicting access policy.
+type SODAccessCriteriaAPI struct {
+	Name         *string        `json:"name,omitempty"`
+	CriteriaList []ObjectRefAPI `json:"criteriaList"`
+}
+
+// SODAccessCriteriaTypes lists the object types accepted in SODAccessCriteriaAPI.CriteriaList.
+var SODAccessCriteriaTypes = []string{ObjectRefTypeEntitlement}
+
+// SODPolicyScheduleAPI represents the schedule on which a policy's violation report is run and sent.
+type SODPolicyScheduleAPI struct {
+	Name              *string        `json:"name,omitempty"`
+	Description       *string        `json:"description,omitempty"`
+	Schedule          *ScheduleAPI   `json:"schedule"`
+	Recipients        []ObjectRefAPI `json:"recipients"`
+	EmailEmptyResults bool           `json:"emailEmptyResults"`
+	CreatorID         *string        `json:"creatorId,omitempty"`
+	ModifierID        *string        `json:"modifierId,omitempty"`
+	Created           *string        `json:"created,omitempty"`
+	Modified          *string        `json:"modified,omitempty"`
+}
+
+// sodPolicyErrorContext provides context for error messages.
+type sodPolicyErrorContext struct {
+	Operation    string
+	ID           string
+	Name         string
+	ResponseBody string
+}
+
+// GetSODPolicy retrieves a specific SOD policy by ID.
+func (c *Client) GetSODPolicy(ctx context.Context, id string) (*SODPolicyAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("SOD policy ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Getting SOD policy", map[string]any{"id": id})
+
+	var policy SODPolicyAPI
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&policy).
+		SetPathParam("id", id).
+		Get(sodPolicyEndpointGet)
+
+	if err != nil {
+		return nil, c.formatSODPolicyError(sodPolicyErrorContext{Operation: "get", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatSODPolicyError(
+			sodPolicyErrorContext{Operation: "get", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully retrieved SOD policy", map[string]any{
+		"id":   id,
+		"name": policy.Name,
+	})
+	return &policy, nil
+}
+
+// CreateSODPolicy creates a new SOD policy.
+func (c *Client) CreateSODPolicy(ctx context.Context, policy *SODPolicyAPI) (*SODPolicyAPI, error) {
+	if policy == nil {
+		return nil, fmt.Errorf("SOD policy cannot be nil")
+	}
+	if policy.Name == "" {
+		return nil, fmt.Errorf("SOD policy name cannot be empty")
+	}
+
+	requestBody, _ := json.Marshal(policy)
+	tflog.Debug(ctx, "Creating SOD policy", map[string]any{
+		"name":         policy.Name,
+		"request_body": string(requestBody),
+	})
+
+	var result SODPolicyAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(policy).
+		SetResult(&result).
+		Post(sodPolicyEndpointCreate)
+
+	if err != nil {
+		return nil, c.formatSODPolicyError(sodPolicyErrorContext{Operation: "create", Name: policy.Name}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatSODPolicyError(
+			sodPolicyErrorContext{Operation: "create", Name: policy.Name, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully created SOD policy", map[string]any{
+		"id":   result.ID,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// UpdateSODPolicy replaces an existing SOD policy.
+func (c *Client) UpdateSODPolicy(ctx context.Context, id string, policy *SODPolicyAPI) (*SODPolicyAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("SOD policy ID cannot be empty")
+	}
+	if policy == nil {
+		return nil, fmt.Errorf("SOD policy cannot be nil")
+	}
+
+	requestBody, _ := json.Marshal(policy)
+	tflog.Debug(ctx, "Updating SOD policy", map[string]any{
+		"id":           id,
+		"request_body": string(requestBody),
+	})
+
+	var result SODPolicyAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(policy).
+		SetResult(&result).
+		SetPathParam("id", id).
+		Put(sodPolicyEndpointUpdate)
+
+	if err != nil {
+		return nil, c.formatSODPolicyError(sodPolicyErrorContext{Operation: "update", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatSODPolicyError(
+			sodPolicyErrorContext{Operation: "update", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully updated SOD policy", map[string]any{
+		"id":   id,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// DeleteSODPolicy deletes a SOD policy by ID. 404 is treated as success.
+func (c *Client) DeleteSODPolicy(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("SOD policy ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Deleting SOD policy", map[string]any{"id": id})
+
+	resp, err := c.prepareRequest(ctx).
+		SetPathParam("id", id).
+		Delete(sodPolicyEndpointDelete)
+
+	if err != nil {
+		return c.formatSODPolicyError(sodPolicyErrorContext{Operation: "delete", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		if resp.StatusCode() == http.StatusNotFound {
+			tflog.Debug(ctx, "SOD policy not found, treating as already deleted", map[string]any{"id": id})
+			return nil
+		}
+		return c.formatSODPolicyError(
+			sodPolicyErrorContext{Operation: "delete", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully deleted SOD policy", map[string]any{"id": id})
+	return nil
+}
+
+// GetSODPolicySchedule retrieves the violation report schedule of a SOD policy.
+// Returns an error wrapping ErrNotFound when the policy has no schedule.
+func (c *Client) GetSODPolicySchedule(ctx context.Context, id string) (*SODPolicyScheduleAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("SOD policy ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Getting SOD policy schedule", map[string]any{"id": id})
+
+	var schedule SODPolicyScheduleAPI
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&schedule).
+		SetPathParam("id", id).
+		Get(sodPolicyEndpointSchedule)
+
+	if err != nil {
+		return nil, c.formatSODPolicyError(sodPolicyErrorContext{Operation: "get schedule of", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatSODPolicyError(
+			sodPolicyErrorContext{Operation: "get schedule of", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully retrieved SOD policy schedule", map[string]any{"id": id})
+	return &schedule, nil
+}
+
+// SetSODPolicySchedule creates or replaces the violation report schedule of a SOD policy.
+func (c *Client) SetSODPolicySchedule(ctx context.Context, id string, schedule *SODPolicyScheduleAPI) (*SODPolicyScheduleAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("SOD policy ID cannot be empty")
+	}
+	if schedule == nil {
+		return nil, fmt.Errorf("SOD policy schedule cannot be nil")
+	}
+
+	requestBody, _ := json.Marshal(schedule)
+	tflog.Debug(ctx, "Setting SOD policy schedule", map[string]any{
+		"id":           id,
+		"request_body": string(requestBody),
+	})
+
+	var result SODPolicyScheduleAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(schedule).
+		SetResult(&result).
+		SetPathParam("id", id).
+		Put(sodPolicyEndpointSchedule)
+
+	if err != nil {
+		return nil, c.formatSODPolicyError(sodPolicyErrorContext{Operation: "set schedule of", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatSODPolicyError(
+			sodPolicyErrorContext{Operation: "set schedule of", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully set SOD policy schedule", map[string]any{"id": id})
+	return &result, nil
+}
+
+// DeleteSODPolicySchedule removes the violation report schedule of a SOD policy. 404 is treated as success.
+func (c *Client) DeleteSODPolicySchedule(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("SOD policy ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Deleting SOD policy schedule", map[string]any{"id": id})
+
+	resp, err := c.prepareRequest(ctx).
+		SetPathParam("id", id).
+		Delete(sodPolicyEndpointSchedule)
+
+	if err != nil {
+		return c.formatSODPolicyError(sodPolicyErrorContext{Operation: "delete schedule of", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		if resp.StatusCode() == http.StatusNotFound {
+			tflog.Debug(ctx, "SOD policy schedule not found, treating as already deleted", map[string]any{"id": id})
+			return nil
+		}
+		return c.formatSODPolicyError(
+			sodPolicyErrorContext{Operation: "delete schedule of", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully deleted SOD policy schedule", map[string]any{"id": id})
+	return nil
+}
+
+func (c *Client) formatSODPolicyError(errCtx sodPolicyErrorContext, err error, statusCode int) error {
+	var baseMsg string
+	switch {
+	case errCtx.ID != "":
+		baseMsg = fmt.Sprintf("failed to %s SOD policy '%s'", errCtx.Operation, errCtx.ID)
+	case errCtx.Name != "":
+		baseMsg = fmt.Sprintf("failed to %s SOD policy '%s'", errCtx.Operation, errCtx.Name)
+	default:
+		baseMsg = fmt.Sprintf("failed to %s SOD policy", errCtx.Operation)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", baseMsg, err)
+	}
+
+	if statusCode != 0 {
+		detail := ""
+		if errCtx.ResponseBody != "" {
+			detail = fmt.Sprintf(" - response: %s", errCtx.ResponseBody)
+		}
+		switch statusCode {
+		case http.StatusBadRequest:
+			return fmt.Errorf("%s: invalid request (400)%s", baseMsg, detail)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+		case http.StatusForbidden:
+			return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+		case http.StatusConflict:
+			return fmt.Errorf("%s: conflict (409)%s", baseMsg, detail)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+		case http.StatusInternalServerError:
+			return fmt.Errorf("%s: server error (500)%s", baseMsg, detail)
+		default:
+			return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+		}
+	}
+
+	return fmt.Errorf("%s: unknown error", baseMsg)
+}
@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	sourceEndpointCheckConnection  = "/v2025/sources/{id}/connector/check-connection"
+	sourceEndpointLoadAccounts     = "/v2025/sources/{id}/load-accounts"
+	sourceEndpointLoadEntitlements = "/v2025/sources/{id}/load-entitlements"
+)
+
+// Source connection check statuses.
+const (
+	SourceConnectionStatusSuccess = "SUCCESS"
+	SourceConnectionStatusFailure = "FAILURE"
+)
+
+// SourceConnectionStatusAPI is the result of a source connection check.
+type SourceConnectionStatusAPI struct {
+	ID            string         `json:"id,omitempty"`
+	Name          string         `json:"name,omitempty"`
+	Status        string         `json:"status"`
+	ElapsedMillis int64          `json:"elapsedMillis,omitempty"`
+	Details       map[string]any `json:"details,omitempty"`
+}
+
+// SourceLoadTaskAPI is the response to a source aggregation request: the task running the aggregation.
+type SourceLoadTaskAPI struct {
+	Success bool          `json:"success"`
+	Task    TaskStatusAPI `json:"task"`
+}
+
+// SourceLoadFileAPI is a CSV file uploaded to a delimited file source with an aggregation request.
+type SourceLoadFileAPI struct {
+	Name    string
+	Content io.Reader
+}
+
+// CheckSourceConnection checks the connection of a source with its current configuration.
+func (c *Client) CheckSourceConnection(ctx context.Context, id string) (*SourceConnectionStatusAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("source ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Checking source connection", map[string]any{
+		"id": id,
+	})
+
+	var result SourceConnectionStatusAPI
+
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&result).
+		SetPathParam("id", id).
+		Post(sourceEndpointCheckConnection)
+
+	if resp != nil && resp.IsError() {
+		return nil, c.formatSourceError(
+			sourceErrorContext{Operation: "check connection of", ID: id, ResponseBody: string(resp.Bytes())},
+			nil,
+			resp.StatusCode(),
+		)
+	}
+
+	if err != nil {
+		return nil, c.formatSourceError(
+			sourceErrorContext{Operation: "check connection of", ID: id},
+			err,
+			0,
+		)
+	}
+
+	tflog.Debug(ctx, "Checked source connection", map[string]any{
+		"id":     id,
+		"status": result.Status,
+	})
+
+	return &result, nil
+}
+
+// LoadSourceAccounts starts an account aggregation of a source and returns the aggregation task.
+// disableOptimization forces every account to be processed, even those unchanged since the last aggregation.
+// file, when not nil, is the CSV to aggregate from a delimited file source.
+func (c *Client) LoadSourceAccounts(ctx context.Context, id string, disableOptimization bool, file *SourceLoadFileAPI) (*SourceLoadTaskAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("source ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Starting source account aggregation", map[string]any{
+		"id":                   id,
+		"disable_optimization": disableOptimization,
+	})
+
+	var result SourceLoadTaskAPI
+
+	req := c.prepareExperimentalRequest(ctx).
+		SetMultipartFormData(map[string]string{"disableOptimization": strconv.FormatBool(disableOptimization)}).
+		SetResult(&result).
+		SetPathParam("id", id)
+	if file != nil {
+		req.SetMultipartField("file", file.Name, "text/csv", file.Content)
+	}
+
+	resp, err := req.Post(sourceEndpointLoadAccounts)
+
+	if resp != nil && resp.IsError() {
+		return nil, c.formatSourceError(
+			sourceErrorContext{Operation: "aggregate accounts of", ID: id, ResponseBody: string(resp.Bytes())},
+			nil,
+			resp.StatusCode(),
+		)
+	}
+
+	if err != nil {
+		return nil, c.formatSourceError(
+			sourceErrorContext{Operation: "aggregate accounts of", ID: id},
+			err,
+			0,
+		)
+	}
+
+	tflog.Info(ctx, "Started source account aggregation", map[string]any{
+		"id":      id,
+		"task_id": result.Task.ID,
+	})
+
+	return &result, nil
+}
+
+// LoadSourceEntitlements starts an entitlement aggregation of a source and returns the aggregation task.
+// file, when not nil, is the CSV to aggregate from a delimited file source.
+func (c *Client) LoadSourceEntitlements(ctx context.Context, id string, file *SourceLoadFileAPI) (*SourceLoadTaskAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("source ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Starting source entitlement aggregation", map[string]any{
+		"id": id,
+	})
+
+	var result SourceLoadTaskAPI
+
+	req := c.prepareExperimentalRequest(ctx).
+		SetMultipartFormData(map[string]string{}).
+		SetResult(&result).
+		SetPathParam("id", id)
+	if file != nil {
+		req.SetMultipartField("csvFile", file.Name, "text/csv", file.Content)
+	}
+
+	resp, err := req.Post(sourceEndpointLoadEntitlements)
+
+	if resp != nil && resp.IsError() {
+		return nil, c.formatSourceError(
+			sourceErrorContext{Operation: "aggregate entitlements of", ID: id, ResponseBody: string(resp.Bytes())},
+			nil,
+			resp.StatusCode(),
+		)
+	}
+
+	if err != nil {
+		return nil, c.formatSourceError(
+			sourceErrorContext{Operation: "aggregate entitlements of", ID: id},
+			err,
+			0,
+		)
+	}
+
+	tflog.Info(ctx, "Started source entitlement aggregation", map[string]any{
+		"id":      id,
+		"task_id": result.Task.ID,
+	})
+
+	return &result, nil
+}
@@ -0,0 +1,287 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	sourceScheduleEndpointCreate = "/v2025/sources/{sourceId}/schedules"
+	sourceScheduleEndpointGet    = "/v2025/sources/{sourceId}/schedules/{scheduleType}"
+	sourceScheduleEndpointPatch  = "/v2025/sources/{sourceId}/schedules/{scheduleType}"
+	sourceScheduleEndpointDelete = "/v2025/sources/{sourceId}/schedules/{scheduleType}"
+)
+
+// Source schedule types. A source has at most one schedule of each type.
+const (
+	SourceScheduleTypeAccountAggregation = "ACCOUNT_AGGREGATION"
+	SourceScheduleTypeGroupAggregation   = "GROUP_AGGREGATION"
+)
+
+// SourceScheduleTypes lists the valid SourceScheduleAPI.Type values.
+var SourceScheduleTypes = []string{SourceScheduleTypeAccountAggregation, SourceScheduleTypeGroupAggregation}
+
+// SourceScheduleAPI represents an aggregation schedule of a source.
+// CronExpression uses the Quartz format, seconds first (e.g. "0 0 5,13,21 * * ?").
+type SourceScheduleAPI struct {
+	Type           string `json:"type"`
+	CronExpression string `json:"cronExpression"`
+}
+
+// sourceScheduleErrorContext provides context for error messages.
+type sourceScheduleErrorContext struct {
+	Operation    string
+	SourceID     string
+	ScheduleType string
+	ResponseBody string
+}
+
+// GetSourceSchedule retrieves the schedule of the given type for a source.
+func (c *Client) GetSourceSchedule(ctx context.Context, sourceID, scheduleType string) (*SourceScheduleAPI, error) {
+	if sourceID == "" {
+		return nil, fmt.Errorf("source ID cannot be empty")
+	}
+
+	if scheduleType == "" {
+		return nil, fmt.Errorf("schedule type cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Getting source schedule", map[string]any{
+		"source_id":     sourceID,
+		"schedule_type": scheduleType,
+	})
+
+	var schedule SourceScheduleAPI
+
+	resp, err := c.prepareExperimentalRequest(ctx).
+		SetResult(&schedule).
+		SetPathParam("sourceId", sourceID).
+		SetPathParam("scheduleType", scheduleType).
+		Get(sourceScheduleEndpointGet)
+
+	if resp != nil && resp.IsError() {
+		return nil, c.formatSourceScheduleError(
+			sourceScheduleErrorContext{Operation: "get", SourceID: sourceID, ScheduleType: scheduleType, ResponseBody: string(resp.Bytes())},
+			nil,
+			resp.StatusCode(),
+		)
+	}
+
+	if err != nil {
+		return nil, c.formatSourceScheduleError(
+			sourceScheduleErrorContext{Operation: "get", SourceID: sourceID, ScheduleType: scheduleType},
+			err,
+			0,
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully retrieved source schedule", map[string]any{
+		"source_id":       sourceID,
+		"schedule_type":   scheduleType,
+		"cron_expression": schedule.CronExpression,
+	})
+
+	return &schedule, nil
+}
+
+// CreateSourceSchedule creates a schedule for a source.
+func (c *Client) CreateSourceSchedule(ctx context.Context, sourceID string, schedule *SourceScheduleAPI) (*SourceScheduleAPI, error) {
+	if sourceID == "" {
+		return nil, fmt.Errorf("source ID cannot be empty")
+	}
+
+	if schedule == nil {
+		return nil, fmt.Errorf("schedule cannot be nil")
+	}
+
+	tflog.Debug(ctx, "Creating source schedule", map[string]any{
+		"source_id":       sourceID,
+		"schedule_type":   schedule.Type,
+		"cron_expression": schedule.CronExpression,
+	})
+
+	var result SourceScheduleAPI
+
+	resp, err := c.prepareExperimentalRequest(ctx).
+		SetBody(schedule).
+		SetResult(&result).
+		SetPathParam("sourceId", sourceID).
+		Post(sourceScheduleEndpointCreate)
+
+	if resp != nil && resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatSourceScheduleError(
+			sourceScheduleErrorContext{Operation: "create", SourceID: sourceID, ScheduleType: schedule.Type, ResponseBody: string(resp.Bytes())},
+			nil,
+			resp.StatusCode(),
+		)
+	}
+
+	if err != nil {
+		return nil, c.formatSourceScheduleError(
+			sourceScheduleErrorContext{Operation: "create", SourceID: sourceID, ScheduleType: schedule.Type},
+			err,
+			0,
+		)
+	}
+
+	tflog.Info(ctx, "Successfully created source schedule", map[string]any{
+		"source_id":     sourceID,
+		"schedule_type": result.Type,
+	})
+
+	return &result, nil
+}
+
+// PatchSourceSchedule applies JSON Patch operations to the schedule of the given type for a source.
+func (c *Client) PatchSourceSchedule(ctx context.Context, sourceID, scheduleType string, patchOps []JSONPatchOperation) (*SourceScheduleAPI, error) {
+	if sourceID == "" {
+		return nil, fmt.Errorf("source ID cannot be empty")
+	}
+
+	if scheduleType == "" {
+		return nil, fmt.Errorf("schedule type cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Patching source schedule", map[string]any{
+		"source_id":        sourceID,
+		"schedule_type":    scheduleType,
+		"operations_count": len(patchOps),
+	})
+
+	var result SourceScheduleAPI
+
+	resp, err := c.prepareExperimentalRequest(ctx).
+		SetHeader("Content-Type", "application/json-patch+json").
+		SetBody(patchOps).
+		SetResult(&result).
+		SetPathParam("sourceId", sourceID).
+		SetPathParam("scheduleType", scheduleType).
+		Patch(sourceScheduleEndpointPatch)
+
+	if resp != nil && resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatSourceScheduleError(
+			sourceScheduleErrorContext{Operation: "patch", SourceID: sourceID, ScheduleType: scheduleType, ResponseBody: string(resp.Bytes())},
+			nil,
+			resp.StatusCode(),
+		)
+	}
+
+	if err != nil {
+		return nil, c.formatSourceScheduleError(
+			sourceScheduleErrorContext{Operation: "patch", SourceID: sourceID, ScheduleType: scheduleType},
+			err,
+			0,
+		)
+	}
+
+	tflog.Info(ctx, "Successfully patched source schedule", map[string]any{
+		"source_id":     sourceID,
+		"schedule_type": scheduleType,
+	})
+
+	return &result, nil
+}
+
+// DeleteSourceSchedule deletes the schedule of the given type for a source.
+func (c *Client) DeleteSourceSchedule(ctx context.Context, sourceID, scheduleType string) error {
+	if sourceID == "" {
+		return fmt.Errorf("source ID cannot be empty")
+	}
+
+	if scheduleType == "" {
+		return fmt.Errorf("schedule type cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Deleting source schedule", map[string]any{
+		"source_id":     sourceID,
+		"schedule_type": scheduleType,
+	})
+
+	resp, err := c.prepareExperimentalRequest(ctx).
+		SetPathParam("sourceId", sourceID).
+		SetPathParam("scheduleType", scheduleType).
+		Delete(sourceScheduleEndpointDelete)
+
+	if resp != nil && resp.IsError() {
+		// 404 is acceptable for delete - resource might already be deleted
+		if resp.StatusCode() == http.StatusNotFound {
+			tflog.Debug(ctx, "Source schedule not found, treating as already deleted", map[string]any{
+				"source_id":     sourceID,
+				"schedule_type": scheduleType,
+			})
+			return nil
+		}
+
+		return c.formatSourceScheduleError(
+			sourceScheduleErrorContext{Operation: "delete", SourceID: sourceID, ScheduleType: scheduleType, ResponseBody: string(resp.Bytes())},
+			nil,
+			resp.StatusCode(),
+		)
+	}
+
+	if err != nil {
+		return c.formatSourceScheduleError(
+			sourceScheduleErrorContext{Operation: "delete", SourceID: sourceID, ScheduleType: scheduleType},
+			err,
+			0,
+		)
+	}
+
+	tflog.Info(ctx, "Successfully deleted source schedule", map[string]any{
+		"source_id":     sourceID,
+		"schedule_type": scheduleType,
+	})
+
+	return nil
+}
+
+// formatSourceScheduleError formats errors with appropriate context for source schedule operations.
+func (c *Client) formatSourceScheduleError(errCtx sourceScheduleErrorContext, err error, statusCode int) error {
+	baseMsg := fmt.Sprintf("failed to %s %s schedule for source '%s'", errCtx.Operation, errCtx.ScheduleType, errCtx.SourceID)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", baseMsg, err)
+	}
+
+	if statusCode != 0 {
+		detail := ""
+		if errCtx.ResponseBody != "" {
+			detail = fmt.Sprintf(" - response: %s", errCtx.ResponseBody)
+		}
+
+		switch statusCode {
+		case http.StatusBadRequest:
+			return fmt.Errorf("%s: invalid request (400)%s", baseMsg, detail)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+		case http.StatusForbidden:
+			return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+		case http.StatusConflict:
+			return fmt.Errorf("%s: conflict (409)%s", baseMsg, detail)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+		case http.StatusInternalServerError:
+			return fmt.Errorf("%s: server error (500)%s", baseMsg, detail)
+		default:
+			return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+		}
+	}
+
+	return fmt.Errorf("%s: unknown error", baseMsg)
+}
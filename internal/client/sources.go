@@ -13,6 +13,7 @@ import (
 )
 
 const (
+	sourceEndpointList   = "/v2025/sources"
 	sourceEndpointGet    = "/v2025/sources/{id}"
 	sourceEndpointCreate = "/v2025/sources"
 	sourceEndpointUpdate = "/v2025/sources/{id}"
@@ -51,6 +52,22 @@ type sourceErrorContext struct {
 	ResponseBody string
 }
 
+// ListSources retrieves all sources matching the given filter expression (e.g., `name sw "HR"`),
+// following pagination. Pass an empty string to omit the filter.
+func (c *Client) ListSources(ctx context.Context, filters string) ([]SourceAPI, error) {
+	tflog.Debug(ctx, "Listing sources", map[string]any{"filters": filters})
+
+	sources, err := listPages[SourceAPI](ctx, c, sourceEndpointList, nil, filters, func(err error, statusCode int, responseBody string) error {
+		return c.formatSourceError(sourceErrorContext{Operation: "list", ResponseBody: responseBody}, err, statusCode)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Successfully listed sources", map[string]any{"count": len(sources)})
+	return sources, nil
+}
+
 // GetSource retrieves a specific source by ID.
 func (c *Client) GetSource(ctx context.Context, id string) (*SourceAPI, error) {
 	if id == "" {
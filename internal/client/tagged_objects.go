@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	taggedObjectEndpointList   = "/v2025/tagged-objects"
+	taggedObjectEndpointObject = "/v2025/tagged-objects/{type}/{id}"
+
+	// taggedObjectsPageSize is the maximum page size accepted by the tagged objects list endpoint.
+	taggedObjectsPageSize = 250
+)
+
+// TaggedObjectAPI represents the tags set on a SailPoint object.
+type TaggedObjectAPI struct {
+	ObjectRef ObjectRefAPI `json:"objectRef"`
+	Tags      []string     `json:"tags"`
+}
+
+// TaggableObjectTypes lists the object types that can be tagged.
+var TaggableObjectTypes = []string{
+	ObjectRefTypeAccessProfile,
+	"APPLICATION",
+	"CAMPAIGN",
+	ObjectRefTypeEntitlement,
+	ObjectRefTypeIdentity,
+	ObjectRefTypeRole,
+	"SOD_POLICY",
+	ObjectRefTypeSource,
+}
+
+// taggedObjectErrorContext provides context for error messages.
+type taggedObjectErrorContext struct {
+	Operation    string
+	ID           string
+	Name         string
+	ResponseBody string
+}
+
+// ListTaggedObjects retrieves all tagged objects matching the given filter expression
+// (e.g., `tagName eq "pci"`), following pagination. Pass an empty string to omit the filter.
+func (c *Client) ListTaggedObjects(ctx context.Context, filters string) ([]TaggedObjectAPI, error) {
+	tflog.Debug(ctx, "Listing tagged objects", map[string]any{"filters": filters})
+
+	var objects []TaggedObjectAPI
+	for offset := 0; ; offset += taggedObjectsPageSize {
+		var page []TaggedObjectAPI
+		req := c.prepareRequest(ctx).
+			SetResult(&page).
+			SetQueryParam("limit", strconv.Itoa(taggedObjectsPageSize)).
+			SetQueryParam("offset", strconv.Itoa(offset))
+		if filters != "" {
+			req.SetQueryParam("filters", filters)
+		}
+
+		resp, err := req.Get(taggedObjectEndpointList)
+		if err != nil {
+			return nil, c.formatTaggedObjectError(taggedObjectErrorContext{Operation: "list"}, err, 0)
+		}
+		if resp.IsError() {
+			return nil, c.formatTaggedObjectError(
+				taggedObjectErrorContext{Operation: "list", ResponseBody: string(resp.Bytes())},
+				nil, resp.StatusCode(),
+			)
+		}
+
+		objects = append(objects, page...)
+		if len(page) < taggedObjectsPageSize {
+			break
+		}
+	}
+
+	tflog.Debug(ctx, "Successfully listed tagged objects", map[string]any{"count": len(objects)})
+	return objects, nil
+}
+
+// GetTaggedObject retrieves the tags set on an object.
+func (c *Client) GetTaggedObject(ctx context.Context, objectType, id string) (*TaggedObjectAPI, error) {
+	if objectType == "" || id == "" {
+		return nil, fmt.Errorf("tagged object type and ID cannot be empty")
+	}
+
+	ref := objectType + "/" + id
+	tflog.Debug(ctx, "Getting tagged object", map[string]any{"object": ref})
+
+	var object TaggedObjectAPI
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&object).
+		SetPathParam("type", objectType).
+		SetPathParam("id", id).
+		Get(taggedObjectEndpointObject)
+
+	if err != nil {
+		return nil, c.formatTaggedObjectError(taggedObjectErrorContext{Operation: "get", ID: ref}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatTaggedObjectError(
+			taggedObjectErrorContext{Operation: "get", ID: ref, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully retrieved tagged object", map[string]any{
+		"object": ref,
+		"tags":   object.Tags,
+	})
+	return &object, nil
+}
+
+// SetTaggedObject replaces the tags set on an object (PUT).
+func (c *Client) SetTaggedObject(ctx context.Context, object *TaggedObjectAPI) (*TaggedObjectAPI, error) {
+	if object == nil {
+		return nil, fmt.Errorf("tagged object cannot be nil")
+	}
+	if object.ObjectRef.Type == "" || object.ObjectRef.ID == "" {
+		return nil, fmt.Errorf("tagged object type and ID cannot be empty")
+	}
+
+	ref := object.ObjectRef.Type + "/" + object.ObjectRef.ID
+	requestBody, _ := json.Marshal(object)
+	tflog.Debug(ctx, "Setting tagged object", map[string]any{
+		"object":       ref,
+		"request_body": string(requestBody),
+	})
+
+	var result TaggedObjectAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(object).
+		SetResult(&result).
+		SetPathParam("type", object.ObjectRef.Type).
+		SetPathParam("id", object.ObjectRef.ID).
+		Put(taggedObjectEndpointObject)
+
+	if err != nil {
+		return nil, c.formatTaggedObjectError(taggedObjectErrorContext{Operation: "set", ID: ref}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatTaggedObjectError(
+			taggedObjectErrorContext{Operation: "set", ID: ref, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully set tagged object", map[string]any{
+		"object": ref,
+		"tags":   result.Tags,
+	})
+	return &result, nil
+}
+
+// DeleteTaggedObject removes all tags from an object. 404 is treated as success.
+func (c *Client) DeleteTaggedObject(ctx context.Context, objectType, id string) error {
+	if objectType == "" || id == "" {
+		return fmt.Errorf("tagged object type and ID cannot be empty")
+	}
+
+	ref := objectType + "/" + id
+	tflog.Debug(ctx, "Deleting tagged object", map[string]any{"object": ref})
+
+	resp, err := c.prepareRequest(ctx).
+		SetPathParam("type", objectType).
+		SetPathParam("id", id).
+		Delete(taggedObjectEndpointObject)
+
+	if err != nil {
+		return c.formatTaggedObjectError(taggedObjectErrorContext{Operation: "delete", ID: ref}, err, 0)
+	}
+	if resp.IsError() {
+		if resp.StatusCode() == http.StatusNotFound {
+			tflog.Debug(ctx, "Tagged object not found, treating as already deleted", map[string]any{"object": ref})
+			return nil
+		}
+		return c.formatTaggedObjectError(
+			taggedObjectErrorContext{Operation: "delete", ID: ref, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully deleted tagged object", map[string]any{"object": ref})
+	return nil
+}
+
+func (c *Client) formatTaggedObjectError(errCtx taggedObjectErrorContext, err error, statusCode int) error {
+	var baseMsg string
+	switch {
+	case errCtx.ID != "":
+		baseMsg = fmt.Sprintf("failed to %s tagged object '%s'", errCtx.Operation, errCtx.ID)
+	case errCtx.Name != "":
+		baseMsg = fmt.Sprintf("failed to %s tagged object '%s'", errCtx.Operation, errCtx.Name)
+	default:
+		baseMsg = fmt.Sprintf("failed to %s tagged objects", errCtx.Operation)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", baseMsg, err)
+	}
+
+	if statusCode != 0 {
+		detail := ""
+		if errCtx.ResponseBody != "" {
+			detail = fmt.Sprintf(" - response: %s", errCtx.ResponseBody)
+		}
+		switch statusCode {
+		case http.StatusBadRequest:
+			return fmt.Errorf("%s: invalid request (400)%s", baseMsg, detail)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+		case http.StatusForbidden:
+			return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+		case http.StatusConflict:
+			return fmt.Errorf("%s: conflict (409)%s", baseMsg, detail)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+		case http.StatusInternalServerError:
+			return fmt.Errorf("%s: server error (500)%s", baseMsg, detail)
+		default:
+			return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+		}
+	}
+
+	return fmt.Errorf("%s: unknown error", baseMsg)
+}
@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	taskStatusEndpointGet = "/v2025/task-status/{id}"
+)
+
+// Task completion statuses. A task that is still running has no completion status.
+const (
+	TaskCompletionStatusSuccess    = "SUCCESS"
+	TaskCompletionStatusWarning    = "WARNING"
+	TaskCompletionStatusError      = "ERROR"
+	TaskCompletionStatusTerminated = "TERMINATED"
+	TaskCompletionStatusTempError  = "TEMPERROR"
+)
+
+// Task message types.
+const (
+	TaskMessageTypeInfo  = "INFO"
+	TaskMessageTypeWarn  = "WARN"
+	TaskMessageTypeError = "ERROR"
+)
+
+// TaskStatusAPI represents the status of a SailPoint task, such as an aggregation.
+type TaskStatusAPI struct {
+	ID               string                 `json:"id"`
+	Type             string                 `json:"type,omitempty"`
+	UniqueName       string                 `json:"uniqueName,omitempty"`
+	Description      string                 `json:"description,omitempty"`
+	Launcher         string                 `json:"launcher,omitempty"`
+	Created          string                 `json:"created,omitempty"`
+	Launched         *string                `json:"launched,omitempty"`
+	Completed        *string                `json:"completed,omitempty"`
+	CompletionStatus *string                `json:"completionStatus,omitempty"`
+	Messages         []TaskStatusMessageAPI `json:"messages,omitempty"`
+	Progress         *string                `json:"progress,omitempty"`
+	PercentComplete  *int64                 `json:"percentComplete,omitempty"`
+}
+
+// TaskStatusMessageAPI is a message reported by a task.
+type TaskStatusMessageAPI struct {
+	Type          string            `json:"type"`
+	Key           string            `json:"key,omitempty"`
+	LocalizedText *LocalizedTextAPI `json:"localizedText,omitempty"`
+}
+
+// LocalizedTextAPI is a message in a given locale.
+type LocalizedTextAPI struct {
+	Locale  string `json:"locale,omitempty"`
+	Message string `json:"message"`
+}
+
+// Text returns the message text, falling back to its key when it has no localized text.
+func (m TaskStatusMessageAPI) Text() string {
+	if m.LocalizedText != nil && m.LocalizedText.Message != "" {
+		return m.LocalizedText.Message
+	}
+	return m.Key
+}
+
+// IsComplete reports whether the task has finished, successfully or not.
+func (t *TaskStatusAPI) IsComplete() bool {
+	return t.CompletionStatus != nil && *t.CompletionStatus != ""
+}
+
+// GetTaskStatus retrieves the status of a task by ID.
+func (c *Client) GetTaskStatus(ctx context.Context, id string) (*TaskStatusAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("task ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Getting task status", map[string]any{
+		"id": id,
+	})
+
+	var task TaskStatusAPI
+
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&task).
+		SetPathParam("id", id).
+		Get(taskStatusEndpointGet)
+
+	if resp != nil && resp.IsError() {
+		return nil, formatTaskStatusError(id, resp.StatusCode(), string(resp.Bytes()))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task status '%s': %w", id, err)
+	}
+
+	return &task, nil
+}
+
+// WaitForTask polls the status of a task every interval until it completes or ctx is done.
+// onPoll, when not nil, is called with each status read before completion.
+func (c *Client) WaitForTask(ctx context.Context, id string, interval time.Duration, onPoll func(*TaskStatusAPI)) (*TaskStatusAPI, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		task, err := c.GetTaskStatus(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if task.IsComplete() {
+			tflog.Debug(ctx, "Task completed", map[string]any{
+				"id":                id,
+				"completion_status": *task.CompletionStatus,
+			})
+			return task, nil
+		}
+		if onPoll != nil {
+			onPoll(task)
+		}
+
+		select {
+		case <-ctx.Done():
+			return task, fmt.Errorf("task '%s' did not complete: %w", id, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func formatTaskStatusError(id string, statusCode int, responseBody string) error {
+	baseMsg := fmt.Sprintf("failed to get task status '%s'", id)
+
+	detail := ""
+	if responseBody != "" {
+		detail = fmt.Sprintf(" - response: %s", responseBody)
+	}
+
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+	case http.StatusForbidden:
+		return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+	case http.StatusNotFound:
+		return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+	default:
+		return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+	}
+}
@@ -37,37 +37,19 @@ const (
 	transformEndpointDelete = "/v2025/transforms/{id}"
 )
 
-// ListTransforms retrieves all transforms from SailPoint.
-// Returns a slice of TransformAPI and any error encountered.
-func (c *Client) ListTransforms(ctx context.Context) ([]TransformAPI, error) {
-	tflog.Debug(ctx, "Listing transforms")
-
-	var transforms []TransformAPI
-
-	resp, err := c.prepareRequest(ctx).
-		SetResult(&transforms).
-		Get(transformEndpointList)
+// ListTransforms retrieves all transforms matching the given filter expression (e.g., `internal eq false`),
+// following pagination. Pass an empty string to omit the filter.
+func (c *Client) ListTransforms(ctx context.Context, filters string) ([]TransformAPI, error) {
+	tflog.Debug(ctx, "Listing transforms", map[string]any{"filters": filters})
 
+	transforms, err := listPages[TransformAPI](ctx, c, transformEndpointList, nil, filters, func(err error, statusCode int, responseBody string) error {
+		return c.formatTransformError(transformErrorContext{Operation: "list", ResponseBody: responseBody}, err, statusCode)
+	})
 	if err != nil {
-		return nil, c.formatTransformError(
-			transformErrorContext{Operation: "list"},
-			err,
-			0,
-		)
+		return nil, err
 	}
 
-	if resp.IsError() {
-		return nil, c.formatTransformError(
-			transformErrorContext{Operation: "list", ResponseBody: string(resp.Bytes())},
-			nil,
-			resp.StatusCode(),
-		)
-	}
-
-	tflog.Debug(ctx, "Successfully listed transforms", map[string]any{
-		"count": len(transforms),
-	})
-
+	tflog.Debug(ctx, "Successfully listed transforms", map[string]any{"count": len(transforms)})
 	return transforms, nil
 }
 
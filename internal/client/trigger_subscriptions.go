@@ -0,0 +1,355 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	eventTriggerEndpointList          = "/v2025/triggers"
+	triggerSubscriptionEndpointList   = "/v2025/trigger-subscriptions"
+	triggerSubscriptionEndpointCreate = "/v2025/trigger-subscriptions"
+	triggerSubscriptionEndpointUpdate = "/v2025/trigger-subscriptions/{id}"
+	triggerSubscriptionEndpointDelete = "/v2025/trigger-subscriptions/{id}"
+)
+
+// EventTriggerAPI represents a SailPoint event trigger from the API.
+// InputSchema and ExampleInput describe the payload sent to subscribers.
+type EventTriggerAPI struct {
+	ID            string          `json:"id"`
+	Name          string          `json:"name"`
+	Description   *string         `json:"description,omitempty"`
+	Type          string          `json:"type"`
+	InputSchema   *string         `json:"inputSchema,omitempty"`
+	ExampleInput  json.RawMessage `json:"exampleInput,omitempty"`
+	OutputSchema  *string         `json:"outputSchema,omitempty"`
+	ExampleOutput json.RawMessage `json:"exampleOutput,omitempty"`
+}
+
+// TriggerSubscriptionAPI represents a SailPoint event trigger subscription from the API.
+type TriggerSubscriptionAPI struct {
+	ID                string                `json:"id,omitempty"`
+	Name              string                `json:"name"`
+	Description       *string               `json:"description,omitempty"`
+	TriggerID         string                `json:"triggerId"`
+	TriggerName       *string               `json:"triggerName,omitempty"`
+	Type              string                `json:"type"`
+	ResponseDeadline  *string               `json:"responseDeadline,omitempty"`
+	HTTPConfig        *TriggerHTTPConfigAPI `json:"httpConfig,omitempty"`
+	EventBridgeConfig *EventBridgeConfigAPI `json:"eventBridgeConfig,omitempty"`
+	Enabled           bool                  `json:"enabled"`
+	Filter            *string               `json:"filter,omitempty"`
+}
+
+// TriggerSubscriptionTypes lists the TriggerSubscriptionAPI.Type values managed by the provider.
+// WORKFLOW subscriptions are created through workflow triggers.
+var TriggerSubscriptionTypes = []string{TriggerSubscriptionTypeHTTP, TriggerSubscriptionTypeEventBridge}
+
+const (
+	TriggerSubscriptionTypeHTTP        = "HTTP"
+	TriggerSubscriptionTypeEventBridge = "EVENTBRIDGE"
+)
+
+// TriggerHTTPConfigAPI configures delivery of trigger events to an HTTP endpoint.
+// The secrets in BasicAuthConfig and BearerTokenAuthConfig are never returned by the API.
+type TriggerHTTPConfigAPI struct {
+	URL                    string                `json:"url"`
+	HTTPDispatchMode       string                `json:"httpDispatchMode,omitempty"`
+	HTTPAuthenticationType string                `json:"httpAuthenticationType,omitempty"`
+	BasicAuthConfig        *BasicAuthConfigAPI   `json:"basicAuthConfig,omitempty"`
+	BearerTokenAuthConfig  *BearerTokenConfigAPI `json:"bearerTokenAuthConfig,omitempty"`
+}
+
+// TriggerHTTPDispatchModes lists the valid TriggerHTTPConfigAPI.HTTPDispatchMode values.
+var TriggerHTTPDispatchModes = []string{"SYNC", "ASYNC", "DYNAMIC"}
+
+// TriggerHTTPAuthenticationTypes lists the valid TriggerHTTPConfigAPI.HTTPAuthenticationType values.
+var TriggerHTTPAuthenticationTypes = []string{
+	TriggerHTTPAuthenticationNone,
+	TriggerHTTPAuthenticationBasic,
+	TriggerHTTPAuthenticationBearer,
+}
+
+const (
+	TriggerHTTPAuthenticationNone   = "NO_AUTH"
+	TriggerHTTPAuthenticationBasic  = "BASIC_AUTH"
+	TriggerHTTPAuthenticationBearer = "BEARER_TOKEN"
+)
+
+// BasicAuthConfigAPI holds the credentials of BASIC_AUTH HTTP subscriptions.
+type BasicAuthConfigAPI struct {
+	UserName string  `json:"userName"`
+	Password *string `json:"password,omitempty"`
+}
+
+// BearerTokenConfigAPI holds the token of BEARER_TOKEN HTTP subscriptions.
+type BearerTokenConfigAPI struct {
+	BearerToken *string `json:"bearerToken,omitempty"`
+}
+
+// redacted returns a copy of the subscription with its HTTP credentials masked, for logging.
+func (s *TriggerSubscriptionAPI) redacted() TriggerSubscriptionAPI {
+	masked := "<redacted>"
+	result := *s
+	if s.HTTPConfig != nil {
+		httpConfig := *s.HTTPConfig
+		if httpConfig.BasicAuthConfig != nil && httpConfig.BasicAuthConfig.Password != nil {
+			basic := *httpConfig.BasicAuthConfig
+			basic.Password = &masked
+			httpConfig.BasicAuthConfig = &basic
+		}
+		if httpConfig.BearerTokenAuthConfig != nil && httpConfig.BearerTokenAuthConfig.BearerToken != nil {
+			httpConfig.BearerTokenAuthConfig = &BearerTokenConfigAPI{BearerToken: &masked}
+		}
+		result.HTTPConfig = &httpConfig
+	}
+	return result
+}
+
+// EventBridgeConfigAPI configures delivery of trigger events to an AWS EventBridge partner event source.
+type EventBridgeConfigAPI struct {
+	AWSAccount string `json:"awsAccount"`
+	AWSRegion  string `json:"awsRegion"`
+}
+
+// triggerSubscriptionErrorContext provides context for error messages.
+type triggerSubscriptionErrorContext struct {
+	Operation    string
+	ID           string
+	Name         string
+	ResponseBody string
+}
+
+// ListEventTriggers retrieves the event triggers available in the tenant.
+func (c *Client) ListEventTriggers(ctx context.Context) ([]EventTriggerAPI, error) {
+	tflog.Debug(ctx, "Listing event triggers")
+
+	var triggers []EventTriggerAPI
+	resp, err := c.prepareExperimentalRequest(ctx).
+		SetResult(&triggers).
+		Get(eventTriggerEndpointList)
+
+	if err != nil {
+		return nil, c.formatTriggerSubscriptionError(triggerSubscriptionErrorContext{Operation: "list event triggers for"}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatTriggerSubscriptionError(
+			triggerSubscriptionErrorContext{Operation: "list event triggers for", ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully listed event triggers", map[string]any{"count": len(triggers)})
+	return triggers, nil
+}
+
+// ListTriggerSubscriptions retrieves trigger subscriptions matching the given filter expression
+// (e.g., `triggerId eq "idn:identity-attributes-changed"`). Pass an empty string to omit the filter.
+func (c *Client) ListTriggerSubscriptions(ctx context.Context, filters string) ([]TriggerSubscriptionAPI, error) {
+	tflog.Debug(ctx, "Listing trigger subscriptions", map[string]any{"filters": filters})
+
+	var subscriptions []TriggerSubscriptionAPI
+	req := c.prepareExperimentalRequest(ctx).
+		SetResult(&subscriptions)
+	if filters != "" {
+		req.SetQueryParam("filters", filters)
+	}
+
+	resp, err := req.Get(triggerSubscriptionEndpointList)
+	if err != nil {
+		return nil, c.formatTriggerSubscriptionError(triggerSubscriptionErrorContext{Operation: "list"}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatTriggerSubscriptionError(
+			triggerSubscriptionErrorContext{Operation: "list", ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully listed trigger subscriptions", map[string]any{"count": len(subscriptions)})
+	return subscriptions, nil
+}
+
+// GetTriggerSubscription retrieves a specific trigger subscription by ID. The API has no endpoint
+// for a single subscription, so the list is filtered by ID.
+func (c *Client) GetTriggerSubscription(ctx context.Context, id string) (*TriggerSubscriptionAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("trigger subscription ID cannot be empty")
+	}
+
+	subscriptions, err := c.ListTriggerSubscriptions(ctx, fmt.Sprintf("id eq %q", id))
+	if err != nil {
+		return nil, err
+	}
+	for i := range subscriptions {
+		if subscriptions[i].ID == id {
+			return &subscriptions[i], nil
+		}
+	}
+	return nil, c.formatTriggerSubscriptionError(triggerSubscriptionErrorContext{Operation: "get", ID: id}, nil, http.StatusNotFound)
+}
+
+// CreateTriggerSubscription creates a new trigger subscription.
+func (c *Client) CreateTriggerSubscription(ctx context.Context, subscription *TriggerSubscriptionAPI) (*TriggerSubscriptionAPI, error) {
+	if subscription == nil {
+		return nil, fmt.Errorf("trigger subscription cannot be nil")
+	}
+	if subscription.Name == "" {
+		return nil, fmt.Errorf("trigger subscription name cannot be empty")
+	}
+
+	requestBody, _ := json.Marshal(subscription.redacted())
+	tflog.Debug(ctx, "Creating trigger subscription", map[string]any{
+		"name":         subscription.Name,
+		"request_body": string(requestBody),
+	})
+
+	var result TriggerSubscriptionAPI
+	resp, err := c.prepareExperimentalRequest(ctx).
+		SetBody(subscription).
+		SetResult(&result).
+		Post(triggerSubscriptionEndpointCreate)
+
+	if err != nil {
+		return nil, c.formatTriggerSubscriptionError(triggerSubscriptionErrorContext{Operation: "create", Name: subscription.Name}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatTriggerSubscriptionError(
+			triggerSubscriptionErrorContext{Operation: "create", Name: subscription.Name, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully created trigger subscription", map[string]any{
+		"id":   result.ID,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// UpdateTriggerSubscription replaces a trigger subscription (PUT) and returns the updated state.
+func (c *Client) UpdateTriggerSubscription(ctx context.Context, id string, subscription *TriggerSubscriptionAPI) (*TriggerSubscriptionAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("trigger subscription ID cannot be empty")
+	}
+	if subscription == nil {
+		return nil, fmt.Errorf("trigger subscription cannot be nil")
+	}
+
+	requestBody, _ := json.Marshal(subscription.redacted())
+	tflog.Debug(ctx, "Updating trigger subscription", map[string]any{
+		"id":           id,
+		"request_body": string(requestBody),
+	})
+
+	var result TriggerSubscriptionAPI
+	resp, err := c.prepareExperimentalRequest(ctx).
+		SetBody(subscription).
+		SetResult(&result).
+		SetPathParam("id", id).
+		Put(triggerSubscriptionEndpointUpdate)
+
+	if err != nil {
+		return nil, c.formatTriggerSubscriptionError(triggerSubscriptionErrorContext{Operation: "update", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatTriggerSubscriptionError(
+			triggerSubscriptionErrorContext{Operation: "update", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully updated trigger subscription", map[string]any{
+		"id":   id,
+		"name": result.Name,
+	})
+	return &result, nil
+}
+
+// DeleteTriggerSubscription deletes a trigger subscription by ID. 404 is treated as success.
+func (c *Client) DeleteTriggerSubscription(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("trigger subscription ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Deleting trigger subscription", map[string]any{"id": id})
+
+	resp, err := c.prepareExperimentalRequest(ctx).
+		SetPathParam("id", id).
+		Delete(triggerSubscriptionEndpointDelete)
+
+	if err != nil {
+		return c.formatTriggerSubscriptionError(triggerSubscriptionErrorContext{Operation: "delete", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		if resp.StatusCode() == http.StatusNotFound {
+			tflog.Debug(ctx, "Trigger subscription not found, treating as already deleted", map[string]any{"id": id})
+			return nil
+		}
+		return c.formatTriggerSubscriptionError(
+			triggerSubscriptionErrorContext{Operation: "delete", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully deleted trigger subscription", map[string]any{"id": id})
+	return nil
+}
+
+func (c *Client) formatTriggerSubscriptionError(errCtx triggerSubscriptionErrorContext, err error, statusCode int) error {
+	var baseMsg string
+	switch {
+	case errCtx.ID != "":
+		baseMsg = fmt.Sprintf("failed to %s trigger subscription '%s'", errCtx.Operation, errCtx.ID)
+	case errCtx.Name != "":
+		baseMsg = fmt.Sprintf("failed to %s trigger subscription '%s'", errCtx.Operation, errCtx.Name)
+	default:
+		baseMsg = fmt.Sprintf("failed to %s trigger subscriptions", errCtx.Operation)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", baseMsg, err)
+	}
+
+	if statusCode != 0 {
+		detail := ""
+		if errCtx.ResponseBody != "" {
+			detail = fmt.Sprintf(" - response: %s", errCtx.ResponseBody)
+		}
+		switch statusCode {
+		case http.StatusBadRequest:
+			return fmt.Errorf("%s: invalid request (400)%s", baseMsg, detail)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+		case http.StatusForbidden:
+			return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+		case http.StatusConflict:
+			return fmt.Errorf("%s: conflict (409)%s", baseMsg, detail)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+		case http.StatusInternalServerError:
+			return fmt.Errorf("%s: server error (500)%s", baseMsg, detail)
+		default:
+			return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+		}
+	}
+
+	return fmt.Errorf("%s: unknown error", baseMsg)
+}
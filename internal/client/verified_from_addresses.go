@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	verifiedFromAddressEndpointList   = "/v2025/verified-from-addresses"
+	verifiedFromAddressEndpointDelete = "/v2025/verified-from-addresses/{id}"
+)
+
+// VerifiedFromAddressAPI represents a sender email address for notifications. SailPoint emails the
+// address a verification link when it is added; it can be used as a sender once verified.
+type VerifiedFromAddressAPI struct {
+	ID                 string `json:"id,omitempty"`
+	Email              string `json:"email"`
+	VerificationStatus string `json:"verificationStatus,omitempty"`
+}
+
+// VerifiedFromAddressStatusSuccess is the verification status of a verified sender address.
+const VerifiedFromAddressStatusSuccess = "SUCCESS"
+
+// verifiedFromAddressErrorContext provides context for error messages.
+type verifiedFromAddressErrorContext struct {
+	Operation    string
+	ID           string
+	Name         string
+	ResponseBody string
+}
+
+// ListVerifiedFromAddresses retrieves the sender addresses matching the given filter expression
+// (e.g., `email eq "noreply@example.com"`). Pass an empty string to omit the filter.
+func (c *Client) ListVerifiedFromAddresses(ctx context.Context, filters string) ([]VerifiedFromAddressAPI, error) {
+	tflog.Debug(ctx, "Listing verified from addresses", map[string]any{"filters": filters})
+
+	var addresses []VerifiedFromAddressAPI
+	req := c.prepareRequest(ctx).
+		SetResult(&addresses)
+	if filters != "" {
+		req.SetQueryParam("filters", filters)
+	}
+
+	resp, err := req.Get(verifiedFromAddressEndpointList)
+	if err != nil {
+		return nil, c.formatVerifiedFromAddressError(verifiedFromAddressErrorContext{Operation: "list"}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatVerifiedFromAddressError(
+			verifiedFromAddressErrorContext{Operation: "list", ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully listed verified from addresses", map[string]any{"count": len(addresses)})
+	return addresses, nil
+}
+
+// CreateVerifiedFromAddress adds a sender address, which starts the verification.
+func (c *Client) CreateVerifiedFromAddress(ctx context.Context, email string) (*VerifiedFromAddressAPI, error) {
+	if email == "" {
+		return nil, fmt.Errorf("verified from address email cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Creating verified from address", map[string]any{"email": email})
+
+	var result VerifiedFromAddressAPI
+	resp, err := c.prepareRequest(ctx).
+		SetBody(&VerifiedFromAddressAPI{Email: email}).
+		SetResult(&result).
+		Post(verifiedFromAddressEndpointList)
+
+	if err != nil {
+		return nil, c.formatVerifiedFromAddressError(verifiedFromAddressErrorContext{Operation: "create", Name: email}, err, 0)
+	}
+	if resp.IsError() {
+		tflog.Error(ctx, "SailPoint API error response", map[string]any{
+			"status_code":   resp.StatusCode(),
+			"response_body": string(resp.Bytes()),
+		})
+		return nil, c.formatVerifiedFromAddressError(
+			verifiedFromAddressErrorContext{Operation: "create", Name: email, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully created verified from address", map[string]any{
+		"id":     result.ID,
+		"email":  result.Email,
+		"status": result.VerificationStatus,
+	})
+	return &result, nil
+}
+
+// DeleteVerifiedFromAddress removes a sender address. 404 is treated as success.
+func (c *Client) DeleteVerifiedFromAddress(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("verified from address ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Deleting verified from address", map[string]any{"id": id})
+
+	resp, err := c.prepareRequest(ctx).
+		SetPathParam("id", id).
+		Delete(verifiedFromAddressEndpointDelete)
+
+	if err != nil {
+		return c.formatVerifiedFromAddressError(verifiedFromAddressErrorContext{Operation: "delete", ID: id}, err, 0)
+	}
+	if resp.IsError() {
+		if resp.StatusCode() == http.StatusNotFound {
+			tflog.Debug(ctx, "Verified from address not found, treating as already deleted", map[string]any{"id": id})
+			return nil
+		}
+		return c.formatVerifiedFromAddressError(
+			verifiedFromAddressErrorContext{Operation: "delete", ID: id, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Successfully deleted verified from address", map[string]any{"id": id})
+	return nil
+}
+
+func (c *Client) formatVerifiedFromAddressError(errCtx verifiedFromAddressErrorContext, err error, statusCode int) error {
+	var baseMsg string
+	switch {
+	case errCtx.ID != "":
+		baseMsg = fmt.Sprintf("failed to %s verified from address '%s'", errCtx.Operation, errCtx.ID)
+	case errCtx.Name != "":
+		baseMsg = fmt.Sprintf("failed to %s verified from address '%s'", errCtx.Operation, errCtx.Name)
+	default:
+		baseMsg = fmt.Sprintf("failed to %s verified from addresses", errCtx.Operation)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", baseMsg, err)
+	}
+
+	if statusCode != 0 {
+		detail := ""
+		if errCtx.ResponseBody != "" {
+			detail = fmt.Sprintf(" - response: %s", errCtx.ResponseBody)
+		}
+		switch statusCode {
+		case http.StatusBadRequest:
+			return fmt.Errorf("%s: invalid request (400)%s", baseMsg, detail)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+		case http.StatusForbidden:
+			return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+		case http.StatusNotFound:
+			return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+		case http.StatusConflict:
+			return fmt.Errorf("%s: conflict (409)%s", baseMsg, detail)
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+		case http.StatusInternalServerError:
+			return fmt.Errorf("%s: server error (500)%s", baseMsg, detail)
+		default:
+			return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+		}
+	}
+
+	return fmt.Errorf("%s: unknown error", baseMsg)
+}
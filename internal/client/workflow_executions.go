@@ -0,0 +1,259 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	workflowEndpointTest             = "/v2025/workflows/{id}/test"
+	workflowEndpointListExecutions   = "/v2025/workflows/{id}/executions"
+	workflowExecutionEndpointGet     = "/v2025/workflow-executions/{id}"
+	workflowExecutionEndpointHistory = "/v2025/workflow-executions/{id}/history"
+)
+
+// Workflow execution statuses. Any other status means the execution is still running.
+const (
+	WorkflowExecutionStatusCompleted = "Completed"
+	WorkflowExecutionStatusFailed    = "Failed"
+	WorkflowExecutionStatusCanceled  = "Canceled"
+)
+
+// Workflow execution event types reporting a failure.
+const (
+	WorkflowExecutionEventActivityTaskFailed      = "ActivityTaskFailed"
+	WorkflowExecutionEventWorkflowExecutionFailed = "WorkflowExecutionFailed"
+)
+
+// WorkflowExecutionAPI represents an execution of a SailPoint Workflow.
+type WorkflowExecutionAPI struct {
+	ID         string `json:"id"`
+	WorkflowID string `json:"workflowId,omitempty"`
+	RequestID  string `json:"requestId,omitempty"`
+	StartTime  string `json:"startTime,omitempty"`
+	CloseTime  string `json:"closeTime,omitempty"`
+	Status     string `json:"status"`
+}
+
+// WorkflowExecutionEventAPI is an event of the history of a workflow execution, such as a step starting or failing.
+type WorkflowExecutionEventAPI struct {
+	Type       string         `json:"type"`
+	Timestamp  string         `json:"timestamp,omitempty"`
+	Attributes map[string]any `json:"attributes,omitempty"`
+}
+
+// workflowTestRequest is the body of a workflow test request.
+type workflowTestRequest struct {
+	Input json.RawMessage `json:"input"`
+}
+
+// workflowTestResponse is the response to a workflow test request.
+type workflowTestResponse struct {
+	WorkflowExecutionID string `json:"workflowExecutionId"`
+}
+
+// IsComplete reports whether the execution has finished, successfully or not.
+func (e *WorkflowExecutionAPI) IsComplete() bool {
+	switch e.Status {
+	case WorkflowExecutionStatusCompleted, WorkflowExecutionStatusFailed, WorkflowExecutionStatusCanceled:
+		return true
+	}
+	return false
+}
+
+// TestWorkflow runs a workflow with the given JSON input, as if it had been sent by its trigger,
+// and returns the ID of the execution. The run is live: its steps take effect in the tenant.
+func (c *Client) TestWorkflow(ctx context.Context, id string, input json.RawMessage) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("workflow ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Testing workflow", map[string]any{
+		"id": id,
+	})
+
+	var result workflowTestResponse
+
+	resp, err := c.prepareRequest(ctx).
+		SetBody(workflowTestRequest{Input: input}).
+		SetResult(&result).
+		SetPathParam("id", id).
+		Post(workflowEndpointTest)
+
+	if err != nil {
+		return "", c.formatWorkflowError(
+			workflowErrorContext{Operation: "test", ID: id},
+			err,
+			0,
+		)
+	}
+
+	if resp.IsError() {
+		return "", c.formatWorkflowError(
+			workflowErrorContext{Operation: "test", ID: id, ResponseBody: string(resp.Bytes())},
+			nil,
+			resp.StatusCode(),
+		)
+	}
+
+	tflog.Info(ctx, "Started workflow test", map[string]any{
+		"id":           id,
+		"execution_id": result.WorkflowExecutionID,
+	})
+
+	return result.WorkflowExecutionID, nil
+}
+
+// ListWorkflowExecutions retrieves the most recent executions of a workflow, newest first, up to limit.
+// filters is a filter expression (e.g., `status eq "Failed"`); pass an empty string to omit it.
+func (c *Client) ListWorkflowExecutions(ctx context.Context, workflowID string, limit int, filters string) ([]WorkflowExecutionAPI, error) {
+	if workflowID == "" {
+		return nil, fmt.Errorf("workflow ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Listing workflow executions", map[string]any{
+		"workflow_id": workflowID,
+		"limit":       limit,
+		"filters":     filters,
+	})
+
+	var executions []WorkflowExecutionAPI
+	req := c.prepareRequest(ctx).
+		SetResult(&executions).
+		SetPathParam("id", workflowID).
+		SetQueryParam("limit", strconv.Itoa(limit))
+	if filters != "" {
+		req.SetQueryParam("filters", filters)
+	}
+
+	resp, err := req.Get(workflowEndpointListExecutions)
+	if err != nil {
+		return nil, c.formatWorkflowError(workflowErrorContext{Operation: "list executions of", ID: workflowID}, err, 0)
+	}
+	if resp.IsError() {
+		return nil, c.formatWorkflowError(
+			workflowErrorContext{Operation: "list executions of", ID: workflowID, ResponseBody: string(resp.Bytes())},
+			nil, resp.StatusCode(),
+		)
+	}
+
+	tflog.Debug(ctx, "Successfully listed workflow executions", map[string]any{"count": len(executions)})
+	return executions, nil
+}
+
+// GetWorkflowExecution retrieves a workflow execution by ID.
+func (c *Client) GetWorkflowExecution(ctx context.Context, id string) (*WorkflowExecutionAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("workflow execution ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Getting workflow execution", map[string]any{
+		"id": id,
+	})
+
+	var execution WorkflowExecutionAPI
+
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&execution).
+		SetPathParam("id", id).
+		Get(workflowExecutionEndpointGet)
+
+	if resp != nil && resp.IsError() {
+		return nil, formatWorkflowExecutionError("get", id, resp.StatusCode(), string(resp.Bytes()))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow execution '%s': %w", id, err)
+	}
+
+	return &execution, nil
+}
+
+// GetWorkflowExecutionHistory retrieves the events of a workflow execution, in order.
+func (c *Client) GetWorkflowExecutionHistory(ctx context.Context, id string) ([]WorkflowExecutionEventAPI, error) {
+	if id == "" {
+		return nil, fmt.Errorf("workflow execution ID cannot be empty")
+	}
+
+	tflog.Debug(ctx, "Getting workflow execution history", map[string]any{
+		"id": id,
+	})
+
+	var events []WorkflowExecutionEventAPI
+
+	resp, err := c.prepareRequest(ctx).
+		SetResult(&events).
+		SetPathParam("id", id).
+		Get(workflowExecutionEndpointHistory)
+
+	if resp != nil && resp.IsError() {
+		return nil, formatWorkflowExecutionError("get history of", id, resp.StatusCode(), string(resp.Bytes()))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history of workflow execution '%s': %w", id, err)
+	}
+
+	return events, nil
+}
+
+// WaitForWorkflowExecution polls a workflow execution every interval until it completes or ctx is done.
+// onPoll, when not nil, is called with each execution read before completion.
+func (c *Client) WaitForWorkflowExecution(ctx context.Context, id string, interval time.Duration, onPoll func(*WorkflowExecutionAPI)) (*WorkflowExecutionAPI, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		execution, err := c.GetWorkflowExecution(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if execution.IsComplete() {
+			tflog.Debug(ctx, "Workflow execution completed", map[string]any{
+				"id":     id,
+				"status": execution.Status,
+			})
+			return execution, nil
+		}
+		if onPoll != nil {
+			onPoll(execution)
+		}
+
+		select {
+		case <-ctx.Done():
+			return execution, fmt.Errorf("workflow execution '%s' did not complete: %w", id, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func formatWorkflowExecutionError(operation, id string, statusCode int, responseBody string) error {
+	baseMsg := fmt.Sprintf("failed to %s workflow execution '%s'", operation, id)
+
+	detail := ""
+	if responseBody != "" {
+		detail = fmt.Sprintf(" - response: %s", responseBody)
+	}
+
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return fmt.Errorf("%s: authentication failed (401)%s", baseMsg, detail)
+	case http.StatusForbidden:
+		return fmt.Errorf("%s: access denied (403)%s", baseMsg, detail)
+	case http.StatusNotFound:
+		return fmt.Errorf("%s: %w", baseMsg, ErrNotFound)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("%s: rate limit exceeded (429)%s", baseMsg, detail)
+	default:
+		return fmt.Errorf("%s: unexpected status code %d%s", baseMsg, statusCode, detail)
+	}
+}
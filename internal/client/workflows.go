@@ -50,6 +50,9 @@ type WorkflowTriggerAPI struct {
 	Attributes  map[string]interface{} `json:"attributes,omitempty"`
 }
 
+// WorkflowTriggerTypes lists the valid WorkflowTriggerAPI.Type values.
+var WorkflowTriggerTypes = []string{"EVENT", "EXTERNAL", "SCHEDULED"}
+
 // workflowErrorContext provides context for error messages.
 type workflowErrorContext struct {
 	Operation    string
@@ -58,6 +61,21 @@ type workflowErrorContext struct {
 	ResponseBody string
 }
 
+// ListWorkflows retrieves all workflows, following pagination. The API does not support filtering workflows.
+func (c *Client) ListWorkflows(ctx context.Context) ([]WorkflowAPI, error) {
+	tflog.Debug(ctx, "Listing workflows")
+
+	workflows, err := listPages[WorkflowAPI](ctx, c, workflowEndpointList, nil, "", func(err error, statusCode int, responseBody string) error {
+		return c.formatWorkflowError(workflowErrorContext{Operation: "list", ResponseBody: responseBody}, err, statusCode)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Successfully listed workflows", map[string]any{"count": len(workflows)})
+	return workflows, nil
+}
+
 // GetWorkflow retrieves a specific workflow by ID.
 func (c *Client) GetWorkflow(ctx context.Context, id string) (*WorkflowAPI, error) {
 	if id == "" {
@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IDIdentityModel is the identity of a resource identified by its ID alone.
+type IDIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// IDIdentitySchema returns the identity schema matching IDIdentityModel.
+func IDIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the object.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
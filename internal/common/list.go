@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ListFiltersModel is the configuration of a list resource accepting API filters.
+type ListFiltersModel struct {
+	Filters types.String `tfsdk:"filters"`
+}
+
+// ListFiltersAttribute returns the `filters` attribute of a list resource. example is a filter
+// expression supported by the list endpoint of the object type.
+func ListFiltersAttribute(example string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("A filter expression in the [SailPoint filter syntax](https://developer.sailpoint.com/docs/standard-collection-parameters#filtering-results) "+
+			"restricting the objects listed (e.g., `%s`). All objects are listed when omitted.", example),
+		Optional: true,
+	}
+}
+
+// ListResults returns the results of a list resource, one per item and at most req.Limit. fill sets
+// the display name and identity of the result of an item, and its resource when req.IncludeResource
+// is set.
+func ListResults[T any](ctx context.Context, req list.ListRequest, items []T, fill func(item T, result *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			fill(item, &result)
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
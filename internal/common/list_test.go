@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestListResults(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		limit int64
+		want  []string
+	}{
+		"no limit": {
+			want: []string{"a", "b", "c"},
+		},
+		"limit below the item count": {
+			limit: 2,
+			want:  []string{"a", "b"},
+		},
+		"limit above the item count": {
+			limit: 5,
+			want:  []string{"a", "b", "c"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			req := list.ListRequest{
+				Limit: tt.limit,
+				ResourceSchema: schema.Schema{
+					Attributes: map[string]schema.Attribute{"id": schema.StringAttribute{Computed: true}},
+				},
+				ResourceIdentitySchema: IDIdentitySchema(),
+			}
+
+			var got []string
+			for result := range ListResults(ctx, req, []string{"a", "b", "c"}, func(item string, result *list.ListResult) {
+				result.DisplayName = item
+			}) {
+				got = append(got, result.DisplayName)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ListResults() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
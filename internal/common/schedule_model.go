@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ScheduleModel represents a recurring schedule. Months, days and hours are lists of values,
+// sent to the API as LIST selectors.
+type ScheduleModel struct {
+	Type       types.String `tfsdk:"type"`
+	Months     types.List   `tfsdk:"months"`
+	Days       types.List   `tfsdk:"days"`
+	Hours      types.List   `tfsdk:"hours"`
+	Expiration types.String `tfsdk:"expiration"`
+	TimeZoneID types.String `tfsdk:"time_zone_id"`
+}
+
+func (m *ScheduleModel) FromAPI(ctx context.Context, api client.ScheduleAPI) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	m.Type = types.StringValue(api.Type)
+	m.Months = scheduleSelectorFromAPI(ctx, api.Months, &diagnostics)
+	m.Days = scheduleSelectorFromAPI(ctx, api.Days, &diagnostics)
+	m.Hours = scheduleSelectorFromAPI(ctx, api.Hours, &diagnostics)
+	m.Expiration = StringOrNull(api.Expiration)
+	m.TimeZoneID = StringOrNull(api.TimeZoneID)
+
+	return diagnostics
+}
+
+func (m *ScheduleModel) ToAPI(ctx context.Context) (client.ScheduleAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	api := client.ScheduleAPI{
+		Type:   m.Type.ValueString(),
+		Months: scheduleSelectorToAPI(ctx, m.Months, &diagnostics),
+		Days:   scheduleSelectorToAPI(ctx, m.Days, &diagnostics),
+		Hours:  scheduleSelectorToAPI(ctx, m.Hours, &diagnostics),
+	}
+	if !m.Expiration.IsNull() && !m.Expiration.IsUnknown() {
+		expiration := m.Expiration.ValueString()
+		api.Expiration = &expiration
+	}
+	if !m.TimeZoneID.IsNull() && !m.TimeZoneID.IsUnknown() {
+		timeZoneID := m.TimeZoneID.ValueString()
+		api.TimeZoneID = &timeZoneID
+	}
+
+	return api, diagnostics
+}
+
+func scheduleSelectorFromAPI(ctx context.Context, api *client.ScheduleSelectorAPI, diagnostics *diag.Diagnostics) types.List {
+	if api == nil || len(api.Values) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	list, diags := types.ListValueFrom(ctx, types.StringType, api.Values)
+	diagnostics.Append(diags...)
+	return list
+}
+
+func scheduleSelectorToAPI(ctx context.Context, list types.List, diagnostics *diag.Diagnostics) *client.ScheduleSelectorAPI {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	var values []string
+	diagnostics.Append(list.ElementsAs(ctx, &values, false)...)
+	return &client.ScheduleSelectorAPI{
+		Type:   client.ScheduleSelectorTypeList,
+		Values: values,
+	}
+}
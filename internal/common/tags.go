@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TagsModel holds the tag attributes of a taggable resource. Embed it in the resource model
+// next to the model shared with the data source.
+//
+// Tags are stored with the tagged objects API rather than on the object itself. The resource only
+// manages the tags in TagsAll: tags set on the object by anything else are left alone and are not
+// reported as drift.
+type TagsModel struct {
+	Tags    types.Set `tfsdk:"tags"`
+	TagsAll types.Set `tfsdk:"tags_all"`
+}
+
+// TagsAttribute returns the `tags` attribute of a taggable resource.
+func TagsAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		MarkdownDescription: "Tags to set on the object, in addition to the provider's `default_tags`. " +
+			"Tags set on the object outside this resource are left alone.",
+		Optional:    true,
+		ElementType: types.StringType,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+		},
+	}
+}
+
+// TagsAllAttribute returns the `tags_all` attribute of a taggable resource.
+func TagsAllAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		MarkdownDescription: "All tags managed by this resource: `tags` merged with the provider's `default_tags`.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+}
+
+// ModifyPlanTags sets `tags_all` in the plan to `tags` merged with the provider's default tags.
+// Call it from the ModifyPlan method of a resource that embeds TagsModel.
+func ModifyPlanTags(ctx context.Context, c *client.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll := types.SetUnknown(types.StringType)
+	if c != nil && !tags.IsUnknown() {
+		var diags diag.Diagnostics
+		tagsAll, diags = MergeDefaultTags(ctx, c, tags)
+		resp.Diagnostics.Append(diags...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// MergeDefaultTags returns tags merged with the provider's default tags.
+func MergeDefaultTags(ctx context.Context, c *client.Client, tags types.Set) (types.Set, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	merged := setStrings(ctx, tags, &diagnostics)
+	for _, tag := range c.DefaultTags {
+		if !slices.Contains(merged, tag) {
+			merged = append(merged, tag)
+		}
+	}
+
+	result, diags := types.SetValueFrom(ctx, types.StringType, merged)
+	diagnostics.Append(diags...)
+	return result, diagnostics
+}
+
+// Apply updates the tags of an object so that it carries the tags planned in m, removing the tags
+// previously managed in prior (nil on create) and keeping any other tag. It returns the new state.
+func (m *TagsModel) Apply(ctx context.Context, c *client.Client, objectType, id string, prior *TagsModel) (TagsModel, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	state := TagsModel{Tags: m.Tags, TagsAll: m.TagsAll}
+	if state.TagsAll.IsUnknown() {
+		var diags diag.Diagnostics
+		state.TagsAll, diags = MergeDefaultTags(ctx, c, m.Tags)
+		diagnostics.Append(diags...)
+	}
+	managed := setStrings(ctx, state.TagsAll, &diagnostics)
+	var previouslyManaged []string
+	if prior != nil {
+		previouslyManaged = setStrings(ctx, prior.TagsAll, &diagnostics)
+	}
+	if diagnostics.HasError() || (len(managed) == 0 && len(previouslyManaged) == 0) {
+		return state, diagnostics
+	}
+
+	current, err := currentTags(ctx, c, objectType, id)
+	if err != nil {
+		diagnostics.AddError("Error Reading SailPoint Tags", fmt.Sprintf("Could not read the tags of %s %q: %s", objectType, id, err.Error()))
+		return state, diagnostics
+	}
+
+	desired, changed := mergeTags(current, previouslyManaged, managed)
+	if !changed {
+		return state, diagnostics
+	}
+
+	if len(desired) == 0 {
+		err = c.DeleteTaggedObject(ctx, objectType, id)
+	} else {
+		_, err = c.SetTaggedObject(ctx, &client.TaggedObjectAPI{
+			ObjectRef: client.ObjectRefAPI{Type: objectType, ID: id},
+			Tags:      desired,
+		})
+	}
+	if err != nil {
+		diagnostics.AddError("Error Updating SailPoint Tags", fmt.Sprintf("Could not update the tags of %s %q: %s", objectType, id, err.Error()))
+	}
+	return state, diagnostics
+}
+
+// Remove removes the tags managed in m from the object, keeping any other tag. Use it when the
+// resource is destroyed but the object itself is not deleted.
+func (m *TagsModel) Remove(ctx context.Context, c *client.Client, objectType, id string) diag.Diagnostics {
+	none := UnmanagedTags()
+	_, diags := none.Apply(ctx, c, objectType, id, m)
+	return diags
+}
+
+// UnmanagedTags returns the tags of a resource managing no tag, as found in state after an import.
+func UnmanagedTags() TagsModel {
+	return TagsModel{Tags: types.SetNull(types.StringType), TagsAll: types.SetValueMust(types.StringType, nil)}
+}
+
+// Read refreshes m from the tags currently set on the object. Only managed tags are tracked, so
+// removing one outside Terraform shows up as drift while adding another does not. Nothing is
+// managed yet after an import, or in state written before tags were supported, so no existing tag
+// is taken over (and later removed) without being configured.
+func (m *TagsModel) Read(ctx context.Context, c *client.Client, objectType, id string) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	if m.TagsAll.IsNull() || m.TagsAll.IsUnknown() {
+		m.TagsAll = setOrEmpty(ctx, nil, &diagnostics)
+		return diagnostics
+	}
+	if len(m.TagsAll.Elements()) == 0 {
+		return diagnostics
+	}
+
+	current, err := currentTags(ctx, c, objectType, id)
+	if err != nil {
+		diagnostics.AddError("Error Reading SailPoint Tags", fmt.Sprintf("Could not read the tags of %s %q: %s", objectType, id, err.Error()))
+		return diagnostics
+	}
+
+	if !m.Tags.IsNull() {
+		m.Tags = setOrNull(ctx, intersect(setStrings(ctx, m.Tags, &diagnostics), current), &diagnostics)
+	}
+	m.TagsAll = setOrEmpty(ctx, intersect(setStrings(ctx, m.TagsAll, &diagnostics), current), &diagnostics)
+	return diagnostics
+}
+
+// mergeTags returns the tags an object should carry: its current tags without the previously
+// managed ones, plus the managed ones. changed reports whether they differ from current.
+func mergeTags(current, previouslyManaged, managed []string) (desired []string, changed bool) {
+	desired = make([]string, 0, len(current)+len(managed))
+	for _, tag := range current {
+		if !slices.Contains(previouslyManaged, tag) || slices.Contains(managed, tag) {
+			desired = append(desired, tag)
+		}
+	}
+	for _, tag := range managed {
+		if !slices.Contains(desired, tag) {
+			desired = append(desired, tag)
+		}
+	}
+	return desired, len(desired) != len(current) || slices.ContainsFunc(desired, func(tag string) bool {
+		return !slices.Contains(current, tag)
+	})
+}
+
+// currentTags returns the tags set on an object, or none when it has never been tagged.
+func currentTags(ctx context.Context, c *client.Client, objectType, id string) ([]string, error) {
+	object, err := c.GetTaggedObject(ctx, objectType, id)
+	if errors.Is(err, client.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return object.Tags, nil
+}
+
+func setStrings(ctx context.Context, set types.Set, diagnostics *diag.Diagnostics) []string {
+	values := make([]string, 0)
+	if !set.IsNull() && !set.IsUnknown() {
+		diagnostics.Append(set.ElementsAs(ctx, &values, false)...)
+	}
+	return values
+}
+
+func setOrEmpty(ctx context.Context, values []string, diagnostics *diag.Diagnostics) types.Set {
+	if values == nil {
+		values = []string{}
+	}
+	set, diags := types.SetValueFrom(ctx, types.StringType, values)
+	diagnostics.Append(diags...)
+	return set
+}
+
+func setOrNull(ctx context.Context, values []string, diagnostics *diag.Diagnostics) types.Set {
+	if len(values) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	return setOrEmpty(ctx, values, diagnostics)
+}
+
+// intersect returns the values of a that are also in b, in the order of a.
+func intersect(a, b []string) []string {
+	var result []string
+	for _, value := range a {
+		if slices.Contains(b, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMergeTags(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		current           []string
+		previouslyManaged []string
+		managed           []string
+		wantDesired       []string
+		wantChanged       bool
+	}{
+		"nothing to do": {
+			wantDesired: []string{},
+		},
+		"first tags": {
+			managed:     []string{"pci", "team-a"},
+			wantDesired: []string{"pci", "team-a"},
+			wantChanged: true,
+		},
+		"unmanaged tags are kept": {
+			current:     []string{"external"},
+			managed:     []string{"pci"},
+			wantDesired: []string{"external", "pci"},
+			wantChanged: true,
+		},
+		"removed managed tag is dropped": {
+			current:           []string{"external", "pci", "sox"},
+			previouslyManaged: []string{"pci", "sox"},
+			managed:           []string{"pci"},
+			wantDesired:       []string{"external", "pci"},
+			wantChanged:       true,
+		},
+		"managed tag removed outside Terraform is restored": {
+			current:           []string{"external"},
+			previouslyManaged: []string{"pci"},
+			managed:           []string{"pci"},
+			wantDesired:       []string{"external", "pci"},
+			wantChanged:       true,
+		},
+		"up to date": {
+			current:           []string{"pci", "external"},
+			previouslyManaged: []string{"pci"},
+			managed:           []string{"pci"},
+			wantDesired:       []string{"pci", "external"},
+		},
+		"all managed tags removed": {
+			current:           []string{"pci"},
+			previouslyManaged: []string{"pci"},
+			wantDesired:       []string{},
+			wantChanged:       true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			desired, changed := mergeTags(tc.current, tc.previouslyManaged, tc.managed)
+
+			if !slices.Equal(desired, tc.wantDesired) {
+				t.Errorf("got desired %v, want %v", desired, tc.wantDesired)
+			}
+			if changed != tc.wantChanged {
+				t.Errorf("got changed %t, want %t", changed, tc.wantChanged)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultTaskTimeout is how long to wait for a SailPoint task when no timeout is configured.
+const DefaultTaskTimeout = 30 * time.Minute
+
+// taskPollInterval is the delay between two reads of the status of a task.
+const taskPollInterval = 5 * time.Second
+
+// durationPattern matches the durations accepted by time.ParseDuration, in hours, minutes and seconds.
+var durationPattern = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(h|m|s))+$`)
+
+// taskTimeoutDescription describes the `timeout` attribute of actions and resources waiting for a task.
+var taskTimeoutDescription = fmt.Sprintf("How long to wait for the task to complete, as a duration such as `45m` or `1h30m`. Defaults to `%s`.", DefaultTaskTimeout)
+
+// TaskTimeoutAttribute returns the `timeout` attribute of an action that waits for a SailPoint task.
+func TaskTimeoutAttribute() actionschema.StringAttribute {
+	return actionschema.StringAttribute{
+		MarkdownDescription: taskTimeoutDescription,
+		Optional:            true,
+		Validators:          taskTimeoutValidators(),
+	}
+}
+
+// ResourceTaskTimeoutAttribute returns the `timeout` attribute of a resource that waits for a SailPoint task.
+func ResourceTaskTimeoutAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: taskTimeoutDescription,
+		Optional:            true,
+		Validators:          taskTimeoutValidators(),
+	}
+}
+
+func taskTimeoutValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(durationPattern, "must be a duration such as `45m` or `1h30m`"),
+	}
+}
+
+// TaskTimeout returns the configured task timeout, or DefaultTaskTimeout when it is not set.
+func TaskTimeout(value types.String) (time.Duration, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return DefaultTaskTimeout, diagnostics
+	}
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil || timeout <= 0 {
+		diagnostics.AddError("Invalid Timeout", fmt.Sprintf("Could not parse timeout %q as a positive duration.", value.ValueString()))
+		return 0, diagnostics
+	}
+	return timeout, diagnostics
+}
+
+// WaitForTask waits up to timeout for a SailPoint task to complete, reporting its progress through
+// progress (which may be nil). The task's error and warning messages are returned as diagnostics,
+// along with an error if the task did not complete successfully.
+func WaitForTask(ctx context.Context, c *client.Client, taskID string, timeout time.Duration, progress func(string)) (*client.TaskStatusAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	task, err := c.WaitForTask(ctx, taskID, taskPollInterval, func(task *client.TaskStatusAPI) {
+		if progress != nil {
+			progress(taskProgressMessage(task))
+		}
+	})
+	if err != nil {
+		diagnostics.AddError("Error Waiting for SailPoint Task", fmt.Sprintf("Could not wait for task %q: %s", taskID, err.Error()))
+		return task, diagnostics
+	}
+
+	diagnostics.Append(TaskDiagnostics(task)...)
+	return task, diagnostics
+}
+
+// TaskDiagnostics returns the error and warning messages of a completed task as diagnostics. A task
+// that failed without reporting an error message still yields an error.
+func TaskDiagnostics(task *client.TaskStatusAPI) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	name := task.UniqueName
+	if name == "" {
+		name = task.ID
+	}
+
+	for _, message := range task.Messages {
+		switch message.Type {
+		case client.TaskMessageTypeError:
+			diagnostics.AddError("SailPoint Task Error", fmt.Sprintf("Task %q reported an error: %s", name, message.Text()))
+		case client.TaskMessageTypeWarn:
+			diagnostics.AddWarning("SailPoint Task Warning", fmt.Sprintf("Task %q reported a warning: %s", name, message.Text()))
+		}
+	}
+
+	if !diagnostics.HasError() && task.CompletionStatus != nil {
+		switch status := *task.CompletionStatus; status {
+		case client.TaskCompletionStatusError, client.TaskCompletionStatusTerminated, client.TaskCompletionStatusTempError:
+			diagnostics.AddError("SailPoint Task Failed", fmt.Sprintf("Task %q completed with status %s.", name, status))
+		}
+	}
+
+	return diagnostics
+}
+
+// taskProgressMessage describes a running task for progress events.
+func taskProgressMessage(task *client.TaskStatusAPI) string {
+	message := fmt.Sprintf("Task %s is running", task.ID)
+	switch {
+	case task.Progress != nil && *task.Progress != "":
+		message += ": " + *task.Progress
+	case task.PercentComplete != nil:
+		message += fmt.Sprintf(": %d%% complete", *task.PercentComplete)
+	}
+	return message
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"testing"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+)
+
+func TestTaskDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	status := func(s string) *string { return &s }
+	message := func(messageType, text string) client.TaskStatusMessageAPI {
+		return client.TaskStatusMessageAPI{Type: messageType, LocalizedText: &client.LocalizedTextAPI{Message: text}}
+	}
+
+	tests := map[string]struct {
+		task         client.TaskStatusAPI
+		wantErrors   int
+		wantWarnings int
+	}{
+		"success": {
+			task: client.TaskStatusAPI{
+				CompletionStatus: status(client.TaskCompletionStatusSuccess),
+				Messages:         []client.TaskStatusMessageAPI{message(client.TaskMessageTypeInfo, "Aggregated 42 accounts")},
+			},
+		},
+		"warnings": {
+			task: client.TaskStatusAPI{
+				CompletionStatus: status(client.TaskCompletionStatusWarning),
+				Messages: []client.TaskStatusMessageAPI{
+					message(client.TaskMessageTypeWarn, "Account jdoe has no manager"),
+					{Type: client.TaskMessageTypeWarn, Key: "task_warning_key"},
+				},
+			},
+			wantWarnings: 2,
+		},
+		"error messages": {
+			task: client.TaskStatusAPI{
+				CompletionStatus: status(client.TaskCompletionStatusError),
+				Messages: []client.TaskStatusMessageAPI{
+					message(client.TaskMessageTypeError, "Connection refused"),
+					message(client.TaskMessageTypeWarn, "Partial results"),
+				},
+			},
+			wantErrors:   1,
+			wantWarnings: 1,
+		},
+		"failure without messages": {
+			task:       client.TaskStatusAPI{CompletionStatus: status(client.TaskCompletionStatusTerminated)},
+			wantErrors: 1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := TaskDiagnostics(&tc.task)
+
+			if got := diags.ErrorsCount(); got != tc.wantErrors {
+				t.Errorf("got %d errors, want %d: %v", got, tc.wantErrors, diags)
+			}
+			if got := diags.WarningsCount(); got != tc.wantWarnings {
+				t.Errorf("got %d warnings, want %d: %v", got, tc.wantWarnings, diags)
+			}
+		})
+	}
+}
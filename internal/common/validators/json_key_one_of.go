@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validators contains reusable attribute validators for the SailPoint
+// ISC Terraform provider. The generic OneOf/length/regex checks come from
+// terraform-plugin-framework-validators; this package only holds validators
+// that need to look inside JSON-encoded attributes or templates.
+package validators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// JSONKeyOneOf returns a validator for JSON string attributes that walks the
+// decoded document and checks that every object member named `key` holds one
+// of the allowed string values. Nested objects and arrays are searched
+// recursively, so a single validator covers e.g. `elementType` at every level
+// of a form definition's element tree.
+//
+// Invalid JSON is ignored: the attribute's custom type already reports it.
+func JSONKeyOneOf(key string, values []string) validator.String {
+	return jsonKeyOneOfValidator{key: key, values: values}
+}
+
+type jsonKeyOneOfValidator struct {
+	key    string
+	values []string
+}
+
+func (v jsonKeyOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("every JSON %q member must be one of: %s", v.key, strings.Join(v.values, ", "))
+}
+
+func (v jsonKeyOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonKeyOneOfValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var doc any
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &doc); err != nil {
+		return
+	}
+
+	v.walk(doc, "$", func(jsonPath string, value any) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("JSON value at %s must be one of [%s], got: %v", jsonPath, strings.Join(v.values, ", "), value),
+		)
+	})
+}
+
+func (v jsonKeyOneOfValidator) walk(node any, jsonPath string, report func(string, any)) {
+	switch n := node.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(n))
+		for k := range n {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			childPath := jsonPath + "." + k
+			if k == v.key {
+				if s, ok := n[k].(string); !ok || !slices.Contains(v.values, s) {
+					report(childPath, n[k])
+				}
+				continue
+			}
+			v.walk(n[k], childPath, report)
+		}
+	case []any:
+		for i, item := range n {
+			v.walk(item, fmt.Sprintf("%s[%d]", jsonPath, i), report)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJSONKeyOneOf(t *testing.T) {
+	t.Parallel()
+
+	allowed := []string{"SECTION", "TEXT"}
+
+	tests := map[string]struct {
+		value      types.String
+		wantErrors int
+	}{
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"invalid JSON is left to the custom type": {
+			value: types.StringValue(`[{not json`),
+		},
+		"all values allowed": {
+			value: types.StringValue(`[{"elementType":"SECTION","config":{"formElements":[{"elementType":"TEXT"}]}}]`),
+		},
+		"nested value rejected": {
+			value:      types.StringValue(`[{"elementType":"SECTION","config":{"formElements":[{"elementType":"TXT"}]}}]`),
+			wantErrors: 1,
+		},
+		"non-string value rejected": {
+			value:      types.StringValue(`[{"elementType":42}]`),
+			wantErrors: 1,
+		},
+		"every offending member reported": {
+			value:      types.StringValue(`[{"elementType":"A"},{"elementType":"B"}]`),
+			wantErrors: 2,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req := validator.StringRequest{Path: path.Root("form_elements"), ConfigValue: tc.value}
+			resp := &validator.StringResponse{}
+
+			JSONKeyOneOf("elementType", allowed).ValidateString(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tc.wantErrors {
+				t.Errorf("got %d errors, want %d: %v", got, tc.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// QuartzCron returns a validator for string attributes holding a Quartz cron
+// expression, the format SailPoint uses for schedules: seconds, minutes,
+// hours, day-of-month, month, day-of-week and an optional year, with exactly
+// one of day-of-month and day-of-week set to `?`.
+func QuartzCron() validator.String {
+	return quartzCronValidator{}
+}
+
+type quartzCronValidator struct{}
+
+func (v quartzCronValidator) Description(_ context.Context) string {
+	return "value must be a Quartz cron expression"
+}
+
+func (v quartzCronValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v quartzCronValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkQuartzCron(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cron Expression",
+			fmt.Sprintf("The value is not a valid Quartz cron expression: %s", err.Error()),
+		)
+	}
+}
+
+// cronField describes one field of a Quartz cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string // names for min, min+1, ... (months and days of the week)
+	noSpec   bool     // accepts `?`
+}
+
+var quartzCronFields = []cronField{
+	{name: "seconds", min: 0, max: 59},
+	{name: "minutes", min: 0, max: 59},
+	{name: "hours", min: 0, max: 23},
+	{name: "day-of-month", min: 1, max: 31, noSpec: true},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day-of-week", min: 1, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}, noSpec: true},
+	{name: "year", min: 1970, max: 2099},
+}
+
+// checkQuartzCron reports the first problem found in a Quartz cron expression.
+func checkQuartzCron(expr string) error {
+	values := strings.Fields(expr)
+	if len(values) != 6 && len(values) != 7 {
+		return fmt.Errorf("expected 6 or 7 space-separated fields (seconds minutes hours day-of-month month day-of-week [year]), got %d", len(values))
+	}
+
+	for i, value := range values {
+		if err := quartzCronFields[i].check(value); err != nil {
+			return fmt.Errorf("%s field %q: %w", quartzCronFields[i].name, value, err)
+		}
+	}
+
+	if (values[3] == "?") == (values[5] == "?") {
+		return errors.New("exactly one of the day-of-month and day-of-week fields must be \"?\"")
+	}
+
+	return nil
+}
+
+func (f cronField) check(value string) error {
+	if value == "?" {
+		if !f.noSpec {
+			return errors.New("\"?\" is only allowed in the day-of-month and day-of-week fields")
+		}
+		return nil
+	}
+
+	for _, item := range strings.Split(value, ",") {
+		if err := f.checkItem(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f cronField) checkItem(item string) error {
+	if item == "" {
+		return errors.New("empty list item")
+	}
+	if ok, err := f.checkSpecial(item); ok {
+		return err
+	}
+
+	base, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		n, err := strconv.Atoi(step)
+		if err != nil || n < 1 || n > f.max {
+			return fmt.Errorf("invalid increment %q", step)
+		}
+	}
+	if base == "*" {
+		return nil
+	}
+
+	low, high, isRange := strings.Cut(base, "-")
+	if _, err := f.parse(low); err != nil {
+		return err
+	}
+	if isRange {
+		if _, err := f.parse(high); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSpecial handles the day-of-month forms L, LW, L-n and nW, and the day-of-week forms L, nL
+// and n#k. ok reports whether item is one of them.
+func (f cronField) checkSpecial(item string) (ok bool, err error) {
+	switch f.name {
+	case "day-of-month":
+		switch {
+		case item == "L" || item == "LW":
+			return true, nil
+		case strings.HasPrefix(item, "L-"):
+			if n, err := strconv.Atoi(item[2:]); err != nil || n < 1 || n > 30 {
+				return true, fmt.Errorf("invalid offset from the last day %q", item[2:])
+			}
+			return true, nil
+		case strings.HasSuffix(item, "W"):
+			_, err := f.parse(strings.TrimSuffix(item, "W"))
+			return true, err
+		}
+	case "day-of-week":
+		switch {
+		case item == "L":
+			return true, nil
+		case strings.HasSuffix(item, "L"):
+			_, err := f.parse(strings.TrimSuffix(item, "L"))
+			return true, err
+		case strings.Contains(item, "#"):
+			day, nth, _ := strings.Cut(item, "#")
+			if _, err := f.parse(day); err != nil {
+				return true, err
+			}
+			if n, err := strconv.Atoi(nth); err != nil || n < 1 || n > 5 {
+				return true, fmt.Errorf("invalid occurrence %q, must be between 1 and 5", nth)
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// parse returns the number for a value of the field, given as a number or a name.
+func (f cronField) parse(value string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(value, name) {
+			return f.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", n, f.min, f.max)
+	}
+	return n, nil
+}
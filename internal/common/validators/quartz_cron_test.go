@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestQuartzCron(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value   types.String
+		wantErr bool
+	}{
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"several times a day": {
+			value: types.StringValue("0 0 5,13,21 * * ?"),
+		},
+		"every 15 minutes": {
+			value: types.StringValue("0 0/15 * * * ?"),
+		},
+		"weekdays by name": {
+			value: types.StringValue("0 30 2 ? * MON-FRI"),
+		},
+		"last day of the month with year": {
+			value: types.StringValue("0 0 3 L * ? 2030"),
+		},
+		"nearest weekday": {
+			value: types.StringValue("0 0 3 15W * ?"),
+		},
+		"second Tuesday": {
+			value: types.StringValue("0 0 3 ? * 3#2"),
+		},
+		"last Friday": {
+			value: types.StringValue("0 0 3 ? JAN,JUL 6L"),
+		},
+		"unix five-field expression": {
+			value:   types.StringValue("0 5 * * *"),
+			wantErr: true,
+		},
+		"both days set": {
+			value:   types.StringValue("0 0 5 * * MON"),
+			wantErr: true,
+		},
+		"neither day set": {
+			value:   types.StringValue("0 0 5 ? * ?"),
+			wantErr: true,
+		},
+		"hour out of range": {
+			value:   types.StringValue("0 0 24 * * ?"),
+			wantErr: true,
+		},
+		"question mark in hours": {
+			value:   types.StringValue("0 0 ? * * ?"),
+			wantErr: true,
+		},
+		"unknown month name": {
+			value:   types.StringValue("0 0 5 1 JANUARY ?"),
+			wantErr: true,
+		},
+		"zero increment": {
+			value:   types.StringValue("0 */0 * * * ?"),
+			wantErr: true,
+		},
+		"sixth occurrence": {
+			value:   types.StringValue("0 0 3 ? * 2#6"),
+			wantErr: true,
+		},
+		"empty list item": {
+			value:   types.StringValue("0 0 5,,13 * * ?"),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req := validator.StringRequest{Path: path.Root("cron_expression"), ConfigValue: tc.value}
+			resp := &validator.StringResponse{}
+
+			QuartzCron().ValidateString(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tc.wantErr {
+				t.Errorf("got error %t, want %t: %v", got, tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common/velocity"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// VelocitySyntax returns a validator for string attributes holding an Apache
+// Velocity template. It reports unbalanced directives, parentheses, quotes
+// and comments at plan time instead of when SailPoint renders the template.
+func VelocitySyntax() validator.String {
+	return velocitySyntaxValidator{}
+}
+
+type velocitySyntaxValidator struct{}
+
+func (v velocitySyntaxValidator) Description(_ context.Context) string {
+	return "value must be a syntactically valid Velocity template"
+}
+
+func (v velocitySyntaxValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v velocitySyntaxValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := velocity.Check(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Velocity Template",
+			fmt.Sprintf("The template is not valid Velocity: %s", err.Error()),
+		)
+	}
+}
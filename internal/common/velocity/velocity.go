@@ -0,0 +1,839 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package velocity implements the subset of Apache Velocity used by SailPoint.
+//
+// Render evaluates the subset used in static and conditional transforms:
+// `$var`, `$!var`, `${var}` and `$!{var}` references with chained String
+// method calls (`$name.substring(0,1).toUpperCase()`), `##` line comments,
+// and `#if`/`#elseif`/`#else`/`#end` blocks whose conditions support ==, !=,
+// <, <=, >, >=, &&, ||, ! (and their word forms), parentheses,
+// string/number/boolean/null literals and references.
+//
+// As in Velocity, a reference to an undefined or null variable renders as its
+// own source text, unless it is quiet (`$!var`), which renders nothing.
+//
+// Check only validates the structure of a template, such as a notification
+// template, that uses more of the language than Render supports.
+package velocity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Render renders a template with the given variables.
+func Render(template string, vars map[string]any) (string, error) {
+	p := &velocityParser{src: template}
+	nodes, term, err := p.parseNodes()
+	if err != nil {
+		return "", err
+	}
+	if term != "" {
+		return "", fmt.Errorf("unexpected #%s at offset %d", term, p.pos)
+	}
+	var b strings.Builder
+	if err := renderVelocityNodes(&b, nodes, vars); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// Check reports the first syntax error in a template without rendering it.
+// On top of what Render supports, it accepts property references
+// (`$identity.name`), `#*...*#` comments, `#[[...]]#` unparsed content, the
+// `#foreach`, `#macro` and `#define` blocks and the `#set`, `#include`,
+// `#parse`, `#evaluate`, `#break` and `#stop` directives. Directive and
+// method arguments are only checked for balanced parentheses and quotes.
+func Check(template string) error {
+	p := &velocityParser{src: template, syntaxOnly: true}
+	_, term, err := p.parseNodes()
+	if err != nil {
+		return err
+	}
+	if term != "" {
+		return fmt.Errorf("unexpected #%s at offset %d", term, p.pos)
+	}
+	return nil
+}
+
+type velocityNode any
+
+type velocityText string
+
+type velocityIf struct {
+	branches []velocityBranch
+	elseBody []velocityNode
+}
+
+type velocityBranch struct {
+	cond velocityExpr
+	body []velocityNode
+}
+
+// velocityRef is a variable reference with optional method calls.
+type velocityRef struct {
+	source string
+	quiet  bool
+	name   string
+	calls  []velocityCall
+}
+
+type velocityCall struct {
+	method string
+	args   []velocityExpr
+}
+
+type velocityExpr interface {
+	eval(vars map[string]any) (any, error)
+}
+
+type velocityLiteral struct{ value any }
+
+type velocityNot struct{ operand velocityExpr }
+
+type velocityBinary struct {
+	op          string
+	left, right velocityExpr
+}
+
+type velocityParser struct {
+	src string
+	pos int
+	// syntaxOnly enables the additional syntax accepted by Check.
+	syntaxOnly bool
+}
+
+// velocityBlockDirectives are the directives, other than #if, whose body is
+// closed by #end. They are only recognized by Check.
+var velocityBlockDirectives = []string{"foreach", "macro", "define"}
+
+// velocityLineDirectives are the directives without a body that are only
+// recognized by Check. #break and #stop take optional arguments.
+var velocityLineDirectives = []string{"set", "include", "parse", "evaluate", "break", "stop"}
+
+// parseNodes parses text, references and directives until the end of input
+// or a #elseif/#else/#end, whose name is returned as term.
+func (p *velocityParser) parseNodes() (nodes []velocityNode, term string, err error) {
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			nodes = append(nodes, velocityText(text.String()))
+			text.Reset()
+		}
+	}
+
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch c {
+		case '$':
+			ref, ok, err := p.parseRef()
+			if err != nil {
+				return nil, "", err
+			}
+			if !ok {
+				text.WriteByte(c)
+				p.pos++
+				continue
+			}
+			flush()
+			nodes = append(nodes, ref)
+		case '#':
+			if strings.HasPrefix(p.src[p.pos:], "##") {
+				if end := strings.IndexByte(p.src[p.pos:], '\n'); end >= 0 {
+					p.pos += end + 1
+				} else {
+					p.pos = len(p.src)
+				}
+				continue
+			}
+			if p.syntaxOnly {
+				skipped, err := p.skipCommentOrUnparsed()
+				if err != nil {
+					return nil, "", err
+				}
+				if skipped {
+					continue
+				}
+			}
+			directive, ok := p.directive()
+			if !ok {
+				text.WriteByte(c)
+				p.pos++
+				continue
+			}
+			flush()
+			switch {
+			case directive == "if":
+				node, err := p.parseIf()
+				if err != nil {
+					return nil, "", err
+				}
+				nodes = append(nodes, node)
+			case slices.Contains(velocityBlockDirectives, directive):
+				if err := p.skipBlock(directive); err != nil {
+					return nil, "", err
+				}
+			case slices.Contains(velocityLineDirectives, directive):
+				if err := p.skipDirectiveArgs(directive); err != nil {
+					return nil, "", err
+				}
+			default:
+				return nodes, directive, nil
+			}
+		default:
+			text.WriteByte(c)
+			p.pos++
+		}
+	}
+	flush()
+	return nodes, "", nil
+}
+
+// directive consumes `#if`, `#elseif`, `#else` or `#end` (and, in syntax-only
+// mode, the other known directives), in plain or `#{...}` form, at the
+// current position.
+func (p *velocityParser) directive() (string, bool) {
+	rest := p.src[p.pos+1:]
+	braced := strings.HasPrefix(rest, "{")
+	if braced {
+		rest = rest[1:]
+	}
+	names := []string{"elseif", "else", "end", "if"}
+	if p.syntaxOnly {
+		names = append(names, velocityBlockDirectives...)
+		names = append(names, velocityLineDirectives...)
+	}
+	for _, name := range names {
+		if !strings.HasPrefix(rest, name) {
+			continue
+		}
+		n := 1 + len(name)
+		if braced {
+			if !strings.HasPrefix(rest[len(name):], "}") {
+				return "", false
+			}
+			n += 2
+		} else if after := rest[len(name):]; after != "" && isVelocityIdentChar(after[0]) {
+			return "", false
+		}
+		p.pos += n
+		return name, true
+	}
+	return "", false
+}
+
+func (p *velocityParser) parseIf() (velocityNode, error) {
+	node := &velocityIf{}
+	cond, err := p.parseCondition()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		body, term, err := p.parseNodes()
+		if err != nil {
+			return nil, err
+		}
+		node.branches = append(node.branches, velocityBranch{cond: cond, body: body})
+		switch term {
+		case "elseif":
+			if cond, err = p.parseCondition(); err != nil {
+				return nil, err
+			}
+			continue
+		case "else":
+			elseBody, term, err := p.parseNodes()
+			if err != nil {
+				return nil, err
+			}
+			if term != "end" {
+				return nil, errors.New("#else must be closed by #end")
+			}
+			node.elseBody = elseBody
+			return node, nil
+		case "end":
+			return node, nil
+		}
+		return nil, errors.New("#if is missing its #end")
+	}
+}
+
+func (p *velocityParser) parseCondition() (velocityExpr, error) {
+	if p.syntaxOnly {
+		return nil, p.skipDirectiveArgs("if")
+	}
+	p.skipSpace()
+	if !p.consume("(") {
+		return nil, fmt.Errorf("expected '(' after directive at offset %d", p.pos)
+	}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if !p.consume(")") {
+		return nil, fmt.Errorf("expected ')' at offset %d", p.pos)
+	}
+	return expr, nil
+}
+
+func (p *velocityParser) parseOr() (velocityExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.consumeOperator("||", "or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = velocityBinary{op: "||", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *velocityParser) parseAnd() (velocityExpr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.consumeOperator("&&", "and") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = velocityBinary{op: "&&", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *velocityParser) parseNot() (velocityExpr, error) {
+	p.skipSpace()
+	if !strings.HasPrefix(p.src[p.pos:], "!=") && p.consumeOperator("!", "not") {
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return velocityNot{operand: operand}, nil
+	}
+	return p.parseComparison()
+}
+
+var velocityComparisons = [][2]string{
+	{"==", "eq"}, {"!=", "ne"}, {"<=", "le"}, {">=", "ge"}, {"<", "lt"}, {">", "gt"},
+}
+
+func (p *velocityParser) parseComparison() (velocityExpr, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range velocityComparisons {
+		if p.consumeOperator(op[0], op[1]) {
+			right, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			return velocityBinary{op: op[0], left: left, right: right}, nil
+		}
+	}
+	return left, nil
+}
+
+func (p *velocityParser) parseOperand() (velocityExpr, error) {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return nil, errors.New("unexpected end of expression")
+	}
+	switch c := p.src[p.pos]; {
+	case c == '(':
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if !p.consume(")") {
+			return nil, fmt.Errorf("expected ')' at offset %d", p.pos)
+		}
+		return expr, nil
+	case c == '$':
+		ref, ok, err := p.parseRef()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("invalid reference at offset %d", p.pos)
+		}
+		return ref, nil
+	case c == '\'' || c == '"':
+		end := strings.IndexByte(p.src[p.pos+1:], c)
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated string at offset %d", p.pos)
+		}
+		s := p.src[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+		return velocityLiteral{value: s}, nil
+	case c == '-' || (c >= '0' && c <= '9'):
+		start := p.pos
+		p.pos++
+		for p.pos < len(p.src) && (p.src[p.pos] == '.' || (p.src[p.pos] >= '0' && p.src[p.pos] <= '9')) {
+			p.pos++
+		}
+		n, err := strconv.ParseFloat(p.src[start:p.pos], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", p.src[start:p.pos])
+		}
+		return velocityLiteral{value: n}, nil
+	}
+	for word, value := range map[string]any{"true": true, "false": false, "null": nil} {
+		if p.consumeWord(word) {
+			return velocityLiteral{value: value}, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected %q in expression at offset %d", p.src[p.pos], p.pos)
+}
+
+// parseRef parses a reference at the current position; ok is false when the
+// `$` does not start one (e.g. "$5" or a lone "$").
+func (p *velocityParser) parseRef() (ref *velocityRef, ok bool, err error) {
+	start := p.pos
+	i := p.pos + 1
+	ref = &velocityRef{}
+	if i < len(p.src) && p.src[i] == '!' {
+		ref.quiet = true
+		i++
+	}
+	braced := i < len(p.src) && p.src[i] == '{'
+	if braced {
+		i++
+	}
+	nameStart := i
+	if i >= len(p.src) || !isVelocityIdentStart(p.src[i]) {
+		return nil, false, nil
+	}
+	for i < len(p.src) && isVelocityIdentChar(p.src[i]) {
+		i++
+	}
+	ref.name = p.src[nameStart:i]
+	p.pos = i
+
+	// Method calls: .name(args). A '.' not followed by a call is plain text,
+	// except in syntax-only mode where it is a property reference.
+	for {
+		save := p.pos
+		if !p.consume(".") || p.pos >= len(p.src) || !isVelocityIdentStart(p.src[p.pos]) {
+			p.pos = save
+			break
+		}
+		methodStart := p.pos
+		for p.pos < len(p.src) && isVelocityIdentChar(p.src[p.pos]) {
+			p.pos++
+		}
+		call := velocityCall{method: p.src[methodStart:p.pos]}
+		if p.syntaxOnly {
+			if strings.HasPrefix(p.src[p.pos:], "(") {
+				if err := p.skipParens(); err != nil {
+					return nil, false, err
+				}
+			}
+			continue
+		}
+		if !p.consume("(") {
+			p.pos = save
+			break
+		}
+		p.skipSpace()
+		for !p.consume(")") {
+			if len(call.args) > 0 && !p.consume(",") {
+				return nil, false, fmt.Errorf("expected ',' or ')' in call to %s at offset %d", call.method, p.pos)
+			}
+			arg, err := p.parseOr()
+			if err != nil {
+				return nil, false, err
+			}
+			call.args = append(call.args, arg)
+			p.skipSpace()
+		}
+		ref.calls = append(ref.calls, call)
+	}
+
+	if braced && !p.consume("}") {
+		return nil, false, fmt.Errorf("unterminated ${ reference at offset %d", start)
+	}
+	ref.source = p.src[start:p.pos]
+	return ref, true, nil
+}
+
+// skipCommentOrUnparsed skips a `#*...*#` comment or `#[[...]]#` unparsed
+// content at the current position.
+func (p *velocityParser) skipCommentOrUnparsed() (bool, error) {
+	for _, delims := range [][2]string{{"#*", "*#"}, {"#[[", "]]#"}} {
+		if !strings.HasPrefix(p.src[p.pos:], delims[0]) {
+			continue
+		}
+		end := strings.Index(p.src[p.pos+len(delims[0]):], delims[1])
+		if end < 0 {
+			return false, fmt.Errorf("unterminated %s at offset %d", delims[0], p.pos)
+		}
+		p.pos += len(delims[0]) + end + len(delims[1])
+		return true, nil
+	}
+	return false, nil
+}
+
+// skipBlock skips the arguments and body of a #foreach, #macro or #define.
+func (p *velocityParser) skipBlock(directive string) error {
+	if err := p.skipDirectiveArgs(directive); err != nil {
+		return err
+	}
+	_, term, err := p.parseNodes()
+	if err != nil {
+		return err
+	}
+	switch term {
+	case "end":
+		return nil
+	case "":
+		return fmt.Errorf("#%s is missing its #end", directive)
+	}
+	return fmt.Errorf("unexpected #%s in #%s at offset %d", term, directive, p.pos)
+}
+
+// skipDirectiveArgs skips the parenthesized arguments of a directive, which
+// only #break and #stop may omit.
+func (p *velocityParser) skipDirectiveArgs(directive string) error {
+	start := p.pos
+	p.skipSpace()
+	if !strings.HasPrefix(p.src[p.pos:], "(") {
+		if directive == "break" || directive == "stop" {
+			p.pos = start
+			return nil
+		}
+		return fmt.Errorf("expected '(' after #%s at offset %d", directive, p.pos)
+	}
+	return p.skipParens()
+}
+
+// skipParens skips balanced parentheses, brackets and braces starting at the
+// current position, ignoring those inside string literals.
+func (p *velocityParser) skipParens() error {
+	start := p.pos
+	var open []byte
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch c {
+		case '(', '[', '{':
+			open = append(open, c)
+		case ')', ']', '}':
+			want := map[byte]byte{')': '(', ']': '[', '}': '{'}[c]
+			if len(open) == 0 || open[len(open)-1] != want {
+				return fmt.Errorf("unbalanced %q at offset %d", c, p.pos)
+			}
+			open = open[:len(open)-1]
+		case '\'', '"':
+			end := strings.IndexByte(p.src[p.pos+1:], c)
+			if end < 0 {
+				return fmt.Errorf("unterminated string at offset %d", p.pos)
+			}
+			p.pos += end + 1
+		}
+		p.pos++
+		if len(open) == 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("unclosed '(' opened at offset %d", start)
+}
+
+func (p *velocityParser) skipSpace() {
+	for p.pos < len(p.src) && strings.ContainsRune(" \t\r\n", rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *velocityParser) consume(s string) bool {
+	if strings.HasPrefix(p.src[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+// consumeWord consumes a keyword only when it is not a prefix of a longer
+// identifier.
+func (p *velocityParser) consumeWord(word string) bool {
+	rest := p.src[p.pos:]
+	if !strings.HasPrefix(rest, word) || (len(rest) > len(word) && isVelocityIdentChar(rest[len(word)])) {
+		return false
+	}
+	p.pos += len(word)
+	return true
+}
+
+func (p *velocityParser) consumeOperator(symbol, word string) bool {
+	p.skipSpace()
+	return p.consume(symbol) || p.consumeWord(word)
+}
+
+func isVelocityIdentStart(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isVelocityIdentChar(c byte) bool {
+	return isVelocityIdentStart(c) || (c >= '0' && c <= '9') || c == '_'
+}
+
+func renderVelocityNodes(b *strings.Builder, nodes []velocityNode, vars map[string]any) error {
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case velocityText:
+			b.WriteString(string(n))
+		case *velocityRef:
+			value, err := n.eval(vars)
+			if err != nil {
+				return err
+			}
+			switch {
+			case value != nil:
+				b.WriteString(velocityString(value))
+			case !n.quiet:
+				b.WriteString(n.source)
+			}
+		case *velocityIf:
+			body := n.elseBody
+			for _, branch := range n.branches {
+				value, err := branch.cond.eval(vars)
+				if err != nil {
+					return err
+				}
+				if velocityTruthy(value) {
+					body = branch.body
+					break
+				}
+			}
+			if err := renderVelocityNodes(b, body, vars); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *velocityRef) eval(vars map[string]any) (any, error) {
+	value := vars[r.name]
+	for _, call := range r.calls {
+		if value == nil {
+			return nil, nil
+		}
+		args := make([]any, len(call.args))
+		for i, arg := range call.args {
+			v, err := arg.eval(vars)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = v
+		}
+		var err error
+		if value, err = callVelocityMethod(value, call.method, args); err != nil {
+			return nil, fmt.Errorf("%s: %w", r.source, err)
+		}
+	}
+	return value, nil
+}
+
+func (l velocityLiteral) eval(map[string]any) (any, error) {
+	return l.value, nil
+}
+
+func (n velocityNot) eval(vars map[string]any) (any, error) {
+	v, err := n.operand.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	return !velocityTruthy(v), nil
+}
+
+func (e velocityBinary) eval(vars map[string]any) (any, error) {
+	left, err := e.left.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	switch e.op {
+	case "&&":
+		if !velocityTruthy(left) {
+			return false, nil
+		}
+	case "||":
+		if velocityTruthy(left) {
+			return true, nil
+		}
+	}
+	right, err := e.right.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	switch e.op {
+	case "&&", "||":
+		return velocityTruthy(right), nil
+	case "==":
+		return velocityEqual(left, right), nil
+	case "!=":
+		return !velocityEqual(left, right), nil
+	}
+	l, lok := velocityNumber(left)
+	r, rok := velocityNumber(right)
+	if !lok || !rok {
+		return nil, fmt.Errorf("operator %s needs numeric operands, got %v and %v", e.op, left, right)
+	}
+	switch e.op {
+	case "<":
+		return l < r, nil
+	case "<=":
+		return l <= r, nil
+	case ">":
+		return l > r, nil
+	}
+	return l >= r, nil
+}
+
+// velocityTruthy follows Velocity: null and false are false, anything else
+// (including the empty string) is true.
+func velocityTruthy(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	}
+	return true
+}
+
+func velocityEqual(a, b any) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if x, ok := a.(float64); ok {
+		if y, ok := b.(float64); ok {
+			return x == y
+		}
+	}
+	return velocityString(a) == velocityString(b)
+}
+
+func velocityNumber(v any) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case string:
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return n, err == nil
+	}
+	return 0, false
+}
+
+func velocityString(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return fmt.Sprint(v)
+}
+
+// callVelocityMethod implements the java.lang.String methods commonly used
+// in transform templates. Indexes are in characters, as in Java.
+func callVelocityMethod(value any, method string, args []any) (any, error) {
+	s := velocityString(value)
+	runes := []rune(s)
+
+	intArg := func(i int) (int, error) {
+		n, ok := args[i].(float64)
+		if !ok || n != float64(int(n)) {
+			return 0, fmt.Errorf("%s argument %d must be an integer", method, i+1)
+		}
+		return int(n), nil
+	}
+	strArg := func(i int) string {
+		return velocityString(args[i])
+	}
+	arity := map[string][]int{
+		"substring": {1, 2}, "toLowerCase": {0}, "toUpperCase": {0}, "trim": {0}, "length": {0},
+		"isEmpty": {0}, "replace": {2}, "contains": {1}, "startsWith": {1}, "endsWith": {1},
+		"equals": {1}, "equalsIgnoreCase": {1}, "indexOf": {1}, "concat": {1}, "charAt": {1},
+	}
+	allowed, ok := arity[method]
+	if !ok {
+		return nil, fmt.Errorf("unsupported method %q", method)
+	}
+	validArity := false
+	for _, n := range allowed {
+		validArity = validArity || n == len(args)
+	}
+	if !validArity {
+		return nil, fmt.Errorf("%s does not take %d argument(s)", method, len(args))
+	}
+
+	switch method {
+	case "substring":
+		begin, err := intArg(0)
+		if err != nil {
+			return nil, err
+		}
+		end := len(runes)
+		if len(args) == 2 {
+			if end, err = intArg(1); err != nil {
+				return nil, err
+			}
+		}
+		if begin < 0 || end > len(runes) || begin > end {
+			return nil, fmt.Errorf("substring(%d, %d) is out of bounds for %q", begin, end, s)
+		}
+		return string(runes[begin:end]), nil
+	case "charAt":
+		i, err := intArg(0)
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 || i >= len(runes) {
+			return nil, fmt.Errorf("charAt(%d) is out of bounds for %q", i, s)
+		}
+		return string(runes[i]), nil
+	case "toLowerCase":
+		return strings.ToLower(s), nil
+	case "toUpperCase":
+		return strings.ToUpper(s), nil
+	case "trim":
+		return strings.TrimSpace(s), nil
+	case "length":
+		return float64(len(runes)), nil
+	case "isEmpty":
+		return s == "", nil
+	case "replace":
+		return strings.ReplaceAll(s, strArg(0), strArg(1)), nil
+	case "contains":
+		return strings.Contains(s, strArg(0)), nil
+	case "startsWith":
+		return strings.HasPrefix(s, strArg(0)), nil
+	case "endsWith":
+		return strings.HasSuffix(s, strArg(0)), nil
+	case "equals":
+		return s == strArg(0), nil
+	case "equalsIgnoreCase":
+		return strings.EqualFold(s, strArg(0)), nil
+	case "indexOf":
+		idx := strings.Index(s, strArg(0))
+		if idx >= 0 {
+			idx = len([]rune(s[:idx]))
+		}
+		return float64(idx), nil
+	}
+	return s + strArg(0), nil
+}
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package velocity
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]any{"first": "Ada", "last": "Lovelace", "count": "3"}
+
+	tests := map[string]struct {
+		template string
+		want     string
+		wantErr  string
+	}{
+		"plain text":              {template: "no variables", want: "no variables"},
+		"references":              {template: "$first.$last ${first}_$!{last}", want: "Ada.Lovelace Ada_Lovelace"},
+		"undefined reference":     {template: "$missing|$!missing|${missing}", want: "$missing||${missing}"},
+		"dollar without name":     {template: "costs $5", want: "costs $5"},
+		"method chain":            {template: "$first.substring(0,1).toLowerCase()$last.toLowerCase()", want: "alovelace"},
+		"length":                  {template: "$last.length()", want: "8"},
+		"if else":                 {template: "#if($first == 'Bob')bob#elseif($last.startsWith('Love'))love#{else}none#end", want: "love"},
+		"logical operators":       {template: "#if($first && !$missing || false)yes#end", want: "yes"},
+		"numeric comparison":      {template: "#if($count >= 2 and $count lt 4)ok#end", want: "ok"},
+		"comment":                 {template: "a## ignored\nb", want: "ab"},
+		"unterminated if":         {template: "#if($first)x", wantErr: "missing its #end"},
+		"stray end":               {template: "x#end", wantErr: "unexpected #end"},
+		"substring out of bounds": {template: "$first.substring(5)", wantErr: "out of bounds"},
+		"unsupported method":      {template: "$first.matches('A.*')", wantErr: "unsupported method"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Render(tc.template, vars)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("error = %v, want it to contain %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		template string
+		wantErr  string
+	}{
+		"plain text":            {template: "Hello"},
+		"property references":   {template: "Hello ${requester.displayName}, $identity.name.toUpperCase() was approved."},
+		"method with arguments": {template: `$__dateTool.format("yyyy-MM-dd", $created)`},
+		"set and foreach": {
+			template: "#set($items = [\"a\", \"b\"])\n#foreach($item in $items)\n- $item#if($foreach.hasNext),#end\n#end",
+		},
+		"macro":                {template: "#macro(greet $name)Hi $name#end#greet('Ada')"},
+		"comments":             {template: "#* #end inside a comment *#x## #end\n#[[ #if unparsed ]]#"},
+		"break without args":   {template: "#foreach($i in $list)#break#end"},
+		"if with arithmetic":   {template: "#if($count + 1 > 2)many#{else}few#end"},
+		"unterminated foreach": {template: "#foreach($i in $list)$i", wantErr: "#foreach is missing its #end"},
+		"unterminated if":      {template: "#if($a)x", wantErr: "missing its #end"},
+		"stray end":            {template: "x#end", wantErr: "unexpected #end"},
+		"else in foreach":      {template: "#foreach($i in $list)#else#end", wantErr: "unexpected #else in #foreach"},
+		"set without args":     {template: "#set $a = 1", wantErr: "expected '(' after #set"},
+		"unbalanced parens":    {template: "#if(($a)x#end", wantErr: "unclosed '('"},
+		"unterminated string":  {template: `#set($a = "x)`, wantErr: "unterminated string"},
+		"unterminated comment": {template: "#* no end", wantErr: "unterminated #*"},
+		"unterminated brace":   {template: "${identity.name", wantErr: "unterminated ${ reference"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Check(tc.template)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("error = %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -10,20 +10,36 @@ import (
 
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/access_profile"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/access_request_config"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/certification_campaign_template"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/connector_rule"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/entitlement"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/event_trigger"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/form_definition"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/governance_group"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/identity_attribute"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/identity_profile"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/launcher"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/lifecycle_state"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/managed_cluster"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/notification_template"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/password_policy"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/password_sync_group"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/role"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/segment"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/service_desk_integration"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/sod_policy"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/source"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/tagged_object"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/transform"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/verified_from_address"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/workflow"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/services/workflow_trigger"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -34,7 +50,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &sailpointProvider{}
+	_ provider.Provider                  = &sailpointProvider{}
+	_ provider.ProviderWithFunctions     = &sailpointProvider{}
+	_ provider.ProviderWithActions       = &sailpointProvider{}
+	_ provider.ProviderWithListResources = &sailpointProvider{}
 )
 
 // sailpointProvider is the provider implementation.
@@ -50,6 +69,7 @@ type sailpointProviderModel struct {
 	BaseUrl      types.String `tfsdk:"base_url"`
 	ClientId     types.String `tfsdk:"client_id"`
 	ClientSecret types.String `tfsdk:"client_secret"`
+	DefaultTags  types.Set    `tfsdk:"default_tags"`
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -81,6 +101,12 @@ func (p *sailpointProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:  true,
 				Sensitive: true,
 			},
+			"default_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags added to every taggable object managed by the provider: sources, roles, access profiles and entitlements. " +
+					"They are merged into each resource's `tags_all`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 		},
 	}
 }
@@ -107,6 +133,10 @@ func (p *sailpointProvider) Configure(ctx context.Context, req provider.Configur
 		resp.Diagnostics.AddAttributeError(path.Root("client_secret"), "Invalid Client Secret", "Client Secret must be configured.")
 	}
 
+	if config.DefaultTags.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("default_tags"), "Invalid Default Tags", "Default tags must be known when the provider is configured.")
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -157,8 +187,17 @@ func (p *sailpointProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	if !config.DefaultTags.IsNull() {
+		resp.Diagnostics.Append(config.DefaultTags.ElementsAs(ctx, &apiClient.DefaultTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.DataSourceData = apiClient
 	resp.ResourceData = apiClient
+	resp.ActionData = apiClient
+	resp.ListResourceData = apiClient
 
 	tflog.Info(ctx, "Configured SailPoint client", map[string]any{"success": true})
 }
@@ -168,18 +207,25 @@ func (p *sailpointProvider) DataSources(_ context.Context) []func() datasource.D
 	return []func() datasource.DataSource{
 		access_profile.NewAccessProfileDataSource,
 		entitlement.NewEntitlementDataSource,
+		event_trigger.NewEventTriggersDataSource,
 		form_definition.NewFormDefinitionDataSource,
+		governance_group.NewGovernanceGroupDataSource,
 		identity_attribute.NewIdentityAttributeDataSource,
 		identity_profile.NewIdentityProfileDataSource,
+		identity_profile.NewIdentityProfilePreviewDataSource,
 		launcher.NewLauncherDataSource,
 		lifecycle_state.NewLifecycleStateDataSource,
+		managed_cluster.NewManagedClusterDataSource,
 		role.NewRoleDataSource,
 		segment.NewSegmentDataSource,
+		sod_policy.NewSODPolicyDataSource,
 		source.NewSourceDataSource,
 		source.NewSourceSchemaDataSource,
 		source.NewSourceProvisioningPolicyDataSource,
+		tagged_object.NewTaggedObjectsDataSource,
 		transform.NewTransformDataSource,
 		workflow.NewWorkflowDataSource,
+		workflow.NewWorkflowExecutionsDataSource,
 	}
 }
 
@@ -187,19 +233,69 @@ func (p *sailpointProvider) DataSources(_ context.Context) []func() datasource.D
 func (p *sailpointProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		access_profile.NewAccessProfileResource,
+		access_request_config.NewAccessRequestConfigResource,
+		certification_campaign_template.NewCampaignTemplateResource,
+		connector_rule.NewConnectorRuleResource,
 		entitlement.NewEntitlementResource,
+		event_trigger.NewEventTriggerSubscriptionResource,
 		form_definition.NewFormDefinitionResource,
+		governance_group.NewGovernanceGroupResource,
 		identity_attribute.NewIdentityAttributeResource,
 		identity_profile.NewIdentityProfileResource,
+		identity_profile.NewIdentityProfileAttributeMappingResource,
 		launcher.NewLauncherResource,
 		lifecycle_state.NewLifecycleStateResource,
+		managed_cluster.NewManagedClusterResource,
+		notification_template.NewNotificationTemplateResource,
+		password_policy.NewPasswordPolicyResource,
+		password_sync_group.NewPasswordSyncGroupResource,
 		role.NewRoleResource,
 		segment.NewSegmentResource,
+		service_desk_integration.NewServiceDeskIntegrationResource,
+		service_desk_integration.NewStatusCheckConfigResource,
+		sod_policy.NewSODPolicyResource,
 		source.NewSourceResource,
 		source.NewSourceSchemaResource,
 		source.NewSourceProvisioningPolicyResource,
+		source.NewSourceScheduleResource,
+		source.NewSourceAccountFileResource,
+		tagged_object.NewTaggedObjectResource,
 		transform.NewTransformResource,
+		verified_from_address.NewVerifiedFromAddressResource,
 		workflow.NewWorkflowResource,
 		workflow_trigger.NewWorkflowTriggerResource,
 	}
 }
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *sailpointProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		transform.NewEvaluateTransformFunction,
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *sailpointProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		identity_profile.NewIdentityProfileProcessAction,
+		source.NewSourceAggregateAction,
+		source.NewSourceTestConnectionAction,
+		workflow.NewWorkflowTestAction,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *sailpointProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		access_profile.NewAccessProfileListResource,
+		form_definition.NewFormDefinitionListResource,
+		identity_profile.NewIdentityProfileListResource,
+		launcher.NewLauncherListResource,
+		lifecycle_state.NewLifecycleStateListResource,
+		role.NewRoleListResource,
+		segment.NewSegmentListResource,
+		source.NewSourceListResource,
+		transform.NewTransformListResource,
+		workflow.NewWorkflowListResource,
+	}
+}
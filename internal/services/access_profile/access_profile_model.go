@@ -85,6 +85,12 @@ type accessProfileModel struct {
 	Modified             types.String               `tfsdk:"modified"`
 }
 
+// accessProfileResourceModel adds the tag attributes, which only the resource has, to accessProfileModel.
+type accessProfileResourceModel struct {
+	accessProfileModel
+	common.TagsModel
+}
+
 // ---------------------------------------------------------------------------
 // FromAPI
 // ---------------------------------------------------------------------------
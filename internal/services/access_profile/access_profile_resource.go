@@ -10,11 +10,13 @@ import (
 
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -23,6 +25,8 @@ var (
 	_ resource.Resource                = &accessProfileResource{}
 	_ resource.ResourceWithConfigure   = &accessProfileResource{}
 	_ resource.ResourceWithImportState = &accessProfileResource{}
+	_ resource.ResourceWithIdentity    = &accessProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &accessProfileResource{}
 )
 
 type accessProfileResource struct {
@@ -45,10 +49,14 @@ func (r *accessProfileResource) Configure(ctx context.Context, req resource.Conf
 }
 
 // objectRefNestedAttr builds a standard SingleNestedAttribute for {type, id, name} refs.
-func objectRefNestedAttr(desc string, required bool) schema.SingleNestedAttribute {
+// allowedTypes restricts the accepted values of `type`.
+func objectRefNestedAttr(desc string, required bool, allowedTypes ...string) schema.SingleNestedAttribute {
 	attrs := map[string]schema.Attribute{
-		"type": schema.StringAttribute{Required: true},
-		"id":   schema.StringAttribute{Required: true},
+		"type": schema.StringAttribute{
+			Required:   true,
+			Validators: []validator.String{stringvalidator.OneOf(allowedTypes...)},
+		},
+		"id": schema.StringAttribute{Required: true},
 		"name": schema.StringAttribute{
 			Computed: true,
 			PlanModifiers: []planmodifier.String{
@@ -73,6 +81,9 @@ func approvalSchemesAttr(desc string) schema.ListNestedAttribute {
 				"approver_type": schema.StringAttribute{
 					MarkdownDescription: "One of `APP_OWNER`, `OWNER`, `SOURCE_OWNER`, `MANAGER`, `GOVERNANCE_GROUP`, `WORKFLOW`.",
 					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(client.AccessProfileApproverTypes...),
+					},
 				},
 				"approver_id": schema.StringAttribute{
 					MarkdownDescription: "ID of the approver. Required when `approver_type` is `GOVERNANCE_GROUP` or `WORKFLOW`.",
@@ -86,12 +97,23 @@ func approvalSchemesAttr(desc string) schema.ListNestedAttribute {
 // level3Attrs is the leaf level of the provisioning criteria tree — no further children.
 func level3Attrs() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
-		"operation": schema.StringAttribute{Required: true},
+		"operation": criteriaOperationAttr(""),
 		"attribute": schema.StringAttribute{Optional: true},
 		"value":     schema.StringAttribute{Optional: true},
 	}
 }
 
+// criteriaOperationAttr is the `operation` attribute shared by every level of the provisioning criteria tree.
+func criteriaOperationAttr(desc string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: desc,
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(client.ProvisioningCriteriaOperations...),
+		},
+	}
+}
+
 func (r *accessProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "Resource for SailPoint Access Profile.",
@@ -111,6 +133,9 @@ func (r *accessProfileResource) Schema(_ context.Context, _ resource.SchemaReque
 			"description": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "Description (max 2000 characters).",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2000),
+				},
 			},
 			"enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -122,15 +147,18 @@ func (r *accessProfileResource) Schema(_ context.Context, _ resource.SchemaReque
 				Computed:            true,
 				MarkdownDescription: "Whether the access profile can be requested. Defaults to `true`.",
 			},
-			"owner":  objectRefNestedAttr("The owner of the access profile. Typically `type = IDENTITY`.", true),
-			"source": objectRefNestedAttr("The source the access profile draws entitlements from. `type = SOURCE`.", true),
+			"owner":  objectRefNestedAttr("The owner of the access profile. Typically `type = IDENTITY`.", true, client.OwnerTypes...),
+			"source": objectRefNestedAttr("The source the access profile draws entitlements from. `type = SOURCE`.", true, client.ObjectRefTypeSource),
 			"entitlements": schema.SetNestedAttribute{
 				MarkdownDescription: "Entitlements bundled into this access profile.",
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"type": schema.StringAttribute{Required: true},
-						"id":   schema.StringAttribute{Required: true},
+						"type": schema.StringAttribute{
+							Required:   true,
+							Validators: []validator.String{stringvalidator.OneOf(client.ObjectRefTypeEntitlement)},
+						},
+						"id": schema.StringAttribute{Required: true},
 						"name": schema.StringAttribute{
 							Computed: true,
 							PlanModifiers: []planmodifier.String{
@@ -150,8 +178,11 @@ func (r *accessProfileResource) Schema(_ context.Context, _ resource.SchemaReque
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"type": schema.StringAttribute{Required: true},
-						"id":   schema.StringAttribute{Required: true},
+						"type": schema.StringAttribute{
+							Required:   true,
+							Validators: []validator.String{stringvalidator.OneOf(client.AdditionalOwnerTypes...)},
+						},
+						"id": schema.StringAttribute{Required: true},
 						"name": schema.StringAttribute{
 							Computed: true,
 							PlanModifiers: []planmodifier.String{
@@ -176,6 +207,9 @@ func (r *accessProfileResource) Schema(_ context.Context, _ resource.SchemaReque
 							"time_unit": schema.StringAttribute{
 								MarkdownDescription: "One of `HOURS`, `DAYS`, `WEEKS`, `MONTHS`.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(client.AccessDurationTimeUnits...),
+								},
 							},
 						},
 					},
@@ -193,17 +227,14 @@ func (r *accessProfileResource) Schema(_ context.Context, _ resource.SchemaReque
 				MarkdownDescription: "Provisioning criteria tree. Max 3 levels deep.",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
-					"operation": schema.StringAttribute{
-						MarkdownDescription: "Root operator: `AND`, `OR`, `EQUALS`, `NOT_EQUALS`, `CONTAINS`, `HAS`.",
-						Required:            true,
-					},
+					"operation": criteriaOperationAttr("Root operator: `AND`, `OR`, `EQUALS`, `NOT_EQUALS`, `CONTAINS`, `HAS`."),
 					"attribute": schema.StringAttribute{Optional: true},
 					"value":     schema.StringAttribute{Optional: true},
 					"children": schema.ListNestedAttribute{
 						Optional: true,
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
-								"operation": schema.StringAttribute{Required: true},
+								"operation": criteriaOperationAttr(""),
 								"attribute": schema.StringAttribute{Optional: true},
 								"value":     schema.StringAttribute{Optional: true},
 								"children": schema.ListNestedAttribute{
@@ -224,12 +255,23 @@ func (r *accessProfileResource) Schema(_ context.Context, _ resource.SchemaReque
 				},
 			},
 			"modified": schema.StringAttribute{Computed: true},
+			"tags":     common.TagsAttribute(),
+			"tags_all": common.TagsAllAttribute(),
 		},
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (r *accessProfileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IDIdentitySchema()
+}
+
+func (r *accessProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ModifyPlanTags(ctx, r.client, req, resp)
+}
+
 func (r *accessProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan accessProfileModel
+	var plan accessProfileResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -259,7 +301,10 @@ func (r *accessProfileResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tags, diags := plan.TagsModel.Apply(ctx, r.client, client.ObjectRefTypeAccessProfile, state.ID.ValueString(), nil)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &accessProfileResourceModel{accessProfileModel: state, TagsModel: tags})...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 	tflog.Info(ctx, "Successfully created access profile", map[string]any{
 		"id":   state.ID.ValueString(),
 		"name": state.Name.ValueString(),
@@ -267,7 +312,7 @@ func (r *accessProfileResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *accessProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state accessProfileModel
+	var state accessProfileResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -293,26 +338,34 @@ func (r *accessProfileResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	resp.Diagnostics.Append(state.FromAPI(ctx, apiResp)...)
+	resp.Diagnostics.Append(state.TagsModel.Read(ctx, r.client, client.ObjectRefTypeAccessProfile, id)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 }
 
 func (r *accessProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan accessProfileModel
+	var plan accessProfileResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var state accessProfileModel
+	var state accessProfileResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	id := state.ID.ValueString()
-	ops, diags := plan.ToPatchOperations(ctx, &state)
+	ops, diags := plan.ToPatchOperations(ctx, &state.accessProfileModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, diags := plan.TagsModel.Apply(ctx, r.client, client.ObjectRefTypeAccessProfile, id, &state.TagsModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -336,11 +389,12 @@ func (r *accessProfileResource) Update(ctx context.Context, req resource.UpdateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &accessProfileResourceModel{accessProfileModel: newState, TagsModel: tags})...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: newState.ID})...)
 }
 
 func (r *accessProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state accessProfileModel
+	var state accessProfileResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -357,5 +411,5 @@ func (r *accessProfileResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *accessProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
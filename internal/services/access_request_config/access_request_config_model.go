@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package access_request_config
+
+import (
+	"context"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// accessRequestConfigID is the fixed ID of the tenant's single access request configuration.
+const accessRequestConfigID = "access-request-config"
+
+// accessRequestConfigModel represents the Terraform state for the Access Request Config resource.
+// Nested blocks are objects rather than struct pointers because they may be unknown in the plan
+// when left unset.
+type accessRequestConfigModel struct {
+	ID                                  types.String `tfsdk:"id"`
+	ApprovalsMustBeExternal             types.Bool   `tfsdk:"approvals_must_be_external"`
+	AutoApprovalEnabled                 types.Bool   `tfsdk:"auto_approval_enabled"`
+	ReauthorizationEnabled              types.Bool   `tfsdk:"reauthorization_enabled"`
+	RequestOnBehalfOfConfig             types.Object `tfsdk:"request_on_behalf_of_config"`
+	ApprovalReminderAndEscalationConfig types.Object `tfsdk:"approval_reminder_and_escalation_config"`
+	EntitlementRequestConfig            types.Object `tfsdk:"entitlement_request_config"`
+}
+
+// requestOnBehalfOfConfigModel controls who may request access for someone else.
+type requestOnBehalfOfConfigModel struct {
+	AllowAnyoneByAnyone    types.Bool `tfsdk:"allow_request_on_behalf_of_anyone_by_anyone"`
+	AllowEmployeeByManager types.Bool `tfsdk:"allow_request_on_behalf_of_employee_by_manager"`
+}
+
+var requestOnBehalfOfConfigAttrTypes = map[string]attr.Type{
+	"allow_request_on_behalf_of_anyone_by_anyone":    types.BoolType,
+	"allow_request_on_behalf_of_employee_by_manager": types.BoolType,
+}
+
+// approvalReminderAndEscalationConfigModel controls approval reminders and escalation to a fallback approver.
+type approvalReminderAndEscalationConfigModel struct {
+	DaysUntilEscalation  types.Int64  `tfsdk:"days_until_escalation"`
+	DaysBetweenReminders types.Int64  `tfsdk:"days_between_reminders"`
+	MaxReminders         types.Int64  `tfsdk:"max_reminders"`
+	FallbackApproverID   types.String `tfsdk:"fallback_approver_id"`
+}
+
+var approvalReminderAndEscalationConfigAttrTypes = map[string]attr.Type{
+	"days_until_escalation":  types.Int64Type,
+	"days_between_reminders": types.Int64Type,
+	"max_reminders":          types.Int64Type,
+	"fallback_approver_id":   types.StringType,
+}
+
+// entitlementRequestConfigModel controls requests for individual entitlements.
+type entitlementRequestConfigModel struct {
+	AllowEntitlementRequest     types.Bool   `tfsdk:"allow_entitlement_request"`
+	RequestCommentsRequired     types.Bool   `tfsdk:"request_comments_required"`
+	DeniedCommentsRequired      types.Bool   `tfsdk:"denied_comments_required"`
+	GrantRequestApprovalSchemes types.String `tfsdk:"grant_request_approval_schemes"`
+}
+
+var entitlementRequestConfigAttrTypes = map[string]attr.Type{
+	"allow_entitlement_request":      types.BoolType,
+	"request_comments_required":      types.BoolType,
+	"denied_comments_required":       types.BoolType,
+	"grant_request_approval_schemes": types.StringType,
+}
+
+// FromAPI maps the API response into the Terraform state.
+func (m *accessRequestConfigModel) FromAPI(ctx context.Context, api *client.AccessRequestConfigAPI) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(accessRequestConfigID)
+	m.ApprovalsMustBeExternal = types.BoolValue(api.ApprovalsMustBeExternal)
+	m.AutoApprovalEnabled = types.BoolValue(api.AutoApprovalEnabled)
+	m.ReauthorizationEnabled = types.BoolValue(api.ReauthorizationEnabled)
+
+	onBehalfOf := requestOnBehalfOfConfigModel{
+		AllowAnyoneByAnyone:    types.BoolValue(false),
+		AllowEmployeeByManager: types.BoolValue(false),
+	}
+	if cfg := api.RequestOnBehalfOfConfig; cfg != nil {
+		onBehalfOf.AllowAnyoneByAnyone = types.BoolValue(cfg.AllowRequestOnBehalfOfAnyoneByAnyone)
+		onBehalfOf.AllowEmployeeByManager = types.BoolValue(cfg.AllowRequestOnBehalfOfEmployeeByManager)
+	}
+	m.RequestOnBehalfOfConfig, diags = types.ObjectValueFrom(ctx, requestOnBehalfOfConfigAttrTypes, onBehalfOf)
+	diagnostics.Append(diags...)
+
+	escalation := approvalReminderAndEscalationConfigModel{
+		DaysUntilEscalation:  types.Int64Null(),
+		DaysBetweenReminders: types.Int64Null(),
+		MaxReminders:         types.Int64Null(),
+		FallbackApproverID:   types.StringNull(),
+	}
+	if cfg := api.ApprovalReminderAndEscalationConfig; cfg != nil {
+		escalation.DaysUntilEscalation = types.Int64PointerValue(cfg.DaysUntilEscalation)
+		escalation.DaysBetweenReminders = types.Int64PointerValue(cfg.DaysBetweenReminders)
+		escalation.MaxReminders = types.Int64PointerValue(cfg.MaxReminders)
+		if cfg.FallbackApproverRef != nil && cfg.FallbackApproverRef.ID != "" {
+			escalation.FallbackApproverID = types.StringValue(cfg.FallbackApproverRef.ID)
+		}
+	}
+	m.ApprovalReminderAndEscalationConfig, diags = types.ObjectValueFrom(ctx, approvalReminderAndEscalationConfigAttrTypes, escalation)
+	diagnostics.Append(diags...)
+
+	entitlements := entitlementRequestConfigModel{
+		AllowEntitlementRequest:     types.BoolValue(false),
+		RequestCommentsRequired:     types.BoolValue(false),
+		DeniedCommentsRequired:      types.BoolValue(false),
+		GrantRequestApprovalSchemes: types.StringNull(),
+	}
+	if cfg := api.EntitlementRequestConfig; cfg != nil {
+		entitlements.AllowEntitlementRequest = types.BoolValue(cfg.AllowEntitlementRequest)
+		entitlements.RequestCommentsRequired = types.BoolValue(cfg.RequestCommentsRequired)
+		entitlements.DeniedCommentsRequired = types.BoolValue(cfg.DeniedCommentsRequired)
+		entitlements.GrantRequestApprovalSchemes = common.StringOrNull(cfg.GrantRequestApprovalSchemes)
+	}
+	m.EntitlementRequestConfig, diags = types.ObjectValueFrom(ctx, entitlementRequestConfigAttrTypes, entitlements)
+	diagnostics.Append(diags...)
+
+	return diagnostics
+}
+
+// ApplyTo overlays the known values of the plan onto current, the configuration read from the tenant,
+// so that the full configuration can be PUT without resetting the settings left unset in Terraform.
+func (m *accessRequestConfigModel) ApplyTo(ctx context.Context, current *client.AccessRequestConfigAPI) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	setBool(&current.ApprovalsMustBeExternal, m.ApprovalsMustBeExternal)
+	setBool(&current.AutoApprovalEnabled, m.AutoApprovalEnabled)
+	setBool(&current.ReauthorizationEnabled, m.ReauthorizationEnabled)
+
+	if isKnown(m.RequestOnBehalfOfConfig) {
+		var cfg requestOnBehalfOfConfigModel
+		diagnostics.Append(m.RequestOnBehalfOfConfig.As(ctx, &cfg, basetypes.ObjectAsOptions{})...)
+		if current.RequestOnBehalfOfConfig == nil {
+			current.RequestOnBehalfOfConfig = &client.RequestOnBehalfOfConfigAPI{}
+		}
+		setBool(&current.RequestOnBehalfOfConfig.AllowRequestOnBehalfOfAnyoneByAnyone, cfg.AllowAnyoneByAnyone)
+		setBool(&current.RequestOnBehalfOfConfig.AllowRequestOnBehalfOfEmployeeByManager, cfg.AllowEmployeeByManager)
+	}
+
+	if isKnown(m.ApprovalReminderAndEscalationConfig) {
+		var cfg approvalReminderAndEscalationConfigModel
+		diagnostics.Append(m.ApprovalReminderAndEscalationConfig.As(ctx, &cfg, basetypes.ObjectAsOptions{})...)
+		if current.ApprovalReminderAndEscalationConfig == nil {
+			current.ApprovalReminderAndEscalationConfig = &client.ApprovalReminderAndEscalationConfigAPI{}
+		}
+		escalation := current.ApprovalReminderAndEscalationConfig
+		setInt64(&escalation.DaysUntilEscalation, cfg.DaysUntilEscalation)
+		setInt64(&escalation.DaysBetweenReminders, cfg.DaysBetweenReminders)
+		setInt64(&escalation.MaxReminders, cfg.MaxReminders)
+		if !cfg.FallbackApproverID.IsUnknown() {
+			escalation.FallbackApproverRef = nil
+			if !cfg.FallbackApproverID.IsNull() {
+				escalation.FallbackApproverRef = &client.ObjectRefAPI{Type: client.ObjectRefTypeIdentity, ID: cfg.FallbackApproverID.ValueString()}
+			}
+		}
+	}
+
+	if isKnown(m.EntitlementRequestConfig) {
+		var cfg entitlementRequestConfigModel
+		diagnostics.Append(m.EntitlementRequestConfig.As(ctx, &cfg, basetypes.ObjectAsOptions{})...)
+		if current.EntitlementRequestConfig == nil {
+			current.EntitlementRequestConfig = &client.EntitlementRequestConfigAPI{}
+		}
+		entitlements := current.EntitlementRequestConfig
+		setBool(&entitlements.AllowEntitlementRequest, cfg.AllowEntitlementRequest)
+		setBool(&entitlements.RequestCommentsRequired, cfg.RequestCommentsRequired)
+		setBool(&entitlements.DeniedCommentsRequired, cfg.DeniedCommentsRequired)
+		if !cfg.GrantRequestApprovalSchemes.IsNull() && !cfg.GrantRequestApprovalSchemes.IsUnknown() {
+			schemes := cfg.GrantRequestApprovalSchemes.ValueString()
+			entitlements.GrantRequestApprovalSchemes = &schemes
+		}
+	}
+
+	return diagnostics
+}
+
+func isKnown(o types.Object) bool {
+	return !o.IsNull() && !o.IsUnknown()
+}
+
+// setBool overwrites dst with v when v is known.
+func setBool(dst *bool, v types.Bool) {
+	if !v.IsNull() && !v.IsUnknown() {
+		*dst = v.ValueBool()
+	}
+}
+
+// setInt64 overwrites dst with v when v is known.
+func setInt64(dst **int64, v types.Int64) {
+	if !v.IsNull() && !v.IsUnknown() {
+		value := v.ValueInt64()
+		*dst = &value
+	}
+}
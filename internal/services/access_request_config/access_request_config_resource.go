@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package access_request_config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &accessRequestConfigResource{}
+	_ resource.ResourceWithConfigure   = &accessRequestConfigResource{}
+	_ resource.ResourceWithImportState = &accessRequestConfigResource{}
+)
+
+type accessRequestConfigResource struct {
+	client *client.Client
+}
+
+// NewAccessRequestConfigResource creates a new Access Request Config resource.
+func NewAccessRequestConfigResource() resource.Resource {
+	return &accessRequestConfigResource{}
+}
+
+func (r *accessRequestConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_request_config"
+}
+
+func (r *accessRequestConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "access request config resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+// boolAttribute returns an optional setting that keeps its current tenant value when unset.
+func boolAttribute(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// daysAttribute returns an optional, non-negative count that keeps its current tenant value when unset.
+func daysAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// stringAttribute returns an optional string setting that keeps its current tenant value when unset.
+func stringAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// blockAttribute returns an optional group of settings that keeps its current tenant values when unset.
+func blockAttribute(description string, attributes map[string]schema.Attribute) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		Attributes:          attributes,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *accessRequestConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for the SailPoint tenant-wide access request configuration.",
+		MarkdownDescription: "Resource for the SailPoint tenant-wide access request configuration. This is a singleton: declare it at most once per tenant. " +
+			"Settings left unset keep their current tenant value; every create and update sends the full configuration. " +
+			"Destroying the resource resets the configuration to the documented defaults. " +
+			"Per-object settings are managed with `access_request_config` on `sailpoint_role` and `sailpoint_access_profile`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Always `access-request-config`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"approvals_must_be_external": boolAttribute("Whether access requests must be approved outside of Identity Security Cloud (e.g., through a service desk integration)."),
+			"auto_approval_enabled":      boolAttribute("Whether requests are approved automatically when the requester is also the approver."),
+			"reauthorization_enabled":    boolAttribute("Whether approvers must re-authenticate before approving."),
+			"request_on_behalf_of_config": blockAttribute("Who may request access for someone else.", map[string]schema.Attribute{
+				"allow_request_on_behalf_of_anyone_by_anyone":    boolAttribute("Whether anyone may request access for anyone."),
+				"allow_request_on_behalf_of_employee_by_manager": boolAttribute("Whether managers may request access for their direct reports."),
+			}),
+			"approval_reminder_and_escalation_config": blockAttribute("Approval reminders, and the reassignment of pending approvals to a fallback approver once they escalate.", map[string]schema.Attribute{
+				"days_until_escalation":  daysAttribute("Days to wait before the first reminder, or before escalating when no reminders are configured."),
+				"days_between_reminders": daysAttribute("Days between reminders."),
+				"max_reminders":          daysAttribute("Maximum number of reminders sent before escalating."),
+				"fallback_approver_id":   stringAttribute("ID of the identity pending approvals are reassigned to on escalation."),
+			}),
+			"entitlement_request_config": blockAttribute("Requests for individual entitlements.", map[string]schema.Attribute{
+				"allow_entitlement_request":      boolAttribute("Whether entitlements can be requested."),
+				"request_comments_required":      boolAttribute("Whether requesters must comment when requesting an entitlement."),
+				"denied_comments_required":       boolAttribute("Whether approvers must comment when denying an entitlement request."),
+				"grant_request_approval_schemes": stringAttribute("Comma-separated approvers of entitlement requests, in order: `entitlementOwner`, `sourceOwner`, `manager` or `workgroup:<governance group ID>`."),
+			}),
+		},
+	}
+}
+
+func (r *accessRequestConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan accessRequestConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating access request config")
+	state, diags := r.put(ctx, &plan, "Error Creating SailPoint Access Request Config")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "Successfully created access request config")
+}
+
+func (r *accessRequestConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state accessRequestConfigModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.GetAccessRequestConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Access Request Config",
+			fmt.Sprintf("Could not read SailPoint Access Request Config: %s", err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Reading SailPoint Access Request Config", "Received nil response from SailPoint API")
+		return
+	}
+
+	resp.Diagnostics.Append(state.FromAPI(ctx, apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *accessRequestConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan accessRequestConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.put(ctx, &plan, "Error Updating SailPoint Access Request Config")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "Successfully updated access request config")
+}
+
+func (r *accessRequestConfigResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if _, err := r.client.UpdateAccessRequestConfig(ctx, client.DefaultAccessRequestConfig()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SailPoint Access Request Config",
+			fmt.Sprintf("Could not reset SailPoint Access Request Config to its defaults: %s", err.Error()),
+		)
+		return
+	}
+	tflog.Info(ctx, "Successfully reset access request config to its defaults")
+}
+
+// ImportState accepts any ID, as there is a single access request configuration per tenant.
+func (r *accessRequestConfigResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), accessRequestConfigID)...)
+}
+
+// put reads the current configuration, overlays the plan and PUTs the result, returning the new state.
+func (r *accessRequestConfigResource) put(ctx context.Context, plan *accessRequestConfigModel, errorTitle string) (*accessRequestConfigModel, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	current, err := r.client.GetAccessRequestConfig(ctx)
+	if err != nil {
+		diagnostics.AddError(errorTitle, fmt.Sprintf("Could not read the current SailPoint Access Request Config: %s", err.Error()))
+		return nil, diagnostics
+	}
+	if current == nil {
+		diagnostics.AddError(errorTitle, "Received nil response from SailPoint API")
+		return nil, diagnostics
+	}
+
+	diagnostics.Append(plan.ApplyTo(ctx, current)...)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	apiResp, err := r.client.UpdateAccessRequestConfig(ctx, current)
+	if err != nil {
+		diagnostics.AddError(errorTitle, fmt.Sprintf("Could not update SailPoint Access Request Config: %s", err.Error()))
+		return nil, diagnostics
+	}
+	if apiResp == nil {
+		diagnostics.AddError(errorTitle, "Received nil response from SailPoint API")
+		return nil, diagnostics
+	}
+
+	var state accessRequestConfigModel
+	diagnostics.Append(state.FromAPI(ctx, apiResp)...)
+	return &state, diagnostics
+}
@@ -0,0 +1,373 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package certification_campaign_template
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// campaignTemplateModel represents the Terraform state for a Certification Campaign Template resource.
+type campaignTemplateModel struct {
+	ID               types.String           `tfsdk:"id"`
+	Name             types.String           `tfsdk:"name"`
+	Description      types.String           `tfsdk:"description"`
+	Owner            *common.ObjectRefModel `tfsdk:"owner"`
+	DeadlineDuration types.String           `tfsdk:"deadline_duration"`
+	Campaign         *campaignModel         `tfsdk:"campaign"`
+	Schedule         *common.ScheduleModel  `tfsdk:"schedule"`
+	Scheduled        types.Bool             `tfsdk:"scheduled"`
+	Created          types.String           `tfsdk:"created"`
+	Modified         types.String           `tfsdk:"modified"`
+}
+
+// campaignModel is the campaign generated from the template.
+type campaignModel struct {
+	Name                        types.String                  `tfsdk:"name"`
+	Description                 types.String                  `tfsdk:"description"`
+	Type                        types.String                  `tfsdk:"type"`
+	EmailNotificationEnabled    types.Bool                    `tfsdk:"email_notification_enabled"`
+	AutoRevokeAllowed           types.Bool                    `tfsdk:"auto_revoke_allowed"`
+	RecommendationsEnabled      types.Bool                    `tfsdk:"recommendations_enabled"`
+	SunsetCommentsRequired      types.Bool                    `tfsdk:"sunset_comments_required"`
+	MandatoryCommentRequirement types.String                  `tfsdk:"mandatory_comment_requirement"`
+	FilterID                    types.String                  `tfsdk:"filter_id"`
+	SourceOwner                 *sourceOwnerCampaignModel     `tfsdk:"source_owner"`
+	Search                      *searchCampaignModel          `tfsdk:"search"`
+	RoleComposition             *roleCompositionCampaignModel `tfsdk:"role_composition"`
+}
+
+// sourceOwnerCampaignModel selects the sources certified by a SOURCE_OWNER campaign.
+type sourceOwnerCampaignModel struct {
+	SourceIDs types.Set `tfsdk:"source_ids"`
+}
+
+// searchCampaignModel selects the identities or access certified by a SEARCH campaign.
+type searchCampaignModel struct {
+	Type        types.String           `tfsdk:"type"`
+	Description types.String           `tfsdk:"description"`
+	Reviewer    *common.ObjectRefModel `tfsdk:"reviewer"`
+	Query       types.String           `tfsdk:"query"`
+	IdentityIDs types.Set              `tfsdk:"identity_ids"`
+}
+
+// roleCompositionCampaignModel selects the roles certified by a ROLE_COMPOSITION campaign.
+type roleCompositionCampaignModel struct {
+	Reviewer    *common.ObjectRefModel `tfsdk:"reviewer"`
+	RoleIDs     types.Set              `tfsdk:"role_ids"`
+	Remediator  *common.ObjectRefModel `tfsdk:"remediator"`
+	Query       types.String           `tfsdk:"query"`
+	Description types.String           `tfsdk:"description"`
+}
+
+// FromAPI maps the API template and its schedule (nil when the template is not scheduled) into the Terraform state.
+func (m *campaignTemplateModel) FromAPI(ctx context.Context, api *client.CampaignTemplateAPI, schedule *client.ScheduleAPI) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	m.ID = types.StringValue(api.ID)
+	m.Name = types.StringValue(api.Name)
+	m.Description = types.StringValue(api.Description)
+	m.DeadlineDuration = common.StringOrNull(api.DeadlineDuration)
+	m.Scheduled = types.BoolValue(api.Scheduled != nil && *api.Scheduled)
+	m.Created = common.StringOrNull(api.Created)
+	m.Modified = common.StringOrNull(api.Modified)
+
+	m.Owner = nil
+	if api.OwnerRef != nil {
+		owner, diags := common.NewObjectRefFromAPIPtr(ctx, *api.OwnerRef)
+		diagnostics.Append(diags...)
+		m.Owner = owner
+	}
+
+	campaign := api.Campaign
+	m.Campaign = &campaignModel{
+		Name:                        types.StringValue(campaign.Name),
+		Description:                 types.StringValue(campaign.Description),
+		Type:                        types.StringValue(campaign.Type),
+		EmailNotificationEnabled:    boolOrDefault(campaign.EmailNotificationEnabled, false),
+		AutoRevokeAllowed:           boolOrDefault(campaign.AutoRevokeAllowed, false),
+		RecommendationsEnabled:      boolOrDefault(campaign.RecommendationsEnabled, false),
+		SunsetCommentsRequired:      boolOrDefault(campaign.SunsetCommentsRequired, true),
+		MandatoryCommentRequirement: common.StringOrNull(campaign.MandatoryCommentRequirement),
+		FilterID:                    types.StringNull(),
+	}
+	if campaign.Filter != nil && campaign.Filter.ID != "" {
+		m.Campaign.FilterID = types.StringValue(campaign.Filter.ID)
+	}
+
+	if info := campaign.SourceOwnerCampaignInfo; info != nil {
+		m.Campaign.SourceOwner = &sourceOwnerCampaignModel{
+			SourceIDs: setOrNull(ctx, info.SourceIDs, &diagnostics),
+		}
+	}
+	if info := campaign.SearchCampaignInfo; info != nil {
+		m.Campaign.Search = &searchCampaignModel{
+			Type:        types.StringValue(info.Type),
+			Description: common.StringOrNull(info.Description),
+			Query:       common.StringOrNull(info.Query),
+			IdentityIDs: setOrNull(ctx, info.IdentityIDs, &diagnostics),
+		}
+		m.Campaign.Search.Reviewer = objectRefFromAPI(ctx, info.Reviewer, &diagnostics)
+	}
+	if info := campaign.RoleCompositionCampaignInfo; info != nil {
+		m.Campaign.RoleComposition = &roleCompositionCampaignModel{
+			RoleIDs:     setOrNull(ctx, info.RoleIDs, &diagnostics),
+			Query:       common.StringOrNull(info.Query),
+			Description: common.StringOrNull(info.Description),
+		}
+		m.Campaign.RoleComposition.Reviewer = objectRefFromAPI(ctx, info.Reviewer, &diagnostics)
+		m.Campaign.RoleComposition.Remediator = objectRefFromAPI(ctx, &info.RemediatorRef, &diagnostics)
+	}
+
+	m.Schedule = nil
+	if schedule != nil {
+		m.Schedule = &common.ScheduleModel{}
+		diagnostics.Append(m.Schedule.FromAPI(ctx, *schedule)...)
+	}
+
+	return diagnostics
+}
+
+// ToAPI maps the Terraform state into an API create payload. The schedule is sent separately.
+func (m *campaignTemplateModel) ToAPI(ctx context.Context) (*client.CampaignTemplateAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	api := &client.CampaignTemplateAPI{
+		Name:             m.Name.ValueString(),
+		Description:      m.Description.ValueString(),
+		DeadlineDuration: stringPointer(m.DeadlineDuration),
+	}
+
+	if m.Owner != nil && !m.Owner.ID.IsUnknown() {
+		owner, diags := common.NewObjectRefToAPIPtr(ctx, *m.Owner)
+		diagnostics.Append(diags...)
+		api.OwnerRef = owner
+	}
+
+	if m.Campaign != nil {
+		campaign, diags := m.Campaign.ToAPI(ctx)
+		diagnostics.Append(diags...)
+		api.Campaign = campaign
+	}
+
+	return api, diagnostics
+}
+
+// ToAPI maps the campaign into its API representation.
+func (m *campaignModel) ToAPI(ctx context.Context) (client.CampaignTemplateCampaignAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	api := client.CampaignTemplateCampaignAPI{
+		Name:                        m.Name.ValueString(),
+		Description:                 m.Description.ValueString(),
+		Type:                        m.Type.ValueString(),
+		EmailNotificationEnabled:    boolPointer(m.EmailNotificationEnabled),
+		AutoRevokeAllowed:           boolPointer(m.AutoRevokeAllowed),
+		RecommendationsEnabled:      boolPointer(m.RecommendationsEnabled),
+		SunsetCommentsRequired:      boolPointer(m.SunsetCommentsRequired),
+		MandatoryCommentRequirement: stringPointer(m.MandatoryCommentRequirement),
+	}
+
+	if !m.FilterID.IsNull() && !m.FilterID.IsUnknown() {
+		api.Filter = &client.ObjectRefAPI{Type: client.CampaignFilterType, ID: m.FilterID.ValueString()}
+	}
+	api.SourceOwnerCampaignInfo = m.SourceOwner.ToAPI(ctx, &diagnostics)
+	api.SearchCampaignInfo = m.Search.ToAPI(ctx, &diagnostics)
+	api.RoleCompositionCampaignInfo = m.RoleComposition.ToAPI(ctx, &diagnostics)
+
+	return api, diagnostics
+}
+
+// ToAPI maps the source owner campaign settings, returning nil when they are not configured.
+func (m *sourceOwnerCampaignModel) ToAPI(ctx context.Context, diagnostics *diag.Diagnostics) *client.SourceOwnerCampaignInfoAPI {
+	if m == nil {
+		return nil
+	}
+	return &client.SourceOwnerCampaignInfoAPI{
+		SourceIDs: setToStrings(ctx, m.SourceIDs, diagnostics),
+	}
+}
+
+// ToAPI maps the search campaign settings, returning nil when they are not configured.
+func (m *searchCampaignModel) ToAPI(ctx context.Context, diagnostics *diag.Diagnostics) *client.SearchCampaignInfoAPI {
+	if m == nil {
+		return nil
+	}
+	return &client.SearchCampaignInfoAPI{
+		Type:        m.Type.ValueString(),
+		Description: stringPointer(m.Description),
+		Reviewer:    objectRefToAPI(ctx, m.Reviewer, diagnostics),
+		Query:       stringPointer(m.Query),
+		IdentityIDs: setToStrings(ctx, m.IdentityIDs, diagnostics),
+	}
+}
+
+// ToAPI maps the role composition campaign settings, returning nil when they are not configured.
+func (m *roleCompositionCampaignModel) ToAPI(ctx context.Context, diagnostics *diag.Diagnostics) *client.RoleCompositionCampaignInfoAPI {
+	if m == nil {
+		return nil
+	}
+	api := &client.RoleCompositionCampaignInfoAPI{
+		Reviewer:    objectRefToAPI(ctx, m.Reviewer, diagnostics),
+		RoleIDs:     setToStrings(ctx, m.RoleIDs, diagnostics),
+		Query:       stringPointer(m.Query),
+		Description: stringPointer(m.Description),
+	}
+	if remediator := objectRefToAPI(ctx, m.Remediator, diagnostics); remediator != nil {
+		api.RemediatorRef = *remediator
+	}
+	return api
+}
+
+// ToPatchOperations compares the plan (m) against state and returns JSON Patch ops for changed fields.
+// The campaign type cannot be patched; changing it replaces the template.
+func (m *campaignTemplateModel) ToPatchOperations(ctx context.Context, state *campaignTemplateModel) ([]client.JSONPatchOperation, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	var ops []client.JSONPatchOperation
+
+	if !m.Name.Equal(state.Name) {
+		ops = append(ops, client.NewReplacePatch("/name", m.Name.ValueString()))
+	}
+	if !m.Description.Equal(state.Description) {
+		ops = append(ops, client.NewReplacePatch("/description", m.Description.ValueString()))
+	}
+	if !m.DeadlineDuration.Equal(state.DeadlineDuration) {
+		if !m.DeadlineDuration.IsNull() {
+			ops = append(ops, client.NewReplacePatch("/deadlineDuration", m.DeadlineDuration.ValueString()))
+		} else {
+			ops = append(ops, client.NewRemovePatch("/deadlineDuration"))
+		}
+	}
+	if m.Owner != nil && (state.Owner == nil || !m.Owner.ID.Equal(state.Owner.ID) || !m.Owner.Type.Equal(state.Owner.Type)) {
+		owner, diags := common.NewObjectRefToAPIPtr(ctx, *m.Owner)
+		diagnostics.Append(diags...)
+		ops = append(ops, client.NewReplacePatch("/ownerRef", owner))
+	}
+
+	if m.Campaign == nil || state.Campaign == nil {
+		return ops, diagnostics
+	}
+	planned, prior := m.Campaign, state.Campaign
+
+	if !planned.Name.Equal(prior.Name) {
+		ops = append(ops, client.NewReplacePatch("/campaign/name", planned.Name.ValueString()))
+	}
+	if !planned.Description.Equal(prior.Description) {
+		ops = append(ops, client.NewReplacePatch("/campaign/description", planned.Description.ValueString()))
+	}
+	boolFields := []struct {
+		path           string
+		planned, prior types.Bool
+	}{
+		{"/campaign/emailNotificationEnabled", planned.EmailNotificationEnabled, prior.EmailNotificationEnabled},
+		{"/campaign/autoRevokeAllowed", planned.AutoRevokeAllowed, prior.AutoRevokeAllowed},
+		{"/campaign/recommendationsEnabled", planned.RecommendationsEnabled, prior.RecommendationsEnabled},
+		{"/campaign/sunsetCommentsRequired", planned.SunsetCommentsRequired, prior.SunsetCommentsRequired},
+	}
+	for _, field := range boolFields {
+		if !field.planned.Equal(field.prior) && !field.planned.IsNull() && !field.planned.IsUnknown() {
+			ops = append(ops, client.NewReplacePatch(field.path, field.planned.ValueBool()))
+		}
+	}
+	if !planned.MandatoryCommentRequirement.Equal(prior.MandatoryCommentRequirement) &&
+		!planned.MandatoryCommentRequirement.IsNull() && !planned.MandatoryCommentRequirement.IsUnknown() {
+		ops = append(ops, client.NewReplacePatch("/campaign/mandatoryCommentRequirement", planned.MandatoryCommentRequirement.ValueString()))
+	}
+	if !planned.FilterID.Equal(prior.FilterID) {
+		if !planned.FilterID.IsNull() {
+			ops = append(ops, client.NewReplacePatch("/campaign/filter", client.ObjectRefAPI{Type: client.CampaignFilterType, ID: planned.FilterID.ValueString()}))
+		} else {
+			ops = append(ops, client.NewRemovePatch("/campaign/filter"))
+		}
+	}
+
+	if !reflect.DeepEqual(planned.SourceOwner, prior.SourceOwner) {
+		ops = append(ops, campaignInfoPatch("/campaign/sourceOwnerCampaignInfo", planned.SourceOwner.ToAPI(ctx, &diagnostics)))
+	}
+	if !reflect.DeepEqual(planned.Search, prior.Search) {
+		ops = append(ops, campaignInfoPatch("/campaign/searchCampaignInfo", planned.Search.ToAPI(ctx, &diagnostics)))
+	}
+	if !reflect.DeepEqual(planned.RoleComposition, prior.RoleComposition) {
+		ops = append(ops, campaignInfoPatch("/campaign/roleCompositionCampaignInfo", planned.RoleComposition.ToAPI(ctx, &diagnostics)))
+	}
+
+	return ops, diagnostics
+}
+
+// campaignInfoPatch replaces the type-specific campaign settings at path, or removes them when info is nil.
+func campaignInfoPatch[T any](path string, info *T) client.JSONPatchOperation {
+	if info == nil {
+		return client.NewRemovePatch(path)
+	}
+	return client.NewReplacePatch(path, info)
+}
+
+func objectRefFromAPI(ctx context.Context, api *client.ObjectRefAPI, diagnostics *diag.Diagnostics) *common.ObjectRefModel {
+	if api == nil || api.ID == "" {
+		return nil
+	}
+	ref, diags := common.NewObjectRefFromAPIPtr(ctx, *api)
+	diagnostics.Append(diags...)
+	return ref
+}
+
+func objectRefToAPI(ctx context.Context, m *common.ObjectRefModel, diagnostics *diag.Diagnostics) *client.ObjectRefAPI {
+	if m == nil {
+		return nil
+	}
+	ref, diags := common.NewObjectRefToAPIPtr(ctx, *m)
+	diagnostics.Append(diags...)
+	return ref
+}
+
+// setOrNull converts values to a set of strings, returning a null set when values is empty.
+func setOrNull(ctx context.Context, values []string, diagnostics *diag.Diagnostics) types.Set {
+	if len(values) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	set, diags := types.SetValueFrom(ctx, types.StringType, values)
+	diagnostics.Append(diags...)
+	return set
+}
+
+// setToStrings converts a set of strings to a slice (null/unknown → nil).
+func setToStrings(ctx context.Context, set types.Set, diagnostics *diag.Diagnostics) []string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+	var values []string
+	diagnostics.Append(set.ElementsAs(ctx, &values, false)...)
+	return values
+}
+
+// boolOrDefault converts *bool to types.Bool, using def when the API omits the value.
+func boolOrDefault(b *bool, def bool) types.Bool {
+	if b == nil {
+		return types.BoolValue(def)
+	}
+	return types.BoolValue(*b)
+}
+
+// boolPointer converts types.Bool to *bool (null/unknown → nil).
+func boolPointer(b types.Bool) *bool {
+	if b.IsNull() || b.IsUnknown() {
+		return nil
+	}
+	v := b.ValueBool()
+	return &v
+}
+
+// stringPointer converts types.String to *string (null/unknown → nil).
+func stringPointer(s types.String) *string {
+	if s.IsNull() || s.IsUnknown() {
+		return nil
+	}
+	v := s.ValueString()
+	return &v
+}
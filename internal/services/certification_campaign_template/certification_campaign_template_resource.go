@@ -0,0 +1,588 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package certification_campaign_template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &campaignTemplateResource{}
+	_ resource.ResourceWithConfigure      = &campaignTemplateResource{}
+	_ resource.ResourceWithImportState    = &campaignTemplateResource{}
+	_ resource.ResourceWithValidateConfig = &campaignTemplateResource{}
+)
+
+// deadlineDurationPattern matches the ISO-8601 durations accepted as deadline durations (e.g., `P2W`, `P1M15D`).
+var deadlineDurationPattern = regexp.MustCompile(`^P(\d+Y)?(\d+M)?(\d+W)?(\d+D)?$`)
+
+type campaignTemplateResource struct {
+	client *client.Client
+}
+
+// NewCampaignTemplateResource creates a new Certification Campaign Template resource.
+func NewCampaignTemplateResource() resource.Resource {
+	return &campaignTemplateResource{}
+}
+
+func (r *campaignTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certification_campaign_template"
+}
+
+func (r *campaignTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "certification campaign template resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+// objectRefAttribute returns the schema of a reference to an identity or governance group.
+func objectRefAttribute(description string, required bool, allowedTypes []string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Required:            required,
+		Optional:            !required,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The type of the referenced object. One of `%s`.", strings.Join(allowedTypes, "`, `")),
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(allowedTypes...),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the referenced object.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the referenced object. Resolved by the server from the ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *campaignTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	owner := objectRefAttribute("The owner of the template. Defaults to the identity that created it.", false, client.OwnerTypes)
+	owner.Computed = true
+	owner.PlanModifiers = []planmodifier.Object{
+		objectplanmodifier.UseStateForUnknown(),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Resource for SailPoint Certification Campaign Template.",
+		MarkdownDescription: "Resource for SailPoint Certification Campaign Template. A campaign template describes a certification campaign " +
+			"(manager, source owner, search or role composition) and, when `schedule` is set, generates campaigns from it on a recurring schedule.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the campaign template.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the campaign template.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the campaign template.",
+				Required:            true,
+			},
+			"owner": owner,
+			"deadline_duration": schema.StringAttribute{
+				MarkdownDescription: "How long generated campaigns stay open, as an ISO-8601 duration (e.g., `P2W` for two weeks).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(deadlineDurationPattern, "must be an ISO-8601 duration of years, months, weeks and days, e.g. P2W"),
+				},
+			},
+			"campaign": schema.SingleNestedAttribute{
+				MarkdownDescription: "The campaign generated from the template.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of generated campaigns. May contain placeholders such as `${campaignDate}`.",
+						Required:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "The description of generated campaigns. May contain placeholders such as `${campaignDate}`.",
+						Required:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the campaign. One of `MANAGER`, `SOURCE_OWNER`, `SEARCH`, `ROLE_COMPOSITION`. Changing this forces a new resource.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.CampaignTypes...),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"email_notification_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether reviewers are notified by email. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"auto_revoke_allowed": schema.BoolAttribute{
+						MarkdownDescription: "Whether access that was not reviewed by the deadline is revoked automatically. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"recommendations_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether AI recommendations are shown to reviewers. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"sunset_comments_required": schema.BoolAttribute{
+						MarkdownDescription: "Whether reviewers must comment when setting an access end date. Defaults to `true`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"mandatory_comment_requirement": schema.StringAttribute{
+						MarkdownDescription: "Which decisions require a comment. One of `ALL_DECISIONS`, `REVOKE_ONLY_DECISIONS`, `NO_DECISIONS`.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.CampaignMandatoryCommentRequirements...),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"filter_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the campaign filter that narrows the access items to review.",
+						Optional:            true,
+					},
+					"source_owner": schema.SingleNestedAttribute{
+						MarkdownDescription: "Settings of a `SOURCE_OWNER` campaign. Required for that type.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"source_ids": schema.SetAttribute{
+								MarkdownDescription: "IDs of the sources whose owners certify access.",
+								Required:            true,
+								ElementType:         types.StringType,
+								Validators: []validator.Set{
+									setvalidator.SizeAtLeast(1),
+								},
+							},
+						},
+					},
+					"search": schema.SingleNestedAttribute{
+						MarkdownDescription: "Settings of a `SEARCH` campaign. Required for that type.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								MarkdownDescription: "What the search selects. One of `IDENTITY`, `ACCESS`.",
+								Required:            true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(client.SearchCampaignTypes...),
+								},
+							},
+							"description": schema.StringAttribute{
+								MarkdownDescription: "Description of the search.",
+								Optional:            true,
+							},
+							"reviewer": objectRefAttribute("The identity or governance group reviewing the campaign.", false, client.CampaignReviewerTypes),
+							"query": schema.StringAttribute{
+								MarkdownDescription: "Search query selecting the identities to certify. Exclusive with `identity_ids`.",
+								Optional:            true,
+							},
+							"identity_ids": schema.SetAttribute{
+								MarkdownDescription: "IDs of the identities to certify. Exclusive with `query`.",
+								Optional:            true,
+								ElementType:         types.StringType,
+							},
+						},
+					},
+					"role_composition": schema.SingleNestedAttribute{
+						MarkdownDescription: "Settings of a `ROLE_COMPOSITION` campaign. Required for that type.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"reviewer": objectRefAttribute("The identity or governance group reviewing the campaign. Defaults to the role owners.", false, client.CampaignReviewerTypes),
+							"role_ids": schema.SetAttribute{
+								MarkdownDescription: "IDs of the roles to certify. Exclusive with `query`.",
+								Optional:            true,
+								ElementType:         types.StringType,
+							},
+							"remediator": objectRefAttribute("The identity that remediates revoked role composition changes.", true, client.OwnerTypes),
+							"query": schema.StringAttribute{
+								MarkdownDescription: "Search query selecting the roles to certify. Exclusive with `role_ids`.",
+								Optional:            true,
+							},
+							"description": schema.StringAttribute{
+								MarkdownDescription: "Description of the role selection.",
+								Optional:            true,
+							},
+						},
+					},
+				},
+			},
+			"schedule": schema.SingleNestedAttribute{
+				MarkdownDescription: "Schedule on which campaigns are generated from the template. Removing it unschedules the template.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The schedule type. One of `WEEKLY`, `MONTHLY`, `ANNUALLY`, `CALENDAR`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.CampaignTemplateScheduleTypes...),
+						},
+					},
+					"months": schema.ListAttribute{
+						MarkdownDescription: "Months the schedule runs in (`1`-`12`), for `ANNUALLY` schedules.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"days": schema.ListAttribute{
+						MarkdownDescription: "Days the schedule runs on: weekdays (`MON`-`SUN`) for `WEEKLY` schedules, days of the month (`1`-`31`, `L` for the last day) for `MONTHLY` and `ANNUALLY` schedules, or ISO-8601 dates for `CALENDAR` schedules.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"hours": schema.ListAttribute{
+						MarkdownDescription: "Hours of the day the schedule runs at (`0`-`23`).",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"expiration": schema.StringAttribute{
+						MarkdownDescription: "ISO-8601 date and time after which the schedule stops running.",
+						Optional:            true,
+					},
+					"time_zone_id": schema.StringAttribute{
+						MarkdownDescription: "Time zone the hours are expressed in (e.g., `America/Chicago`). Defaults to GMT.",
+						Optional:            true,
+					},
+				},
+			},
+			"scheduled": schema.BoolAttribute{
+				MarkdownDescription: "Whether campaigns are currently generated from the template on a schedule.",
+				Computed:            true,
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "The date and time the campaign template was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time the campaign template was last modified.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the type-specific campaign settings match the campaign type.
+func (r *campaignTemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config campaignTemplateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Campaign == nil || config.Campaign.Type.IsUnknown() {
+		return
+	}
+
+	campaignType := config.Campaign.Type.ValueString()
+	settings := []struct {
+		attribute    string
+		campaignType string
+		configured   bool
+	}{
+		{"source_owner", client.CampaignTypeSourceOwner, config.Campaign.SourceOwner != nil},
+		{"search", client.CampaignTypeSearch, config.Campaign.Search != nil},
+		{"role_composition", client.CampaignTypeRoleComposition, config.Campaign.RoleComposition != nil},
+	}
+	for _, s := range settings {
+		switch {
+		case s.campaignType == campaignType && !s.configured:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("campaign").AtName(s.attribute),
+				"Missing Campaign Settings",
+				fmt.Sprintf("`campaign.%s` is required when `campaign.type` is %q.", s.attribute, s.campaignType),
+			)
+		case s.campaignType != campaignType && s.configured:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("campaign").AtName(s.attribute),
+				"Unexpected Campaign Settings",
+				fmt.Sprintf("`campaign.%s` is only supported when `campaign.type` is %q.", s.attribute, s.campaignType),
+			)
+		}
+	}
+
+	if search := config.Campaign.Search; search != nil && !search.Query.IsNull() && !search.IdentityIDs.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("campaign").AtName("search").AtName("identity_ids"),
+			"Conflicting Search Campaign Settings",
+			"Only one of `query` and `identity_ids` can be set.",
+		)
+	}
+	if roles := config.Campaign.RoleComposition; roles != nil && !roles.Query.IsNull() && !roles.RoleIDs.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("campaign").AtName("role_composition").AtName("role_ids"),
+			"Conflicting Role Composition Campaign Settings",
+			"Only one of `query` and `role_ids` can be set.",
+		)
+	}
+}
+
+func (r *campaignTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan campaignTemplateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReq, diags := plan.ToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating campaign template", map[string]any{"name": plan.Name.ValueString()})
+	apiResp, err := r.client.CreateCampaignTemplate(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SailPoint Certification Campaign Template",
+			fmt.Sprintf("Could not create SailPoint Certification Campaign Template %q: %s", plan.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Creating SailPoint Certification Campaign Template", "Received nil response from SailPoint API")
+		return
+	}
+
+	id := apiResp.ID
+	schedule, diags := r.applySchedule(ctx, id, &plan, nil)
+	if diags.HasError() {
+		// Keep the template in state so the next apply sets the schedule instead of creating a duplicate template.
+		var state campaignTemplateModel
+		resp.Diagnostics.Append(state.FromAPI(ctx, apiResp, nil)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Scheduling the template changes its `scheduled` flag, so read it back.
+	if schedule != nil {
+		apiResp, err = r.client.GetCampaignTemplate(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading SailPoint Certification Campaign Template",
+				fmt.Sprintf("Could not read SailPoint Certification Campaign Template %q after creation: %s", id, err.Error()),
+			)
+			return
+		}
+	}
+
+	var state campaignTemplateModel
+	resp.Diagnostics.Append(state.FromAPI(ctx, apiResp, schedule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Successfully created campaign template", map[string]any{
+		"id":   state.ID.ValueString(),
+		"name": state.Name.ValueString(),
+	})
+}
+
+func (r *campaignTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state campaignTemplateModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	apiResp, err := r.client.GetCampaignTemplate(ctx, id)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Info(ctx, "Campaign template not found, removing from state", map[string]any{"id": id})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Certification Campaign Template",
+			fmt.Sprintf("Could not read SailPoint Certification Campaign Template %q: %s", id, err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Reading SailPoint Certification Campaign Template", "Received nil response from SailPoint API")
+		return
+	}
+
+	schedule, diags := r.readSchedule(ctx, id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(state.FromAPI(ctx, apiResp, schedule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *campaignTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan campaignTemplateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state campaignTemplateModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	ops, diags := plan.ToPatchOperations(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(ops) > 0 {
+		if _, err := r.client.PatchCampaignTemplate(ctx, id, ops); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating SailPoint Certification Campaign Template",
+				fmt.Sprintf("Could not update SailPoint Certification Campaign Template %q: %s", id, err.Error()),
+			)
+			return
+		}
+	}
+
+	schedule, diags := r.applySchedule(ctx, id, &plan, state.Schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.GetCampaignTemplate(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Certification Campaign Template",
+			fmt.Sprintf("Could not read SailPoint Certification Campaign Template %q after update: %s", id, err.Error()),
+		)
+		return
+	}
+
+	var newState campaignTemplateModel
+	resp.Diagnostics.Append(newState.FromAPI(ctx, apiResp, schedule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	tflog.Info(ctx, "Successfully updated campaign template", map[string]any{
+		"id":   newState.ID.ValueString(),
+		"name": newState.Name.ValueString(),
+	})
+}
+
+func (r *campaignTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state campaignTemplateModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := r.client.DeleteCampaignTemplate(ctx, id); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SailPoint Certification Campaign Template",
+			fmt.Sprintf("Could not delete SailPoint Certification Campaign Template %q: %s", id, err.Error()),
+		)
+		return
+	}
+	tflog.Info(ctx, "Successfully deleted campaign template", map[string]any{"id": id})
+}
+
+func (r *campaignTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applySchedule brings the template's schedule in line with the plan: it is set when configured
+// and deleted when it was removed. It returns the resulting schedule, if any.
+func (r *campaignTemplateResource) applySchedule(ctx context.Context, id string, plan *campaignTemplateModel, prior *common.ScheduleModel) (*client.ScheduleAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	if plan.Schedule == nil {
+		if prior != nil {
+			if err := r.client.DeleteCampaignTemplateSchedule(ctx, id); err != nil {
+				diagnostics.AddError(
+					"Error Deleting SailPoint Certification Campaign Template Schedule",
+					fmt.Sprintf("Could not delete the schedule of SailPoint Certification Campaign Template %q: %s", id, err.Error()),
+				)
+			}
+		}
+		return nil, diagnostics
+	}
+
+	scheduleReq, diags := plan.Schedule.ToAPI(ctx)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	if err := r.client.SetCampaignTemplateSchedule(ctx, id, &scheduleReq); err != nil {
+		diagnostics.AddError(
+			"Error Setting SailPoint Certification Campaign Template Schedule",
+			fmt.Sprintf("Could not set the schedule of SailPoint Certification Campaign Template %q: %s", id, err.Error()),
+		)
+		return nil, diagnostics
+	}
+
+	schedule, diags := r.readSchedule(ctx, id)
+	diagnostics.Append(diags...)
+	return schedule, diagnostics
+}
+
+// readSchedule fetches the template's schedule, returning nil when the template is not scheduled.
+func (r *campaignTemplateResource) readSchedule(ctx context.Context, id string) (*client.ScheduleAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	schedule, err := r.client.GetCampaignTemplateSchedule(ctx, id)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return nil, diagnostics
+		}
+		diagnostics.AddError(
+			"Error Reading SailPoint Certification Campaign Template Schedule",
+			fmt.Sprintf("Could not read the schedule of SailPoint Certification Campaign Template %q: %s", id, err.Error()),
+		)
+		return nil, diagnostics
+	}
+	return schedule, diagnostics
+}
@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connector_rule
+
+import (
+	"context"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// connectorRuleModel represents the Terraform state for a SailPoint connector rule.
+type connectorRuleModel struct {
+	ID          types.String                  `tfsdk:"id"`
+	Name        types.String                  `tfsdk:"name"`
+	Description types.String                  `tfsdk:"description"`
+	Type        types.String                  `tfsdk:"type"`
+	Signature   *connectorRuleSignatureModel  `tfsdk:"signature"`
+	SourceCode  *connectorRuleSourceCodeModel `tfsdk:"source_code"`
+	Attributes  jsontypes.Normalized          `tfsdk:"attributes"`
+	Created     types.String                  `tfsdk:"created"`
+	Modified    types.String                  `tfsdk:"modified"`
+}
+
+// connectorRuleSignatureModel describes the inputs and output of a rule.
+type connectorRuleSignatureModel struct {
+	Input  []connectorRuleArgumentModel `tfsdk:"input"`
+	Output *connectorRuleArgumentModel  `tfsdk:"output"`
+}
+
+// connectorRuleArgumentModel is a typed rule input or output.
+type connectorRuleArgumentModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+}
+
+// connectorRuleSourceCodeModel holds the rule script.
+type connectorRuleSourceCodeModel struct {
+	Version types.String `tfsdk:"version"`
+	Script  types.String `tfsdk:"script"`
+}
+
+// FromAPI maps fields from the API response to the Terraform model.
+func (m *connectorRuleModel) FromAPI(ctx context.Context, api client.ConnectorRuleAPI) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	m.ID = types.StringValue(api.ID)
+	m.Name = types.StringValue(api.Name)
+	m.Type = types.StringValue(api.Type)
+	m.Description = common.StringOrNullIfEmpty(derefString(api.Description))
+	m.Created = common.StringOrNull(api.Created)
+	m.Modified = common.StringOrNull(api.Modified)
+
+	m.SourceCode = &connectorRuleSourceCodeModel{
+		Version: types.StringValue(api.SourceCode.Version),
+		Script:  types.StringValue(api.SourceCode.Script),
+	}
+
+	// The API reports a rule without a signature as an empty one.
+	m.Signature = nil
+	if sig := api.Signature; sig != nil && (len(sig.Input) > 0 || sig.Output != nil) {
+		m.Signature = &connectorRuleSignatureModel{}
+		if len(sig.Input) > 0 {
+			m.Signature.Input = make([]connectorRuleArgumentModel, len(sig.Input))
+			for i, input := range sig.Input {
+				m.Signature.Input[i] = argumentFromAPI(input)
+			}
+		}
+		if sig.Output != nil {
+			output := argumentFromAPI(*sig.Output)
+			m.Signature.Output = &output
+		}
+	}
+
+	if api.Attributes != nil && len(*api.Attributes) > 0 {
+		var diags diag.Diagnostics
+		m.Attributes, diags = common.MarshalJSONOrDefault(*api.Attributes, "{}")
+		diagnostics.Append(diags...)
+	} else {
+		m.Attributes = jsontypes.NewNormalizedNull()
+	}
+
+	return diagnostics
+}
+
+// ToAPI maps fields from the Terraform model to the API create/replace request.
+func (m *connectorRuleModel) ToAPI(ctx context.Context) (client.ConnectorRuleAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	apiRequest := client.ConnectorRuleAPI{
+		Name:        m.Name.ValueString(),
+		Type:        m.Type.ValueString(),
+		Description: stringPointer(m.Description),
+	}
+
+	if m.SourceCode != nil {
+		apiRequest.SourceCode = m.SourceCode.ToAPI()
+	}
+
+	if m.Signature != nil {
+		apiRequest.Signature = &client.ConnectorRuleSignature{
+			Input: make([]client.ConnectorRuleArgument, len(m.Signature.Input)),
+		}
+		for i := range m.Signature.Input {
+			apiRequest.Signature.Input[i] = m.Signature.Input[i].ToAPI()
+		}
+		if m.Signature.Output != nil {
+			output := m.Signature.Output.ToAPI()
+			apiRequest.Signature.Output = &output
+		}
+	}
+
+	if attributes, diags := common.UnmarshalJSONField[map[string]interface{}](m.Attributes); attributes != nil {
+		apiRequest.Attributes = attributes
+		diagnostics.Append(diags...)
+	}
+
+	return apiRequest, diagnostics
+}
+
+// ToAPI maps the source code to the API representation.
+func (m *connectorRuleSourceCodeModel) ToAPI() client.ConnectorRuleSourceCode {
+	return client.ConnectorRuleSourceCode{
+		Version: m.Version.ValueString(),
+		Script:  m.Script.ValueString(),
+	}
+}
+
+// ToAPI maps a rule argument to the API representation.
+func (m *connectorRuleArgumentModel) ToAPI() client.ConnectorRuleArgument {
+	return client.ConnectorRuleArgument{
+		Name:        m.Name.ValueString(),
+		Description: stringPointer(m.Description),
+		Type:        stringPointer(m.Type),
+	}
+}
+
+func argumentFromAPI(api client.ConnectorRuleArgument) connectorRuleArgumentModel {
+	return connectorRuleArgumentModel{
+		Name:        types.StringValue(api.Name),
+		Description: common.StringOrNullIfEmpty(derefString(api.Description)),
+		Type:        common.StringOrNullIfEmpty(derefString(api.Type)),
+	}
+}
+
+// stringPointer converts types.String to *string (null/unknown → nil).
+func stringPointer(s types.String) *string {
+	if s.IsNull() || s.IsUnknown() {
+		return nil
+	}
+	v := s.ValueString()
+	return &v
+}
+
+// derefString dereferences s, returning "" if nil.
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
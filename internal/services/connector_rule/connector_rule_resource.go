@@ -0,0 +1,349 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package connector_rule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &connectorRuleResource{}
+	_ resource.ResourceWithConfigure   = &connectorRuleResource{}
+	_ resource.ResourceWithImportState = &connectorRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &connectorRuleResource{}
+)
+
+type connectorRuleResource struct {
+	client *client.Client
+}
+
+// NewConnectorRuleResource creates a new Connector Rule resource.
+func NewConnectorRuleResource() resource.Resource {
+	return &connectorRuleResource{}
+}
+
+func (r *connectorRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector_rule"
+}
+
+func (r *connectorRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "connector rule resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+// argumentAttributes returns the schema attributes of a rule input or output.
+func argumentAttributes(kind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The name of the %s.", kind),
+			Required:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Description of the %s.", kind),
+			Optional:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The Java type of the %s (e.g., `Map`, `Application`).", kind),
+			Optional:            true,
+		},
+	}
+}
+
+func (r *connectorRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for SailPoint Connector Rule.",
+		MarkdownDescription: "Resource for SailPoint Connector Rule. Connector rules are BeanShell scripts run by cloud connectors on the virtual appliance, " +
+			"referenced by name from source `connector_attributes` (e.g., `beforeProvisioningRule`). " +
+			"The script is checked with the rule validation endpoint at plan time.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the connector rule.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the connector rule, as referenced from source connector attributes.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the connector rule.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the connector rule (e.g., `BuildMap`, `ConnectorBeforeCreate`, `WebServiceBeforeOperationRule`). Changing this forces a new resource.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.ConnectorRuleTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"signature": schema.SingleNestedAttribute{
+				MarkdownDescription: "The inputs the rule receives and the value it returns.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"input": schema.ListNestedAttribute{
+						MarkdownDescription: "The rule inputs.",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: argumentAttributes("input"),
+						},
+					},
+					"output": schema.SingleNestedAttribute{
+						MarkdownDescription: "The rule output.",
+						Optional:            true,
+						Attributes:          argumentAttributes("output"),
+					},
+				},
+			},
+			"source_code": schema.SingleNestedAttribute{
+				MarkdownDescription: "The rule script.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"version": schema.StringAttribute{
+						MarkdownDescription: "The version of the script language. Defaults to `1.0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("1.0"),
+					},
+					"script": schema.StringAttribute{
+						MarkdownDescription: "The BeanShell script. Typically loaded with `file()`.",
+						Required:            true,
+					},
+				},
+			},
+			"attributes": schema.StringAttribute{
+				MarkdownDescription: "Additional attributes of the rule as a JSON string.",
+				Optional:            true,
+				CustomType:          jsontypes.NormalizedType{},
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "The date and time the connector rule was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time the connector rule was last modified.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ModifyPlan validates the rule script with the SailPoint rule validation endpoint whenever it changes,
+// so syntax errors and disallowed constructs are reported by terraform plan rather than mid-apply.
+func (r *connectorRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var planned, prior *connectorRuleSourceCodeModel
+	sourceCodePath := path.Root("source_code")
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, sourceCodePath, &planned)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, sourceCodePath, &prior)...)
+	}
+	if resp.Diagnostics.HasError() || planned == nil {
+		return
+	}
+
+	// The script may come from another resource and only be known at apply time.
+	if planned.Script.IsUnknown() || planned.Version.IsUnknown() {
+		return
+	}
+	if prior != nil && planned.Script.Equal(prior.Script) && planned.Version.Equal(prior.Version) {
+		return
+	}
+
+	sourceCode := planned.ToAPI()
+	result, err := r.client.ValidateConnectorRule(ctx, &sourceCode)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			sourceCodePath.AtName("script"),
+			"Could Not Validate SailPoint Connector Rule",
+			fmt.Sprintf("The rule script could not be validated at plan time and will be checked when applied: %s", err.Error()),
+		)
+		return
+	}
+	if result == nil || result.State == client.ConnectorRuleValidationStateOK {
+		return
+	}
+
+	problems := make([]string, 0, len(result.Details))
+	for _, detail := range result.Details {
+		problems = append(problems, fmt.Sprintf("  - line %d, column %d: %s", detail.Line, detail.Column, detail.Message))
+	}
+	resp.Diagnostics.AddAttributeError(
+		sourceCodePath.AtName("script"),
+		"Invalid SailPoint Connector Rule Script",
+		fmt.Sprintf("SailPoint rejected the rule script (state %q):\n%s", result.State, strings.Join(problems, "\n")),
+	)
+}
+
+func (r *connectorRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan connectorRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReq, diags := plan.ToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating connector rule", map[string]any{"name": plan.Name.ValueString()})
+	apiResp, err := r.client.CreateConnectorRule(ctx, &apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SailPoint Connector Rule",
+			fmt.Sprintf("Could not create SailPoint Connector Rule %q: %s", plan.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Creating SailPoint Connector Rule", "Received nil response from SailPoint API")
+		return
+	}
+
+	var state connectorRuleModel
+	resp.Diagnostics.Append(state.FromAPI(ctx, *apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Successfully created connector rule", map[string]any{
+		"id":   state.ID.ValueString(),
+		"name": state.Name.ValueString(),
+	})
+}
+
+func (r *connectorRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state connectorRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	apiResp, err := r.client.GetConnectorRule(ctx, id)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Info(ctx, "Connector rule not found, removing from state", map[string]any{"id": id})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Connector Rule",
+			fmt.Sprintf("Could not read SailPoint Connector Rule %q: %s", id, err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Reading SailPoint Connector Rule", "Received nil response from SailPoint API")
+		return
+	}
+
+	resp.Diagnostics.Append(state.FromAPI(ctx, *apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *connectorRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan connectorRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state connectorRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	apiReq, diags := plan.ToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.UpdateConnectorRule(ctx, id, &apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SailPoint Connector Rule",
+			fmt.Sprintf("Could not update SailPoint Connector Rule %q: %s", id, err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Updating SailPoint Connector Rule", "Received nil response from SailPoint API")
+		return
+	}
+
+	var newState connectorRuleModel
+	resp.Diagnostics.Append(newState.FromAPI(ctx, *apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	tflog.Info(ctx, "Successfully updated connector rule", map[string]any{
+		"id":   newState.ID.ValueString(),
+		"name": newState.Name.ValueString(),
+	})
+}
+
+func (r *connectorRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state connectorRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := r.client.DeleteConnectorRule(ctx, id); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SailPoint Connector Rule",
+			fmt.Sprintf("Could not delete SailPoint Connector Rule %q: %s", id, err.Error()),
+		)
+		return
+	}
+	tflog.Info(ctx, "Successfully deleted connector rule", map[string]any{"id": id})
+}
+
+func (r *connectorRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -32,6 +32,12 @@ type entitlementModel struct {
 	Modified               types.String           `tfsdk:"modified"`
 }
 
+// entitlementResourceModel adds the tag attributes, which only the resource has, to entitlementModel.
+type entitlementResourceModel struct {
+	entitlementModel
+	common.TagsModel
+}
+
 // FromAPI maps the API response into the Terraform state.
 func (m *entitlementModel) FromAPI(ctx context.Context, api *client.EntitlementAPI) diag.Diagnostics {
 	var diagnostics diag.Diagnostics
@@ -10,11 +10,13 @@ import (
 
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -23,6 +25,7 @@ var (
 	_ resource.Resource                = &entitlementResource{}
 	_ resource.ResourceWithConfigure   = &entitlementResource{}
 	_ resource.ResourceWithImportState = &entitlementResource{}
+	_ resource.ResourceWithModifyPlan  = &entitlementResource{}
 )
 
 type entitlementResource struct {
@@ -106,6 +109,9 @@ func (r *entitlementResource) Schema(_ context.Context, _ resource.SchemaRequest
 					"type": schema.StringAttribute{
 						MarkdownDescription: "Owner type. Must be `IDENTITY`.",
 						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.OwnerTypes...),
+						},
 					},
 					"id": schema.StringAttribute{
 						MarkdownDescription: "Identity ID of the owner.",
@@ -148,14 +154,20 @@ func (r *entitlementResource) Schema(_ context.Context, _ resource.SchemaRequest
 				MarkdownDescription: "When the entitlement was last modified.",
 				Computed:            true,
 			},
+			"tags":     common.TagsAttribute(),
+			"tags_all": common.TagsAllAttribute(),
 		},
 	}
 }
 
+func (r *entitlementResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ModifyPlanTags(ctx, r.client, req, resp)
+}
+
 // Create adopts an existing entitlement by ID. The entitlement must already exist in ISC —
 // entitlements are managed via source aggregation, not via Terraform.
 func (r *entitlementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan entitlementModel
+	var plan entitlementResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -219,7 +231,9 @@ func (r *entitlementResource) Create(ctx context.Context, req resource.CreateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tags, diags := plan.TagsModel.Apply(ctx, r.client, client.ObjectRefTypeEntitlement, id, nil)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &entitlementResourceModel{entitlementModel: state, TagsModel: tags})...)
 	tflog.Info(ctx, "Successfully adopted entitlement", map[string]any{
 		"id":      state.ID.ValueString(),
 		"name":    state.Name.ValueString(),
@@ -228,7 +242,7 @@ func (r *entitlementResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *entitlementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state entitlementModel
+	var state entitlementResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -254,6 +268,7 @@ func (r *entitlementResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	resp.Diagnostics.Append(state.FromAPI(ctx, apiResp)...)
+	resp.Diagnostics.Append(state.TagsModel.Read(ctx, r.client, client.ObjectRefTypeEntitlement, id)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -261,19 +276,25 @@ func (r *entitlementResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *entitlementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan entitlementModel
+	var plan entitlementResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var state entitlementModel
+	var state entitlementResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	id := state.ID.ValueString()
-	ops, diags := plan.ToPatchOperations(ctx, &state)
+	ops, diags := plan.ToPatchOperations(ctx, &state.entitlementModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, diags := plan.TagsModel.Apply(ctx, r.client, client.ObjectRefTypeEntitlement, id, &state.TagsModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -297,18 +318,28 @@ func (r *entitlementResource) Update(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &entitlementResourceModel{entitlementModel: newState, TagsModel: tags})...)
 	tflog.Info(ctx, "Successfully updated entitlement", map[string]any{
 		"id":      newState.ID.ValueString(),
 		"patches": len(ops),
 	})
 }
 
-// Delete is a no-op for entitlements — they are managed by source aggregation
-// and cannot be removed via the API. Terraform state tracking is dropped, but
+// Delete only removes the tags managed by the resource — entitlements are managed by source
+// aggregation and cannot be removed via the API. Terraform state tracking is dropped, but
 // the entitlement persists in ISC.
-func (r *entitlementResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Intentional no-op.
+func (r *entitlementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state entitlementResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(state.TagsModel.Remove(ctx, r.client, client.ObjectRefTypeEntitlement, state.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.AddWarning(
 		"Entitlement not deleted from SailPoint ISC",
 		"Entitlements are managed by source aggregation and cannot be deleted via the API. "+
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package event_trigger
+
+import (
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// eventTriggerSubscriptionModel represents the Terraform state for the Event Trigger Subscription resource.
+type eventTriggerSubscriptionModel struct {
+	ID                types.String            `tfsdk:"id"`
+	Name              types.String            `tfsdk:"name"`
+	Description       types.String            `tfsdk:"description"`
+	TriggerID         types.String            `tfsdk:"trigger_id"`
+	TriggerName       types.String            `tfsdk:"trigger_name"`
+	Type              types.String            `tfsdk:"type"`
+	ResponseDeadline  types.String            `tfsdk:"response_deadline"`
+	Enabled           types.Bool              `tfsdk:"enabled"`
+	Filter            types.String            `tfsdk:"filter"`
+	HTTPConfig        *httpConfigModel        `tfsdk:"http_config"`
+	EventBridgeConfig *eventBridgeConfigModel `tfsdk:"eventbridge_config"`
+}
+
+// httpConfigModel configures delivery to an HTTP endpoint.
+type httpConfigModel struct {
+	URL                    types.String                `tfsdk:"url"`
+	HTTPDispatchMode       types.String                `tfsdk:"http_dispatch_mode"`
+	HTTPAuthenticationType types.String                `tfsdk:"http_authentication_type"`
+	BasicAuthConfig        *basicAuthConfigModel       `tfsdk:"basic_auth_config"`
+	BearerTokenAuthConfig  *bearerTokenAuthConfigModel `tfsdk:"bearer_token_auth_config"`
+}
+
+// basicAuthConfigModel holds BASIC_AUTH credentials. The password is write-only: it is read from
+// the configuration and never stored, and PasswordWOVersion is bumped to send a new one.
+type basicAuthConfigModel struct {
+	UserName          types.String `tfsdk:"user_name"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+// bearerTokenAuthConfigModel holds a BEARER_TOKEN token, write-only like basicAuthConfigModel.PasswordWO.
+type bearerTokenAuthConfigModel struct {
+	BearerTokenWO        types.String `tfsdk:"bearer_token_wo"`
+	BearerTokenWOVersion types.Int64  `tfsdk:"bearer_token_wo_version"`
+}
+
+// eventBridgeConfigModel configures delivery to an AWS EventBridge partner event source.
+type eventBridgeConfigModel struct {
+	AWSAccount types.String `tfsdk:"aws_account"`
+	AWSRegion  types.String `tfsdk:"aws_region"`
+}
+
+// FromAPI maps the API response into the Terraform state. The API never returns secrets, so the
+// write-only attributes stay null and their versions, like the bearer token block, come from prior.
+func (m *eventTriggerSubscriptionModel) FromAPI(api *client.TriggerSubscriptionAPI, prior *eventTriggerSubscriptionModel) {
+	m.ID = types.StringValue(api.ID)
+	m.Name = types.StringValue(api.Name)
+	m.Description = types.StringNull()
+	if api.Description != nil {
+		m.Description = common.StringOrNullIfEmpty(*api.Description)
+	}
+	m.TriggerID = types.StringValue(api.TriggerID)
+	m.TriggerName = common.StringOrNull(api.TriggerName)
+	m.Type = types.StringValue(api.Type)
+	m.ResponseDeadline = common.StringOrNull(api.ResponseDeadline)
+	m.Enabled = types.BoolValue(api.Enabled)
+	m.Filter = common.StringOrNull(api.Filter)
+
+	var priorHTTP *httpConfigModel
+	if prior != nil {
+		priorHTTP = prior.HTTPConfig
+	}
+
+	m.HTTPConfig = nil
+	if api.HTTPConfig != nil {
+		m.HTTPConfig = &httpConfigModel{
+			URL:                    types.StringValue(api.HTTPConfig.URL),
+			HTTPDispatchMode:       types.StringValue(api.HTTPConfig.HTTPDispatchMode),
+			HTTPAuthenticationType: types.StringValue(api.HTTPConfig.HTTPAuthenticationType),
+		}
+		switch api.HTTPConfig.HTTPAuthenticationType {
+		case client.TriggerHTTPAuthenticationBasic:
+			basic := &basicAuthConfigModel{
+				UserName:          types.StringNull(),
+				PasswordWO:        types.StringNull(),
+				PasswordWOVersion: types.Int64Null(),
+			}
+			if api.HTTPConfig.BasicAuthConfig != nil {
+				basic.UserName = types.StringValue(api.HTTPConfig.BasicAuthConfig.UserName)
+			}
+			if priorHTTP != nil && priorHTTP.BasicAuthConfig != nil {
+				basic.PasswordWOVersion = priorHTTP.BasicAuthConfig.PasswordWOVersion
+				if basic.UserName.IsNull() {
+					basic.UserName = priorHTTP.BasicAuthConfig.UserName
+				}
+			}
+			m.HTTPConfig.BasicAuthConfig = basic
+		case client.TriggerHTTPAuthenticationBearer:
+			bearer := &bearerTokenAuthConfigModel{
+				BearerTokenWO:        types.StringNull(),
+				BearerTokenWOVersion: types.Int64Null(),
+			}
+			if priorHTTP != nil && priorHTTP.BearerTokenAuthConfig != nil {
+				bearer.BearerTokenWOVersion = priorHTTP.BearerTokenAuthConfig.BearerTokenWOVersion
+			}
+			m.HTTPConfig.BearerTokenAuthConfig = bearer
+		}
+	}
+
+	m.EventBridgeConfig = nil
+	if api.EventBridgeConfig != nil {
+		m.EventBridgeConfig = &eventBridgeConfigModel{
+			AWSAccount: types.StringValue(api.EventBridgeConfig.AWSAccount),
+			AWSRegion:  types.StringValue(api.EventBridgeConfig.AWSRegion),
+		}
+	}
+}
+
+// ToAPI converts the plan into the API request. Write-only values are not part of the plan, so the
+// secrets are taken from config.
+func (m *eventTriggerSubscriptionModel) ToAPI(config *eventTriggerSubscriptionModel) *client.TriggerSubscriptionAPI {
+	api := &client.TriggerSubscriptionAPI{
+		Name:             m.Name.ValueString(),
+		Description:      stringPointer(m.Description),
+		TriggerID:        m.TriggerID.ValueString(),
+		Type:             m.Type.ValueString(),
+		ResponseDeadline: stringPointer(m.ResponseDeadline),
+		Enabled:          m.Enabled.ValueBool(),
+		Filter:           stringPointer(m.Filter),
+	}
+
+	if m.HTTPConfig != nil {
+		api.HTTPConfig = &client.TriggerHTTPConfigAPI{
+			URL:                    m.HTTPConfig.URL.ValueString(),
+			HTTPDispatchMode:       m.HTTPConfig.HTTPDispatchMode.ValueString(),
+			HTTPAuthenticationType: m.HTTPConfig.HTTPAuthenticationType.ValueString(),
+		}
+		var configHTTP *httpConfigModel
+		if config != nil {
+			configHTTP = config.HTTPConfig
+		}
+		if basic := m.HTTPConfig.BasicAuthConfig; basic != nil {
+			api.HTTPConfig.BasicAuthConfig = &client.BasicAuthConfigAPI{UserName: basic.UserName.ValueString()}
+			if configHTTP != nil && configHTTP.BasicAuthConfig != nil {
+				api.HTTPConfig.BasicAuthConfig.Password = stringPointer(configHTTP.BasicAuthConfig.PasswordWO)
+			}
+		}
+		if m.HTTPConfig.BearerTokenAuthConfig != nil {
+			api.HTTPConfig.BearerTokenAuthConfig = &client.BearerTokenConfigAPI{}
+			if configHTTP != nil && configHTTP.BearerTokenAuthConfig != nil {
+				api.HTTPConfig.BearerTokenAuthConfig.BearerToken = stringPointer(configHTTP.BearerTokenAuthConfig.BearerTokenWO)
+			}
+		}
+	}
+
+	if m.EventBridgeConfig != nil {
+		api.EventBridgeConfig = &client.EventBridgeConfigAPI{
+			AWSAccount: m.EventBridgeConfig.AWSAccount.ValueString(),
+			AWSRegion:  m.EventBridgeConfig.AWSRegion.ValueString(),
+		}
+	}
+
+	return api
+}
+
+// stringPointer converts types.String to *string (null/unknown → nil).
+func stringPointer(v types.String) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	s := v.ValueString()
+	return &s
+}
@@ -0,0 +1,395 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package event_trigger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &eventTriggerSubscriptionResource{}
+	_ resource.ResourceWithConfigure      = &eventTriggerSubscriptionResource{}
+	_ resource.ResourceWithImportState    = &eventTriggerSubscriptionResource{}
+	_ resource.ResourceWithValidateConfig = &eventTriggerSubscriptionResource{}
+)
+
+var (
+	// responseDeadlinePattern matches the ISO 8601 durations accepted as a response deadline (e.g., PT1H).
+	responseDeadlinePattern = regexp.MustCompile(`^P(\d+D)?(T(\d+H)?(\d+M)?(\d+S)?)?$`)
+	awsAccountPattern       = regexp.MustCompile(`^\d{12}$`)
+	awsRegionPattern        = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+)
+
+type eventTriggerSubscriptionResource struct {
+	client *client.Client
+}
+
+// NewEventTriggerSubscriptionResource creates a new Event Trigger Subscription resource.
+func NewEventTriggerSubscriptionResource() resource.Resource {
+	return &eventTriggerSubscriptionResource{}
+}
+
+func (r *eventTriggerSubscriptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_trigger_subscription"
+}
+
+func (r *eventTriggerSubscriptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "event trigger subscription resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+func (r *eventTriggerSubscriptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for a SailPoint event trigger subscription.",
+		MarkdownDescription: "Resource for a SailPoint event trigger subscription, which delivers the events of a trigger " +
+			"(e.g., `idn:identity-attributes-changed`) to an HTTP endpoint or to AWS EventBridge. " +
+			"List the available triggers with the `sailpoint_event_triggers` data source. " +
+			"Workflows subscribe to triggers through `sailpoint_workflow_trigger` instead. " +
+			"The HTTP credentials are write-only attributes, which require Terraform 1.11 or later.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the subscription.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the subscription.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the subscription.",
+				Optional:            true,
+			},
+			"trigger_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the trigger to subscribe to (e.g., `idn:account-aggregation-completed`). Changing this forces a new resource.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trigger_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the trigger.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The delivery type: `HTTP` (requires `http_config`) or `EVENTBRIDGE` (requires `eventbridge_config`).",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.TriggerSubscriptionTypes...),
+				},
+			},
+			"response_deadline": schema.StringAttribute{
+				MarkdownDescription: "How long SailPoint waits for the response of a `REQUEST_RESPONSE` trigger, as an ISO 8601 duration. Defaults to `PT1H`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("PT1H"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(responseDeadlinePattern, "must be an ISO 8601 duration (e.g., PT1H)"),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether events are delivered. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "A JSONPath expression selecting the events to deliver (e.g., `$[?($.identity.name == \"john.doe\")]`). " +
+					"When omitted, every event of the trigger is delivered.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\$`), "must be a JSONPath expression starting with `$`"),
+				},
+			},
+			"http_config": schema.SingleNestedAttribute{
+				MarkdownDescription: "Delivery to an HTTP endpoint. Required when `type` is `HTTP`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "The URL events are posted to.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an http:// or https:// URL"),
+						},
+					},
+					"http_dispatch_mode": schema.StringAttribute{
+						MarkdownDescription: "How events are dispatched: `" + strings.Join(client.TriggerHTTPDispatchModes, "`, `") + "`. Defaults to `SYNC`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("SYNC"),
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.TriggerHTTPDispatchModes...),
+						},
+					},
+					"http_authentication_type": schema.StringAttribute{
+						MarkdownDescription: "How SailPoint authenticates to the endpoint: `NO_AUTH`, `BASIC_AUTH` (requires `basic_auth_config`) " +
+							"or `BEARER_TOKEN` (requires `bearer_token_auth_config`). Defaults to `NO_AUTH`.",
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString(client.TriggerHTTPAuthenticationNone),
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.TriggerHTTPAuthenticationTypes...),
+						},
+					},
+					"basic_auth_config": schema.SingleNestedAttribute{
+						MarkdownDescription: "Credentials for `BASIC_AUTH`.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"user_name": schema.StringAttribute{
+								MarkdownDescription: "The user name.",
+								Required:            true,
+							},
+							"password_wo": schema.StringAttribute{
+								MarkdownDescription: "The password. Write-only: it is sent to SailPoint but never stored in the state. " +
+									"Change `password_wo_version` to send a new password.",
+								Required:  true,
+								WriteOnly: true,
+								Sensitive: true,
+							},
+							"password_wo_version": schema.Int64Attribute{
+								MarkdownDescription: "A version number to change whenever `password_wo` changes, so the new password is sent.",
+								Optional:            true,
+							},
+						},
+					},
+					"bearer_token_auth_config": schema.SingleNestedAttribute{
+						MarkdownDescription: "Token for `BEARER_TOKEN`.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"bearer_token_wo": schema.StringAttribute{
+								MarkdownDescription: "The bearer token. Write-only: it is sent to SailPoint but never stored in the state. " +
+									"Change `bearer_token_wo_version` to send a new token.",
+								Required:  true,
+								WriteOnly: true,
+								Sensitive: true,
+							},
+							"bearer_token_wo_version": schema.Int64Attribute{
+								MarkdownDescription: "A version number to change whenever `bearer_token_wo` changes, so the new token is sent.",
+								Optional:            true,
+							},
+						},
+					},
+				},
+			},
+			"eventbridge_config": schema.SingleNestedAttribute{
+				MarkdownDescription: "Delivery to an AWS EventBridge partner event source. Required when `type` is `EVENTBRIDGE`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"aws_account": schema.StringAttribute{
+						MarkdownDescription: "The 12-digit ID of the AWS account receiving the events.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(awsAccountPattern, "must be a 12-digit AWS account ID"),
+						},
+					},
+					"aws_region": schema.StringAttribute{
+						MarkdownDescription: "The AWS region of the partner event source (e.g., `us-east-1`).",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(awsRegionPattern, "must be an AWS region (e.g., us-east-1)"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the delivery settings match type and http_authentication_type.
+func (r *eventTriggerSubscriptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config eventTriggerSubscriptionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Type.IsNull() && !config.Type.IsUnknown() {
+		subscriptionType := config.Type.ValueString()
+		switch {
+		case subscriptionType == client.TriggerSubscriptionTypeHTTP && config.HTTPConfig == nil:
+			resp.Diagnostics.AddAttributeError(path.Root("http_config"), "Missing HTTP Config",
+				fmt.Sprintf("`http_config` is required when `type` is %q.", subscriptionType))
+		case subscriptionType == client.TriggerSubscriptionTypeEventBridge && config.EventBridgeConfig == nil:
+			resp.Diagnostics.AddAttributeError(path.Root("eventbridge_config"), "Missing EventBridge Config",
+				fmt.Sprintf("`eventbridge_config` is required when `type` is %q.", subscriptionType))
+		}
+		if subscriptionType != client.TriggerSubscriptionTypeHTTP && config.HTTPConfig != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("http_config"), "Unexpected HTTP Config",
+				fmt.Sprintf("`http_config` is only used when `type` is %q.", client.TriggerSubscriptionTypeHTTP))
+		}
+		if subscriptionType != client.TriggerSubscriptionTypeEventBridge && config.EventBridgeConfig != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("eventbridge_config"), "Unexpected EventBridge Config",
+				fmt.Sprintf("`eventbridge_config` is only used when `type` is %q.", client.TriggerSubscriptionTypeEventBridge))
+		}
+	}
+
+	httpConfig := config.HTTPConfig
+	if httpConfig == nil || httpConfig.HTTPAuthenticationType.IsUnknown() {
+		return
+	}
+	authType := httpConfig.HTTPAuthenticationType.ValueString()
+	if httpConfig.HTTPAuthenticationType.IsNull() {
+		authType = client.TriggerHTTPAuthenticationNone
+	}
+	authPath := path.Root("http_config").AtName("http_authentication_type")
+	if authType == client.TriggerHTTPAuthenticationBasic && httpConfig.BasicAuthConfig == nil {
+		resp.Diagnostics.AddAttributeError(authPath, "Missing Basic Auth Config",
+			fmt.Sprintf("`http_config.basic_auth_config` is required when `http_authentication_type` is %q.", authType))
+	}
+	if authType == client.TriggerHTTPAuthenticationBearer && httpConfig.BearerTokenAuthConfig == nil {
+		resp.Diagnostics.AddAttributeError(authPath, "Missing Bearer Token Auth Config",
+			fmt.Sprintf("`http_config.bearer_token_auth_config` is required when `http_authentication_type` is %q.", authType))
+	}
+	if authType != client.TriggerHTTPAuthenticationBasic && httpConfig.BasicAuthConfig != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("http_config").AtName("basic_auth_config"), "Unexpected Basic Auth Config",
+			fmt.Sprintf("`basic_auth_config` is only used when `http_authentication_type` is %q.", client.TriggerHTTPAuthenticationBasic))
+	}
+	if authType != client.TriggerHTTPAuthenticationBearer && httpConfig.BearerTokenAuthConfig != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("http_config").AtName("bearer_token_auth_config"), "Unexpected Bearer Token Auth Config",
+			fmt.Sprintf("`bearer_token_auth_config` is only used when `http_authentication_type` is %q.", client.TriggerHTTPAuthenticationBearer))
+	}
+}
+
+func (r *eventTriggerSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config eventTriggerSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.CreateTriggerSubscription(ctx, plan.ToAPI(&config))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SailPoint Event Trigger Subscription",
+			fmt.Sprintf("Could not create SailPoint Event Trigger Subscription %q: %s", plan.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Creating SailPoint Event Trigger Subscription", "Received nil response from SailPoint API")
+		return
+	}
+
+	var state eventTriggerSubscriptionModel
+	state.FromAPI(apiResp, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Successfully created event trigger subscription", map[string]any{
+		"id":   state.ID.ValueString(),
+		"name": state.Name.ValueString(),
+	})
+}
+
+func (r *eventTriggerSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state eventTriggerSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	apiResp, err := r.client.GetTriggerSubscription(ctx, id)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Info(ctx, "Event trigger subscription not found, removing from state", map[string]any{"id": id})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Event Trigger Subscription",
+			fmt.Sprintf("Could not read SailPoint Event Trigger Subscription %q: %s", id, err.Error()),
+		)
+		return
+	}
+
+	var newState eventTriggerSubscriptionModel
+	newState.FromAPI(apiResp, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *eventTriggerSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config eventTriggerSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// PUT replaces the whole subscription, so the credentials are sent again on every update.
+	id := plan.ID.ValueString()
+	apiResp, err := r.client.UpdateTriggerSubscription(ctx, id, plan.ToAPI(&config))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SailPoint Event Trigger Subscription",
+			fmt.Sprintf("Could not update SailPoint Event Trigger Subscription %q: %s", id, err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Updating SailPoint Event Trigger Subscription", "Received nil response from SailPoint API")
+		return
+	}
+
+	var newState eventTriggerSubscriptionModel
+	newState.FromAPI(apiResp, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	tflog.Info(ctx, "Successfully updated event trigger subscription", map[string]any{
+		"id":   newState.ID.ValueString(),
+		"name": newState.Name.ValueString(),
+	})
+}
+
+func (r *eventTriggerSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state eventTriggerSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := r.client.DeleteTriggerSubscription(ctx, id); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SailPoint Event Trigger Subscription",
+			fmt.Sprintf("Could not delete SailPoint Event Trigger Subscription %q: %s", id, err.Error()),
+		)
+		return
+	}
+	tflog.Info(ctx, "Successfully deleted event trigger subscription", map[string]any{"id": id})
+}
+
+func (r *eventTriggerSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
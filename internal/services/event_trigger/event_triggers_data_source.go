@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package event_trigger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &eventTriggersDataSource{}
+	_ datasource.DataSourceWithConfigure = &eventTriggersDataSource{}
+)
+
+type eventTriggersDataSource struct {
+	client *client.Client
+}
+
+// eventTriggersDataSourceModel represents the Terraform state for the Event Triggers data source.
+type eventTriggersDataSourceModel struct {
+	Triggers []eventTriggerModel `tfsdk:"triggers"`
+}
+
+// eventTriggerModel represents an event trigger and the payloads it exchanges with subscribers.
+type eventTriggerModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Type          types.String `tfsdk:"type"`
+	InputSchema   types.String `tfsdk:"input_schema"`
+	ExampleInput  types.String `tfsdk:"example_input"`
+	OutputSchema  types.String `tfsdk:"output_schema"`
+	ExampleOutput types.String `tfsdk:"example_output"`
+}
+
+// NewEventTriggersDataSource creates a new data source for SailPoint Event Triggers.
+func NewEventTriggersDataSource() datasource.DataSource {
+	return &eventTriggersDataSource{}
+}
+
+func (d *eventTriggersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_triggers"
+}
+
+func (d *eventTriggersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "event triggers data source")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = c
+}
+
+func (d *eventTriggersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for SailPoint Event Triggers.",
+		MarkdownDescription: "Data source for SailPoint Event Triggers. Lists the triggers available in the tenant, " +
+			"with the schema of the payload each one sends, so `sailpoint_event_trigger_subscription` filters can be written against it.",
+		Attributes: map[string]schema.Attribute{
+			"triggers": schema.ListNestedAttribute{
+				MarkdownDescription: "The available triggers.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the trigger (e.g., `idn:identity-attributes-changed`), used as `trigger_id`.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the trigger.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the trigger.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "`FIRE_AND_FORGET`, or `REQUEST_RESPONSE` when SailPoint waits for the subscriber's response.",
+							Computed:            true,
+						},
+						"input_schema": schema.StringAttribute{
+							MarkdownDescription: "The schema of the payload sent to subscribers.",
+							Computed:            true,
+						},
+						"example_input": schema.StringAttribute{
+							MarkdownDescription: "An example payload, as JSON.",
+							Computed:            true,
+						},
+						"output_schema": schema.StringAttribute{
+							MarkdownDescription: "The schema of the response expected from subscribers of `REQUEST_RESPONSE` triggers.",
+							Computed:            true,
+						},
+						"example_output": schema.StringAttribute{
+							MarkdownDescription: "An example response, as JSON.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *eventTriggersDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading event triggers data source")
+
+	triggers, err := d.client.ListEventTriggers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Event Triggers",
+			fmt.Sprintf("Could not list SailPoint Event Triggers: %s", err.Error()),
+		)
+		return
+	}
+
+	state := eventTriggersDataSourceModel{Triggers: make([]eventTriggerModel, 0, len(triggers))}
+	for _, trigger := range triggers {
+		state.Triggers = append(state.Triggers, eventTriggerModel{
+			ID:            types.StringValue(trigger.ID),
+			Name:          types.StringValue(trigger.Name),
+			Description:   common.StringOrNull(trigger.Description),
+			Type:          types.StringValue(trigger.Type),
+			InputSchema:   common.StringOrNull(trigger.InputSchema),
+			ExampleInput:  rawJSONOrNull(trigger.ExampleInput),
+			OutputSchema:  common.StringOrNull(trigger.OutputSchema),
+			ExampleOutput: rawJSONOrNull(trigger.ExampleOutput),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// rawJSONOrNull returns the JSON document as a string, or null when it is absent.
+func rawJSONOrNull(raw []byte) types.String {
+	if len(raw) == 0 || string(raw) == "null" {
+		return types.StringNull()
+	}
+	return types.StringValue(string(raw))
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package form_definition
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &formDefinitionListResource{}
+	_ list.ListResourceWithConfigure = &formDefinitionListResource{}
+)
+
+type formDefinitionListResource struct {
+	client *client.Client
+}
+
+// NewFormDefinitionListResource creates a new list resource for SailPoint Form Definitions.
+func NewFormDefinitionListResource() list.ListResource {
+	return &formDefinitionListResource{}
+}
+
+// Metadata implements list.ListResource.
+func (r *formDefinitionListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_form_definition"
+}
+
+// Configure implements list.ListResourceWithConfigure.
+func (r *formDefinitionListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "form definition list resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+// ListResourceConfigSchema implements list.ListResource.
+func (r *formDefinitionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists SailPoint Form Definitions, to bring existing ones under management with `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"filters": common.ListFiltersAttribute(`name sw "Access"`),
+		},
+	}
+}
+
+// List implements list.ListResource.
+func (r *formDefinitionListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config common.ListFiltersModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := r.client.ListFormDefinitions(ctx, config.Filters.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error Listing SailPoint Form Definitions",
+			fmt.Sprintf("Could not list SailPoint Form Definitions: %s", err.Error()),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, items, func(api client.FormDefinitionAPI, result *list.ListResult) {
+		result.DisplayName = api.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, common.IDIdentityModel{ID: types.StringValue(api.ID)})...)
+		if !req.IncludeResource {
+			return
+		}
+
+		var state formDefinitionModel
+		result.Diagnostics.Append(state.FromAPI(ctx, api)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+	})
+}
@@ -10,12 +10,15 @@ import (
 
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common/validators"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -23,6 +26,7 @@ var (
 	_ resource.Resource                = &formDefinitionResource{}
 	_ resource.ResourceWithConfigure   = &formDefinitionResource{}
 	_ resource.ResourceWithImportState = &formDefinitionResource{}
+	_ resource.ResourceWithIdentity    = &formDefinitionResource{}
 )
 
 type formDefinitionResource struct {
@@ -76,6 +80,9 @@ func (r *formDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequ
 					"type": schema.StringAttribute{
 						MarkdownDescription: "The type of the owner (e.g., IDENTITY).",
 						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.OwnerTypes...),
+						},
 					},
 					"id": schema.StringAttribute{
 						MarkdownDescription: "The unique identifier of the owner.",
@@ -95,6 +102,9 @@ func (r *formDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequ
 						"type": schema.StringAttribute{
 							MarkdownDescription: "The type of the referencing object (WORKFLOW, SOURCE, MySailPoint).",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(client.FormUsedByTypes...),
+							},
 						},
 						"id": schema.StringAttribute{
 							MarkdownDescription: "The unique identifier of the referencing object.",
@@ -122,6 +132,9 @@ func (r *formDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequ
 							MarkdownDescription: "The type of the form input (STRING, ARRAY).",
 							Optional:            true,
 							Computed:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(client.FormInputTypes...),
+							},
 						},
 						"label": schema.StringAttribute{
 							MarkdownDescription: "The label of the form input.",
@@ -138,7 +151,10 @@ func (r *formDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequ
 			"form_elements": schema.StringAttribute{
 				MarkdownDescription: "JSON array of form elements (fields, sections, etc.). Elements must be wrapped in SECTION elements. Each element object has: id, elementType (TEXT, TOGGLE, TEXTAREA, HIDDEN, PHONE, EMAIL, SELECT, DATE, SECTION, COLUMN_SET, IMAGE, DESCRIPTION), config, key, validations. **Important:** Omit fields with zero values (empty strings `\"\"`, empty arrays `[]`, `false`) from the JSON to avoid inconsistent plan errors.",
 				Optional:            true,
-				CustomType:          jsontypes.NormalizedType{},
+				Validators: []validator.String{
+					validators.JSONKeyOneOf("elementType", client.FormElementTypes),
+				},
+				CustomType: jsontypes.NormalizedType{},
 			},
 			"form_conditions": schema.ListNestedAttribute{
 				MarkdownDescription: "List of conditions for the form definition. Conditions control the visibility and behavior of form elements based on form inputs and other conditions.",
@@ -148,6 +164,9 @@ func (r *formDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequ
 						"rule_operator": schema.StringAttribute{
 							MarkdownDescription: "The operator for the condition (AND, OR).",
 							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(client.FormConditionRuleOperators...),
+							},
 						},
 						"rules": schema.ListNestedAttribute{
 							MarkdownDescription: "List of rules for the condition.",
@@ -157,6 +176,9 @@ func (r *formDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequ
 									"source_type": schema.StringAttribute{
 										MarkdownDescription: "The type of the source for the rule (INPUT, ELEMENT).",
 										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(client.FormRuleSourceTypes...),
+										},
 									},
 									"source": schema.StringAttribute{
 										MarkdownDescription: "The source for the rule.",
@@ -165,10 +187,16 @@ func (r *formDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequ
 									"operator": schema.StringAttribute{
 										MarkdownDescription: "The operator for the rule (EQ, NE, CO, NOT_CO, IN, NOT_IN, EM, NOT_EM, SW, NOT_SW, EW, NOT_EW).",
 										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(client.FormRuleOperators...),
+										},
 									},
 									"value_type": schema.StringAttribute{
 										MarkdownDescription: "The type of the value for the rule (STRING, STRING_LIST, INPUT, ELEMENT, LIST, BOOLEAN).",
 										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(client.FormRuleValueTypes...),
+										},
 									},
 									"value": schema.StringAttribute{
 										MarkdownDescription: "The value for the rule.",
@@ -185,6 +213,9 @@ func (r *formDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequ
 									"effect_type": schema.StringAttribute{
 										MarkdownDescription: "The type of the effect (HIDE, SHOW, DISABLE, ENABLE, REQUIRE, OPTIONAL, SUBMIT_MESSAGE, SUBMIT_NOTIFICATION, SET_DEFAULT_VALUE).",
 										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(client.FormEffectTypes...),
+										},
 									},
 									"config": schema.SingleNestedAttribute{
 										MarkdownDescription: "The configuration for the effect.",
@@ -221,6 +252,11 @@ func (r *formDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (r *formDefinitionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IDIdentitySchema()
+}
+
 // Create implements resource.Resource.
 func (r *formDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve the plan
@@ -282,6 +318,7 @@ func (r *formDefinitionResource) Create(ctx context.Context, req resource.Create
 		"id":   state.ID.ValueString(),
 	})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -348,6 +385,7 @@ func (r *formDefinitionResource) Read(ctx context.Context, req resource.ReadRequ
 		"id": state.ID.ValueString(),
 	})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -463,6 +501,7 @@ func (r *formDefinitionResource) Update(ctx context.Context, req resource.Update
 		"id": state.ID.ValueString(),
 	})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: newState.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -478,7 +517,7 @@ func (r *formDefinitionResource) ImportState(ctx context.Context, req resource.I
 		"id": req.ID,
 	})
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 
 	tflog.Info(ctx, "Successfully imported SailPoint Form Definition resource", map[string]any{
 		"id": req.ID,
@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package governance_group
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &governanceGroupDataSource{}
+	_ datasource.DataSourceWithConfigure = &governanceGroupDataSource{}
+)
+
+type governanceGroupDataSource struct {
+	client *client.Client
+}
+
+// NewGovernanceGroupDataSource creates a new data source for SailPoint Governance Group.
+func NewGovernanceGroupDataSource() datasource.DataSource {
+	return &governanceGroupDataSource{}
+}
+
+func (d *governanceGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_governance_group"
+}
+
+func (d *governanceGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "governance group data source")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = c
+}
+
+func (d *governanceGroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Data source for SailPoint Governance Group.",
+		MarkdownDescription: "Data source for SailPoint Governance Group (workgroup). Look up a governance group by name, e.g. to reference it as a `GOVERNANCE_GROUP` owner or approver.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the governance group. Must match exactly one governance group.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the governance group.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the governance group.",
+				Computed:            true,
+			},
+			"owner": schema.SingleNestedAttribute{
+				MarkdownDescription: "The owner of the governance group.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{Computed: true},
+					"id":   schema.StringAttribute{Computed: true},
+					"name": schema.StringAttribute{Computed: true},
+				},
+			},
+			"members": schema.SetAttribute{
+				MarkdownDescription: "IDs of the identities that are members of the governance group.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"member_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of members in the governance group.",
+				Computed:            true,
+			},
+			"connection_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of objects the governance group is connected to.",
+				Computed:            true,
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "The date and time the governance group was created.",
+				Computed:            true,
+			},
+			"modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time the governance group was last modified.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *governanceGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state governanceGroupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	tflog.Debug(ctx, "Reading governance group data source", map[string]any{"name": name})
+
+	filter := fmt.Sprintf("name eq %q", name)
+	groups, err := d.client.ListGovernanceGroups(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Governance Group",
+			fmt.Sprintf("Could not look up SailPoint Governance Group %q: %s", name, err.Error()),
+		)
+		return
+	}
+
+	// The name filter is case-insensitive on the server; keep exact matches only.
+	var matches []client.GovernanceGroupAPI
+	for _, group := range groups {
+		if group.Name == name {
+			matches = append(matches, group)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"SailPoint Governance Group Not Found",
+			fmt.Sprintf("No governance group named %q was found.", name),
+		)
+		return
+	case 1:
+	default:
+		ids := make([]string, len(matches))
+		for i, group := range matches {
+			ids[i] = group.ID
+		}
+		resp.Diagnostics.AddError(
+			"Multiple SailPoint Governance Groups Found",
+			fmt.Sprintf("%d governance groups are named %q (IDs: %s).", len(matches), name, strings.Join(ids, ", ")),
+		)
+		return
+	}
+
+	group := matches[0]
+	members, err := d.client.ListGovernanceGroupMembers(ctx, group.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Governance Group",
+			fmt.Sprintf("Could not read members of SailPoint Governance Group %q: %s", group.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.FromAPI(ctx, &group, members)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
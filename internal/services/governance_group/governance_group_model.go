@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package governance_group
+
+import (
+	"context"
+	"sort"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// governanceGroupModel represents the Terraform state for a Governance Group resource and data source.
+type governanceGroupModel struct {
+	ID              types.String           `tfsdk:"id"`
+	Name            types.String           `tfsdk:"name"`
+	Description     types.String           `tfsdk:"description"`
+	Owner           *common.ObjectRefModel `tfsdk:"owner"`
+	Members         types.Set              `tfsdk:"members"`
+	MemberCount     types.Int64            `tfsdk:"member_count"`
+	ConnectionCount types.Int64            `tfsdk:"connection_count"`
+	Created         types.String           `tfsdk:"created"`
+	Modified        types.String           `tfsdk:"modified"`
+}
+
+// FromAPI maps the API response and the group's members into the Terraform state.
+func (m *governanceGroupModel) FromAPI(ctx context.Context, api *client.GovernanceGroupAPI, members []client.ObjectRefAPI) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	m.ID = types.StringValue(api.ID)
+	m.Name = types.StringValue(api.Name)
+	m.Description = common.StringOrNullIfEmpty(stringValue(api.Description))
+	m.Created = common.StringOrNull(api.Created)
+	m.Modified = common.StringOrNull(api.Modified)
+	m.MemberCount = types.Int64PointerValue(api.MemberCount)
+	m.ConnectionCount = types.Int64PointerValue(api.ConnectionCount)
+
+	if api.Owner != nil {
+		owner, diags := common.NewObjectRefFromAPIPtr(ctx, *api.Owner)
+		diagnostics.Append(diags...)
+		m.Owner = owner
+	} else {
+		m.Owner = nil
+	}
+
+	memberIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.ID)
+	}
+	var diags diag.Diagnostics
+	m.Members, diags = types.SetValueFrom(ctx, types.StringType, memberIDs)
+	diagnostics.Append(diags...)
+
+	return diagnostics
+}
+
+// ToAPI maps the Terraform state into an API create payload. Members are not part of the payload.
+func (m *governanceGroupModel) ToAPI(ctx context.Context) (*client.GovernanceGroupAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	api := &client.GovernanceGroupAPI{
+		Name: m.Name.ValueString(),
+	}
+
+	if !m.Description.IsNull() && !m.Description.IsUnknown() {
+		desc := m.Description.ValueString()
+		api.Description = &desc
+	}
+
+	if m.Owner != nil {
+		owner, diags := common.NewObjectRefToAPIPtr(ctx, *m.Owner)
+		diagnostics.Append(diags...)
+		api.Owner = owner
+	}
+
+	return api, diagnostics
+}
+
+// ToPatchOperations compares the plan (m) against state and returns JSON Patch ops for changed fields.
+// Membership changes are computed separately by MemberChanges.
+func (m *governanceGroupModel) ToPatchOperations(ctx context.Context, state *governanceGroupModel) ([]client.JSONPatchOperation, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	var ops []client.JSONPatchOperation
+
+	if !m.Name.Equal(state.Name) {
+		ops = append(ops, client.NewReplacePatch("/name", m.Name.ValueString()))
+	}
+
+	if !m.Description.Equal(state.Description) {
+		if !m.Description.IsNull() {
+			ops = append(ops, client.NewReplacePatch("/description", m.Description.ValueString()))
+		} else {
+			ops = append(ops, client.NewReplacePatch("/description", ""))
+		}
+	}
+
+	if m.Owner != nil && (state.Owner == nil || !m.Owner.ID.Equal(state.Owner.ID) || !m.Owner.Type.Equal(state.Owner.Type)) {
+		ownerAPI, diags := common.NewObjectRefToAPIPtr(ctx, *m.Owner)
+		diagnostics.Append(diags...)
+		ops = append(ops, client.NewReplacePatch("/owner", ownerAPI))
+	}
+
+	return ops, diagnostics
+}
+
+// MemberChanges returns the identity IDs to add to and remove from the group to move its
+// membership from state to the plan (m). An unknown plan means membership is not managed.
+func (m *governanceGroupModel) MemberChanges(ctx context.Context, state types.Set) (add, remove []string, diagnostics diag.Diagnostics) {
+	if m.Members.IsUnknown() || m.Members.IsNull() {
+		return nil, nil, diagnostics
+	}
+
+	var planned, current []string
+	diagnostics.Append(m.Members.ElementsAs(ctx, &planned, false)...)
+	if !state.IsNull() && !state.IsUnknown() {
+		diagnostics.Append(state.ElementsAs(ctx, &current, false)...)
+	}
+	if diagnostics.HasError() {
+		return nil, nil, diagnostics
+	}
+
+	add = setDifference(planned, current)
+	remove = setDifference(current, planned)
+	return add, remove, diagnostics
+}
+
+// setDifference returns the sorted elements of a that are not in b.
+func setDifference(a, b []string) []string {
+	exclude := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		exclude[v] = struct{}{}
+	}
+	var diff []string
+	for _, v := range a {
+		if _, ok := exclude[v]; !ok {
+			diff = append(diff, v)
+		}
+	}
+	sort.Strings(diff)
+	return diff
+}
+
+// stringValue dereferences s, returning "" if nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
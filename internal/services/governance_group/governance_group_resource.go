@@ -0,0 +1,353 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package governance_group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &governanceGroupResource{}
+	_ resource.ResourceWithConfigure   = &governanceGroupResource{}
+	_ resource.ResourceWithImportState = &governanceGroupResource{}
+)
+
+type governanceGroupResource struct {
+	client *client.Client
+}
+
+// NewGovernanceGroupResource creates a new Governance Group resource.
+func NewGovernanceGroupResource() resource.Resource {
+	return &governanceGroupResource{}
+}
+
+func (r *governanceGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_governance_group"
+}
+
+func (r *governanceGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "governance group resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+func (r *governanceGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for SailPoint Governance Group.",
+		MarkdownDescription: "Resource for SailPoint Governance Group (workgroup). Governance groups are sets of identities that can own " +
+			"access items and act as approvers, e.g. as `GOVERNANCE_GROUP` additional owners or approvers of access profiles and roles.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the governance group.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the governance group.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the governance group.",
+				Optional:            true,
+			},
+			"owner": schema.SingleNestedAttribute{
+				MarkdownDescription: "The owner of the governance group.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the owner object. Must be `IDENTITY`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.OwnerTypes...),
+						},
+					},
+					"id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the owner.",
+						Required:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the owner. Resolved by the server from the owner ID.",
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"members": schema.SetAttribute{
+				MarkdownDescription: "IDs of the identities that are members of the governance group. " +
+					"When set, membership is managed exactly: identities missing from the set are removed from the group. " +
+					"When omitted, membership is left untouched and reflects the current members.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"member_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of members in the governance group.",
+				Computed:            true,
+			},
+			"connection_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of objects (access profiles, roles, approval schemes, ...) the governance group is connected to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "The date and time the governance group was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time the governance group was last modified.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *governanceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan governanceGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReq, diags := plan.ToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating governance group", map[string]any{"name": plan.Name.ValueString()})
+	apiResp, err := r.client.CreateGovernanceGroup(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SailPoint Governance Group",
+			fmt.Sprintf("Could not create SailPoint Governance Group %q: %s", plan.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Creating SailPoint Governance Group", "Received nil response from SailPoint API")
+		return
+	}
+
+	id := apiResp.ID
+	add, _, diags := plan.MemberChanges(ctx, types.SetNull(types.StringType))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(add) > 0 {
+		if err := r.client.AddGovernanceGroupMembers(ctx, id, add); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating SailPoint Governance Group",
+				fmt.Sprintf("Governance Group %q was created but its members could not be added: %s. "+
+					"The group is tainted and will be replaced on the next apply.", id, err.Error()),
+			)
+			// Keep the group in state so it is tracked rather than orphaned. Terraform taints it because
+			// Create failed, so the next apply destroys and recreates it with its members.
+			var state governanceGroupModel
+			resp.Diagnostics.Append(state.FromAPI(ctx, apiResp, nil)...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	state, diags := r.read(ctx, id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SailPoint Governance Group",
+			fmt.Sprintf("SailPoint Governance Group %q was not found after creation", id),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "Successfully created governance group", map[string]any{
+		"id":   state.ID.ValueString(),
+		"name": state.Name.ValueString(),
+	})
+}
+
+func (r *governanceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state governanceGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	newState, diags := r.read(ctx, id)
+	if newState == nil && !diags.HasError() {
+		tflog.Info(ctx, "Governance group not found, removing from state", map[string]any{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *governanceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan governanceGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state governanceGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	ops, diags := plan.ToPatchOperations(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(ops) > 0 {
+		if _, err := r.client.PatchGovernanceGroup(ctx, id, ops); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating SailPoint Governance Group",
+				fmt.Sprintf("Could not update SailPoint Governance Group %q: %s", id, err.Error()),
+			)
+			return
+		}
+	}
+
+	add, remove, diags := plan.MemberChanges(ctx, state.Members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(remove) > 0 {
+		if err := r.client.RemoveGovernanceGroupMembers(ctx, id, remove); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating SailPoint Governance Group",
+				fmt.Sprintf("Could not remove members from SailPoint Governance Group %q: %s", id, err.Error()),
+			)
+			return
+		}
+	}
+	if len(add) > 0 {
+		if err := r.client.AddGovernanceGroupMembers(ctx, id, add); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating SailPoint Governance Group",
+				fmt.Sprintf("Could not add members to SailPoint Governance Group %q: %s", id, err.Error()),
+			)
+			return
+		}
+	}
+
+	newState, diags := r.read(ctx, id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if newState == nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SailPoint Governance Group",
+			fmt.Sprintf("SailPoint Governance Group %q was not found after update", id),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	tflog.Info(ctx, "Successfully updated governance group", map[string]any{
+		"id":   newState.ID.ValueString(),
+		"name": newState.Name.ValueString(),
+	})
+}
+
+func (r *governanceGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state governanceGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := r.client.DeleteGovernanceGroup(ctx, id); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SailPoint Governance Group",
+			fmt.Sprintf("Could not delete SailPoint Governance Group %q: %s", id, err.Error()),
+		)
+		return
+	}
+	tflog.Info(ctx, "Successfully deleted governance group", map[string]any{"id": id})
+}
+
+func (r *governanceGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// read fetches the governance group and its members. It returns a nil model without
+// diagnostics when the group no longer exists.
+func (r *governanceGroupResource) read(ctx context.Context, id string) (*governanceGroupModel, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	apiResp, err := r.client.GetGovernanceGroup(ctx, id)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return nil, diagnostics
+		}
+		diagnostics.AddError(
+			"Error Reading SailPoint Governance Group",
+			fmt.Sprintf("Could not read SailPoint Governance Group %q: %s", id, err.Error()),
+		)
+		return nil, diagnostics
+	}
+	if apiResp == nil {
+		diagnostics.AddError("Error Reading SailPoint Governance Group", "Received nil response from SailPoint API")
+		return nil, diagnostics
+	}
+
+	members, err := r.client.ListGovernanceGroupMembers(ctx, id)
+	if err != nil {
+		diagnostics.AddError(
+			"Error Reading SailPoint Governance Group",
+			fmt.Sprintf("Could not read members of SailPoint Governance Group %q: %s", id, err.Error()),
+		)
+		return nil, diagnostics
+	}
+
+	var state governanceGroupModel
+	diagnostics.Append(state.FromAPI(ctx, apiResp, members)...)
+	return &state, diagnostics
+}
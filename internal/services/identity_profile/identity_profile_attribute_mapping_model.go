@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package identity_profile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const attributeTransformsPath = "/identityAttributeConfig/attributeTransforms"
+
+// identityProfileAttributeMappingModel represents the Terraform state for a single attribute
+// mapping of an Identity Profile.
+type identityProfileAttributeMappingModel struct {
+	IdentityProfileID     types.String             `tfsdk:"identity_profile_id"`
+	IdentityAttributeName types.String             `tfsdk:"identity_attribute_name"`
+	TransformDefinition   transformDefinitionModel `tfsdk:"transform_definition"`
+}
+
+// FromAPI maps an attribute transform of the profile to the Terraform model.
+func (m *identityProfileAttributeMappingModel) FromAPI(ctx context.Context, profileID string, api client.IdentityAttributeTransformAPI) diag.Diagnostics {
+	m.IdentityProfileID = types.StringValue(profileID)
+	m.IdentityAttributeName = types.StringValue(api.IdentityAttributeName)
+	return m.TransformDefinition.FromAPI(ctx, api.TransformDefinition)
+}
+
+// ToAPI maps the Terraform model to an attribute transform of the profile.
+func (m *identityProfileAttributeMappingModel) ToAPI(ctx context.Context) (client.IdentityAttributeTransformAPI, diag.Diagnostics) {
+	transformDef, diags := m.TransformDefinition.ToAPI(ctx)
+	return client.IdentityAttributeTransformAPI{
+		IdentityAttributeName: m.IdentityAttributeName.ValueString(),
+		TransformDefinition:   transformDef,
+	}, diags
+}
+
+// ToPatchOperations returns the JSON Patch operations setting the mapping on a profile whose current
+// attribute transforms are given. An existing mapping for the attribute is replaced in place, guarded
+// by a test operation so that the patch fails rather than overwrites another mapping if the list has
+// changed since it was read. Otherwise the mapping is appended.
+func (m *identityProfileAttributeMappingModel) ToPatchOperations(ctx context.Context, current []client.IdentityAttributeTransformAPI) ([]client.JSONPatchOperation, diag.Diagnostics) {
+	transform, diags := m.ToAPI(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	name := m.IdentityAttributeName.ValueString()
+	if index := findAttributeTransform(current, name); index >= 0 {
+		return []client.JSONPatchOperation{
+			client.NewTestPatch(attributeTransformNamePath(index), name),
+			client.NewReplacePatch(attributeTransformPath(index), transform),
+		}, diags
+	}
+	if len(current) == 0 {
+		return []client.JSONPatchOperation{
+			client.NewAddPatch(attributeTransformsPath, []client.IdentityAttributeTransformAPI{transform}),
+		}, diags
+	}
+	return []client.JSONPatchOperation{
+		client.NewAddPatch(attributeTransformsPath+"/-", transform),
+	}, diags
+}
+
+// removePatchOperations returns the JSON Patch operations removing the mapping at index.
+func removePatchOperations(index int, name string) []client.JSONPatchOperation {
+	return []client.JSONPatchOperation{
+		client.NewTestPatch(attributeTransformNamePath(index), name),
+		client.NewRemovePatch(attributeTransformPath(index)),
+	}
+}
+
+// findAttributeTransform returns the index of the mapping for the named identity attribute, or -1.
+func findAttributeTransform(transforms []client.IdentityAttributeTransformAPI, name string) int {
+	for i, transform := range transforms {
+		if transform.IdentityAttributeName == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func attributeTransformPath(index int) string {
+	return fmt.Sprintf("%s/%d", attributeTransformsPath, index)
+}
+
+func attributeTransformNamePath(index int) string {
+	return attributeTransformPath(index) + "/identityAttributeName"
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package identity_profile
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAttributeMappingToPatchOperations(t *testing.T) {
+	t.Parallel()
+
+	mapping := identityProfileAttributeMappingModel{
+		IdentityProfileID:     types.StringValue("profile-id"),
+		IdentityAttributeName: types.StringValue("department"),
+		TransformDefinition: transformDefinitionModel{
+			Type:       types.StringValue("accountAttribute"),
+			Attributes: jsontypes.NewNormalizedValue(`{"attributeName":"dept","sourceName":"HR"}`),
+		},
+	}
+	transform := client.IdentityAttributeTransformAPI{
+		IdentityAttributeName: "department",
+		TransformDefinition: client.TransformDefinitionAPI{
+			Type:       "accountAttribute",
+			Attributes: map[string]interface{}{"attributeName": "dept", "sourceName": "HR"},
+		},
+	}
+	other := func(name string) client.IdentityAttributeTransformAPI {
+		return client.IdentityAttributeTransformAPI{IdentityAttributeName: name}
+	}
+
+	tests := map[string]struct {
+		current []client.IdentityAttributeTransformAPI
+		want    []client.JSONPatchOperation
+	}{
+		"no mappings yet": {
+			want: []client.JSONPatchOperation{
+				client.NewAddPatch("/identityAttributeConfig/attributeTransforms", []client.IdentityAttributeTransformAPI{transform}),
+			},
+		},
+		"appended after other mappings": {
+			current: []client.IdentityAttributeTransformAPI{other("email"), other("riskLevel")},
+			want: []client.JSONPatchOperation{
+				client.NewAddPatch("/identityAttributeConfig/attributeTransforms/-", transform),
+			},
+		},
+		"existing mapping replaced in place": {
+			current: []client.IdentityAttributeTransformAPI{other("email"), other("department"), other("riskLevel")},
+			want: []client.JSONPatchOperation{
+				client.NewTestPatch("/identityAttributeConfig/attributeTransforms/1/identityAttributeName", "department"),
+				client.NewReplacePatch("/identityAttributeConfig/attributeTransforms/1", transform),
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := mapping.ToPatchOperations(context.Background(), tt.current)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemovePatchOperations(t *testing.T) {
+	t.Parallel()
+
+	want := []client.JSONPatchOperation{
+		client.NewTestPatch("/identityAttributeConfig/attributeTransforms/2/identityAttributeName", "riskLevel"),
+		client.NewRemovePatch("/identityAttributeConfig/attributeTransforms/2"),
+	}
+	if got := removePatchOperations(2, "riskLevel"); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,370 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package identity_profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &identityProfileAttributeMappingResource{}
+	_ resource.ResourceWithConfigure   = &identityProfileAttributeMappingResource{}
+	_ resource.ResourceWithImportState = &identityProfileAttributeMappingResource{}
+)
+
+type identityProfileAttributeMappingResource struct {
+	client *client.Client
+}
+
+// NewIdentityProfileAttributeMappingResource creates a new resource for a single Identity Profile attribute mapping.
+func NewIdentityProfileAttributeMappingResource() resource.Resource {
+	return &identityProfileAttributeMappingResource{}
+}
+
+// Metadata implements resource.Resource.
+func (r *identityProfileAttributeMappingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_profile_attribute_mapping"
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *identityProfileAttributeMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "identity profile attribute mapping resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+// Schema implements resource.Resource.
+func (r *identityProfileAttributeMappingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single attribute mapping of a SailPoint Identity Profile.",
+		MarkdownDescription: "Manages a single attribute mapping of a SailPoint Identity Profile: the transform computing one identity attribute. " +
+			"Other mappings of the profile are left alone, so different configurations can own different attributes. " +
+			"The `sailpoint_identity_profile` resource must leave `identity_attribute_config.attribute_transforms` unset, otherwise it removes the mappings it does not list. " +
+			"If the profile already maps the attribute, the mapping is adopted and updated to match your configuration.",
+		Attributes: map[string]schema.Attribute{
+			"identity_profile_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the identity profile. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identity_attribute_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the identity attribute being mapped. Changing this forces a new resource.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"transform_definition": schema.SingleNestedAttribute{
+				MarkdownDescription: "The transform definition for the identity attribute.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the transform definition (e.g., `accountAttribute`, `rule`, `reference`).",
+						Required:            true,
+					},
+					"attributes": schema.StringAttribute{
+						MarkdownDescription: "The attributes of the transform definition as a JSON string.",
+						Optional:            true,
+						Computed:            true,
+						CustomType:          jsontypes.NormalizedType{},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create implements resource.Resource.
+func (r *identityProfileAttributeMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan identityProfileAttributeMappingModel
+	tflog.Debug(ctx, "Getting plan for identity profile attribute mapping resource")
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, &plan, "Creating")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Successfully created SailPoint Identity Profile attribute mapping resource", map[string]any{
+		"identity_profile_id":     state.IdentityProfileID.ValueString(),
+		"identity_attribute_name": state.IdentityAttributeName.ValueString(),
+	})
+}
+
+// Read implements resource.Resource.
+func (r *identityProfileAttributeMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state identityProfileAttributeMappingModel
+	tflog.Debug(ctx, "Getting state for identity profile attribute mapping resource read")
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileID := state.IdentityProfileID.ValueString()
+	attributeName := state.IdentityAttributeName.ValueString()
+
+	// Read the identity profile from SailPoint
+	tflog.Debug(ctx, "Fetching identity profile from SailPoint", map[string]any{
+		"identity_profile_id":     profileID,
+		"identity_attribute_name": attributeName,
+	})
+	profile, err := r.client.GetIdentityProfile(ctx, profileID)
+	if err != nil {
+		// If the profile was deleted outside of Terraform, remove the mapping from state
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Info(ctx, "SailPoint Identity Profile not found, removing attribute mapping from state", map[string]any{
+				"identity_profile_id":     profileID,
+				"identity_attribute_name": attributeName,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Identity Profile Attribute Mapping",
+			fmt.Sprintf("Could not read mapping of attribute %q on SailPoint Identity Profile %q: %s",
+				attributeName, profileID, err.Error()),
+		)
+		tflog.Error(ctx, "Failed to read SailPoint Identity Profile attribute mapping", map[string]any{
+			"identity_profile_id":     profileID,
+			"identity_attribute_name": attributeName,
+			"error":                   err.Error(),
+		})
+		return
+	}
+
+	if profile == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Identity Profile Attribute Mapping",
+			"Received nil response from SailPoint API",
+		)
+		return
+	}
+
+	transforms := profile.IdentityAttributeConfig.AttributeTransforms
+	index := findAttributeTransform(transforms, attributeName)
+	if index < 0 {
+		tflog.Info(ctx, "SailPoint Identity Profile attribute mapping not found, removing from state", map[string]any{
+			"identity_profile_id":     profileID,
+			"identity_attribute_name": attributeName,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Map the mapping to the resource model
+	resp.Diagnostics.Append(state.FromAPI(ctx, profileID, transforms[index])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Successfully read SailPoint Identity Profile attribute mapping resource", map[string]any{
+		"identity_profile_id":     profileID,
+		"identity_attribute_name": attributeName,
+	})
+}
+
+// Update implements resource.Resource.
+func (r *identityProfileAttributeMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan identityProfileAttributeMappingModel
+	tflog.Debug(ctx, "Getting plan for identity profile attribute mapping resource update")
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, &plan, "Updating")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Successfully updated SailPoint Identity Profile attribute mapping resource", map[string]any{
+		"identity_profile_id":     state.IdentityProfileID.ValueString(),
+		"identity_attribute_name": state.IdentityAttributeName.ValueString(),
+	})
+}
+
+// Delete implements resource.Resource.
+func (r *identityProfileAttributeMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state identityProfileAttributeMappingModel
+	tflog.Debug(ctx, "Getting state for identity profile attribute mapping resource deletion")
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileID := state.IdentityProfileID.ValueString()
+	attributeName := state.IdentityAttributeName.ValueString()
+
+	profile, err := r.client.GetIdentityProfile(ctx, profileID)
+	if errors.Is(err, client.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SailPoint Identity Profile Attribute Mapping",
+			fmt.Sprintf("Could not read SailPoint Identity Profile %q: %s", profileID, err.Error()),
+		)
+		return
+	}
+
+	index := findAttributeTransform(profile.IdentityAttributeConfig.AttributeTransforms, attributeName)
+	if index < 0 {
+		tflog.Debug(ctx, "SailPoint Identity Profile attribute mapping already removed", map[string]any{
+			"identity_profile_id":     profileID,
+			"identity_attribute_name": attributeName,
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "Removing identity profile attribute mapping via SailPoint API", map[string]any{
+		"identity_profile_id":     profileID,
+		"identity_attribute_name": attributeName,
+		"index":                   index,
+	})
+	_, err = r.client.PatchIdentityProfile(ctx, profileID, removePatchOperations(index, attributeName))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SailPoint Identity Profile Attribute Mapping",
+			fmt.Sprintf("Could not remove mapping of attribute %q from SailPoint Identity Profile %q: %s",
+				attributeName, profileID, err.Error()),
+		)
+		tflog.Error(ctx, "Failed to delete SailPoint Identity Profile attribute mapping", map[string]any{
+			"identity_profile_id":     profileID,
+			"identity_attribute_name": attributeName,
+			"error":                   err.Error(),
+		})
+		return
+	}
+	tflog.Info(ctx, "Successfully deleted SailPoint Identity Profile attribute mapping resource", map[string]any{
+		"identity_profile_id":     profileID,
+		"identity_attribute_name": attributeName,
+	})
+}
+
+// ImportState implements resource.ResourceWithImportState.
+// Import format: identity_profile_id/identity_attribute_name.
+func (r *identityProfileAttributeMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing identity profile attribute mapping resource", map[string]any{
+		"import_id": req.ID,
+	})
+
+	// Parse the import ID (format: identity_profile_id/identity_attribute_name)
+	profileID, attributeName, ok := strings.Cut(req.ID, "/")
+	if !ok || profileID == "" || attributeName == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: identity_profile_id/identity_attribute_name, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identity_profile_id"), profileID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identity_attribute_name"), attributeName)...)
+
+	tflog.Info(ctx, "Successfully imported SailPoint Identity Profile attribute mapping resource", map[string]any{
+		"identity_profile_id":     profileID,
+		"identity_attribute_name": attributeName,
+	})
+}
+
+// apply sets the planned mapping on the profile, adding it or replacing the existing mapping for the
+// attribute, and returns the resulting state. action names the operation in error titles.
+func (r *identityProfileAttributeMappingResource) apply(ctx context.Context, plan *identityProfileAttributeMappingModel, action string) (*identityProfileAttributeMappingModel, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	profileID := plan.IdentityProfileID.ValueString()
+	attributeName := plan.IdentityAttributeName.ValueString()
+	errorTitle := fmt.Sprintf("Error %s SailPoint Identity Profile Attribute Mapping", action)
+
+	// The patch addresses mappings by index, so it is built from the current list
+	profile, err := r.client.GetIdentityProfile(ctx, profileID)
+	if err != nil {
+		diagnostics.AddError(errorTitle, fmt.Sprintf("Could not read SailPoint Identity Profile %q: %s", profileID, err.Error()))
+		return nil, diagnostics
+	}
+
+	patchOperations, diags := plan.ToPatchOperations(ctx, profile.IdentityAttributeConfig.AttributeTransforms)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	tflog.Debug(ctx, "Setting identity profile attribute mapping via SailPoint API", map[string]any{
+		"identity_profile_id":     profileID,
+		"identity_attribute_name": attributeName,
+		"operations_count":        len(patchOperations),
+	})
+	apiResponse, err := r.client.PatchIdentityProfile(ctx, profileID, patchOperations)
+	if err != nil {
+		diagnostics.AddError(errorTitle, fmt.Sprintf("Could not set mapping of attribute %q on SailPoint Identity Profile %q: %s",
+			attributeName, profileID, err.Error()))
+		tflog.Error(ctx, "Failed to set SailPoint Identity Profile attribute mapping", map[string]any{
+			"identity_profile_id":     profileID,
+			"identity_attribute_name": attributeName,
+			"error":                   err.Error(),
+		})
+		return nil, diagnostics
+	}
+
+	if apiResponse == nil {
+		diagnostics.AddError(errorTitle, "Received nil response from SailPoint API")
+		return nil, diagnostics
+	}
+
+	transforms := apiResponse.IdentityAttributeConfig.AttributeTransforms
+	index := findAttributeTransform(transforms, attributeName)
+	if index < 0 {
+		diagnostics.AddError(errorTitle, fmt.Sprintf("Mapping of attribute %q is missing from SailPoint Identity Profile %q after the update",
+			attributeName, profileID))
+		return nil, diagnostics
+	}
+
+	var state identityProfileAttributeMappingModel
+	diagnostics.Append(state.FromAPI(ctx, profileID, transforms[index])...)
+	return &state, diagnostics
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package identity_profile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &identityProfileListResource{}
+	_ list.ListResourceWithConfigure = &identityProfileListResource{}
+)
+
+type identityProfileListResource struct {
+	client *client.Client
+}
+
+// NewIdentityProfileListResource creates a new list resource for SailPoint Identity Profiles.
+func NewIdentityProfileListResource() list.ListResource {
+	return &identityProfileListResource{}
+}
+
+// Metadata implements list.ListResource.
+func (r *identityProfileListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_profile"
+}
+
+// Configure implements list.ListResourceWithConfigure.
+func (r *identityProfileListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "identity profile list resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+// ListResourceConfigSchema implements list.ListResource.
+func (r *identityProfileListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists SailPoint Identity Profiles, to bring existing ones under management with `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"filters": common.ListFiltersAttribute(`name sw "Employees"`),
+		},
+	}
+}
+
+// List implements list.ListResource.
+func (r *identityProfileListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config common.ListFiltersModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := r.client.ListIdentityProfiles(ctx, config.Filters.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error Listing SailPoint Identity Profiles",
+			fmt.Sprintf("Could not list SailPoint Identity Profiles: %s", err.Error()),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, items, func(api client.IdentityProfileAPI, result *list.ListResult) {
+		result.DisplayName = api.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, common.IDIdentityModel{ID: types.StringValue(api.ID)})...)
+		if !req.IncludeResource {
+			return
+		}
+
+		var state identityProfileModel
+		result.Diagnostics.Append(state.FromAPI(ctx, api)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &identityProfileResourceModel{identityProfileModel: state, ProcessOnChange: types.BoolNull(), ProcessTimeout: types.StringNull()})...)
+	})
+}
@@ -152,6 +152,13 @@ type identityProfileModel struct {
 	Modified                types.String                  `tfsdk:"modified"`
 }
 
+// identityProfileResourceModel extends the shared model with the settings that only exist on the resource.
+type identityProfileResourceModel struct {
+	identityProfileModel
+	ProcessOnChange types.Bool   `tfsdk:"process_on_change"`
+	ProcessTimeout  types.String `tfsdk:"process_timeout"`
+}
+
 // FromAPI maps fields from the API response to the Terraform model.
 func (m *identityProfileModel) FromAPI(ctx context.Context, api client.IdentityProfileAPI) diag.Diagnostics {
 	var diagnostics diag.Diagnostics
@@ -261,8 +268,15 @@ func (m *identityProfileModel) ToPatchOperations(ctx context.Context, state *ide
 		}
 	}
 
-	// IdentityAttributeConfig (required, always present)
-	if !reflect.DeepEqual(m.IdentityAttributeConfig, state.IdentityAttributeConfig) {
+	// IdentityAttributeConfig (required, always present). When attribute transforms are not
+	// configured, they are left to sailpoint_identity_profile_attribute_mapping resources and
+	// only the enabled flag is patched.
+	if m.ignoresAttributeTransforms() {
+		if !m.IdentityAttributeConfig.Enabled.IsUnknown() &&
+			(state.IdentityAttributeConfig == nil || !m.IdentityAttributeConfig.Enabled.Equal(state.IdentityAttributeConfig.Enabled)) {
+			patchOps = append(patchOps, client.NewReplacePatch("/identityAttributeConfig/enabled", m.IdentityAttributeConfig.Enabled.ValueBool()))
+		}
+	} else if !reflect.DeepEqual(m.IdentityAttributeConfig, state.IdentityAttributeConfig) {
 		if m.IdentityAttributeConfig != nil {
 			configAPI, diags := m.IdentityAttributeConfig.ToAPI(ctx)
 			diagnostics.Append(diags...)
@@ -273,6 +287,13 @@ func (m *identityProfileModel) ToPatchOperations(ctx context.Context, state *ide
 	return patchOps, diagnostics
 }
 
+// ignoresAttributeTransforms reports whether attribute transforms are left unconfigured, in which
+// case mappings on the profile are not tracked and are managed by
+// sailpoint_identity_profile_attribute_mapping resources instead.
+func (m *identityProfileModel) ignoresAttributeTransforms() bool {
+	return m.IdentityAttributeConfig != nil && m.IdentityAttributeConfig.AttributeTransforms == nil
+}
+
 // identityProfileDataSourceModel embeds the resource model and adds server-managed read-only fields.
 type identityProfileDataSourceModel struct {
 	identityProfileModel
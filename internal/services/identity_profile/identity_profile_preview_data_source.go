@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package identity_profile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &identityProfilePreviewDataSource{}
+	_ datasource.DataSourceWithConfigure = &identityProfilePreviewDataSource{}
+)
+
+type identityProfilePreviewDataSource struct {
+	client *client.Client
+}
+
+// NewIdentityProfilePreviewDataSource creates a new data source for previewing an Identity Profile attribute configuration.
+func NewIdentityProfilePreviewDataSource() datasource.DataSource {
+	return &identityProfilePreviewDataSource{}
+}
+
+// Metadata implements datasource.DataSource.
+func (d *identityProfilePreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_profile_preview"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *identityProfilePreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "identity profile preview data source")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = c
+}
+
+// Schema implements datasource.DataSource.
+func (d *identityProfilePreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Previews the identity attribute values an identity would get with a candidate identity attribute configuration.",
+		MarkdownDescription: "Previews the identity attribute values an identity would get with a candidate identity attribute configuration, " +
+			"alongside its current values, without changing anything in SailPoint. Use it to review the effect of a change to " +
+			"`identity_attribute_config` on `sailpoint_identity_profile` on real identities before applying it.",
+		Attributes: map[string]schema.Attribute{
+			"identity_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the identity to preview the configuration against.",
+				Required:            true,
+			},
+			"attribute_transforms": schema.ListNestedAttribute{
+				MarkdownDescription: "The candidate identity attribute mappings, in the same shape as `identity_attribute_config.attribute_transforms` on `sailpoint_identity_profile`.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"identity_attribute_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the identity attribute being mapped.",
+							Required:            true,
+						},
+						"transform_definition": schema.SingleNestedAttribute{
+							MarkdownDescription: "The transform definition for the identity attribute.",
+							Required:            true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "The type of the transform definition (e.g., `accountAttribute`, `reference`).",
+									Required:            true,
+								},
+								"attributes": schema.StringAttribute{
+									MarkdownDescription: "The attributes of the transform definition as a JSON string.",
+									Optional:            true,
+									CustomType:          jsontypes.NormalizedType{},
+								},
+							},
+						},
+					},
+				},
+			},
+			"identity": schema.SingleNestedAttribute{
+				MarkdownDescription: "The identity the configuration was previewed against.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the object. Always `IDENTITY`.",
+						Computed:            true,
+					},
+					"id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the identity.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the identity.",
+						Computed:            true,
+					},
+				},
+			},
+			"preview_attributes": schema.ListNestedAttribute{
+				MarkdownDescription: "The previewed identity attributes.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the identity attribute.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The value the attribute would have with the candidate configuration. Non-string values (e.g. multi-valued attributes) are JSON-encoded.",
+							Computed:            true,
+						},
+						"previous_value": schema.StringAttribute{
+							MarkdownDescription: "The current value of the attribute. Non-string values are JSON-encoded.",
+							Computed:            true,
+						},
+						"changed": schema.BoolAttribute{
+							MarkdownDescription: "Whether `value` differs from `previous_value`.",
+							Computed:            true,
+						},
+						"error_messages": schema.ListAttribute{
+							MarkdownDescription: "Errors raised while computing the attribute with the candidate configuration.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource.
+func (d *identityProfilePreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config identityProfilePreviewModel
+	tflog.Debug(ctx, "Getting config for identity profile preview data source")
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityID := config.IdentityID.ValueString()
+
+	// Map the candidate configuration to the API preview request
+	previewRequest, diags := config.ToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Preview the configuration against the identity
+	tflog.Debug(ctx, "Previewing identity profile configuration via SailPoint API", map[string]any{
+		"identity_id": identityID,
+	})
+	previewResponse, err := d.client.PreviewIdentityProfile(ctx, &previewRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Previewing SailPoint Identity Profile",
+			fmt.Sprintf("Could not preview identity attribute configuration for identity %q: %s", identityID, err.Error()),
+		)
+		tflog.Error(ctx, "Failed to preview SailPoint Identity Profile", map[string]any{
+			"identity_id": identityID,
+			"error":       err.Error(),
+		})
+		return
+	}
+
+	if previewResponse == nil {
+		resp.Diagnostics.AddError(
+			"Error Previewing SailPoint Identity Profile",
+			"Received nil response from SailPoint API",
+		)
+		return
+	}
+
+	// Map the response to the data source model
+	state := config
+	resp.Diagnostics.Append(state.FromAPI(ctx, *previewResponse)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Successfully read SailPoint Identity Profile preview data source", map[string]any{
+		"identity_id":        identityID,
+		"preview_attributes": len(state.PreviewAttributes),
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package identity_profile
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// identityProfilePreviewModel represents the Terraform state for the identity profile preview data source.
+type identityProfilePreviewModel struct {
+	IdentityID          types.String                      `tfsdk:"identity_id"`
+	AttributeTransforms []identityAttributeTransformModel `tfsdk:"attribute_transforms"`
+	Identity            *common.ObjectRefModel            `tfsdk:"identity"`
+	PreviewAttributes   []identityPreviewAttributeModel   `tfsdk:"preview_attributes"`
+}
+
+// identityPreviewAttributeModel represents a single previewed identity attribute.
+type identityPreviewAttributeModel struct {
+	Name          types.String   `tfsdk:"name"`
+	Value         types.String   `tfsdk:"value"`
+	PreviousValue types.String   `tfsdk:"previous_value"`
+	Changed       types.Bool     `tfsdk:"changed"`
+	ErrorMessages []types.String `tfsdk:"error_messages"`
+}
+
+// ToAPI maps the candidate configuration to the API preview request.
+// The configuration is always previewed as enabled.
+func (m *identityProfilePreviewModel) ToAPI(ctx context.Context) (client.IdentityPreviewRequestAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	apiRequest := client.IdentityPreviewRequestAPI{
+		IdentityID: m.IdentityID.ValueString(),
+		IdentityAttributeConfig: client.IdentityAttributeConfigAPI{
+			Enabled:             true,
+			AttributeTransforms: make([]client.IdentityAttributeTransformAPI, len(m.AttributeTransforms)),
+		},
+	}
+
+	for i := range m.AttributeTransforms {
+		var diags diag.Diagnostics
+		apiRequest.IdentityAttributeConfig.AttributeTransforms[i], diags = m.AttributeTransforms[i].ToAPI(ctx)
+		diagnostics.Append(diags...)
+	}
+
+	return apiRequest, diagnostics
+}
+
+// FromAPI maps the preview response to the computed attributes of the model.
+func (m *identityProfilePreviewModel) FromAPI(ctx context.Context, api client.IdentityPreviewResponseAPI) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	m.Identity = nil
+	if api.Identity != nil {
+		var diags diag.Diagnostics
+		m.Identity, diags = common.NewObjectRefFromAPIPtr(ctx, *api.Identity)
+		diagnostics.Append(diags...)
+	}
+
+	var diags diag.Diagnostics
+	m.PreviewAttributes, diags = common.MapSliceFromAPI(ctx, api.PreviewAttributes, NewIdentityPreviewAttributeFromAPI)
+	diagnostics.Append(diags...)
+
+	return diagnostics
+}
+
+func NewIdentityPreviewAttributeFromAPI(ctx context.Context, api client.IdentityPreviewAttributeAPI) (identityPreviewAttributeModel, diag.Diagnostics) {
+	var m identityPreviewAttributeModel
+	diags := m.FromAPI(ctx, api)
+	return m, diags
+}
+
+func (m *identityPreviewAttributeModel) FromAPI(_ context.Context, api client.IdentityPreviewAttributeAPI) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	m.Name = types.StringValue(api.Name)
+	m.Value = previewValueToString(api.Value, &diagnostics)
+	m.PreviousValue = previewValueToString(api.PreviousValue, &diagnostics)
+	m.Changed = types.BoolValue(!m.Value.Equal(m.PreviousValue))
+
+	m.ErrorMessages = make([]types.String, len(api.ErrorMessages))
+	for i, msg := range api.ErrorMessages {
+		m.ErrorMessages[i] = types.StringValue(msg.Text)
+	}
+
+	return diagnostics
+}
+
+// previewValueToString renders a previewed attribute value: strings as-is,
+// other JSON values (e.g. lists of a multi-valued attribute) as JSON.
+func previewValueToString(value interface{}, diagnostics *diag.Diagnostics) types.String {
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull()
+	case string:
+		return types.StringValue(v)
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		diagnostics.AddError("Error Mapping Identity Preview Value", err.Error())
+		return types.StringNull()
+	}
+	return types.StringValue(string(raw))
+}
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package identity_profile
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ action.Action              = &identityProfileProcessAction{}
+	_ action.ActionWithConfigure = &identityProfileProcessAction{}
+)
+
+type identityProfileProcessAction struct {
+	client *client.Client
+}
+
+// identityProfileProcessActionModel represents the configuration of the identity profile process action.
+type identityProfileProcessActionModel struct {
+	IdentityProfileID types.String `tfsdk:"identity_profile_id"`
+	Timeout           types.String `tfsdk:"timeout"`
+}
+
+// NewIdentityProfileProcessAction creates a new action processing the identities of an Identity Profile.
+func NewIdentityProfileProcessAction() action.Action {
+	return &identityProfileProcessAction{}
+}
+
+// Metadata implements action.Action.
+func (a *identityProfileProcessAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_profile_process"
+}
+
+// Configure implements action.ActionWithConfigure.
+func (a *identityProfileProcessAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "identity profile process action")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	a.client = c
+}
+
+// Schema implements action.Action.
+func (a *identityProfileProcessAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Processes the identities of a SailPoint Identity Profile.",
+		MarkdownDescription: "Processes the identities of a SailPoint Identity Profile, applying its current identity attribute configuration to them " +
+			"(the \"Apply Changes\" button of the UI), and waits for the processing task to complete. " +
+			"Errors reported by the task fail the action; its warnings are shown as warnings.",
+		Attributes: map[string]schema.Attribute{
+			"identity_profile_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the identity profile to process.",
+				Required:            true,
+			},
+			"timeout": common.TaskTimeoutAttribute(),
+		},
+	}
+}
+
+// Invoke implements action.Action.
+func (a *identityProfileProcessAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config identityProfileProcessActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := common.TaskTimeout(config.Timeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityProfileID := config.IdentityProfileID.ValueString()
+
+	task, diags := processIdentityProfile(ctx, a.client, identityProfileID, timeout, func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Processing of identity profile %s completed with status %s", identityProfileID, *task.CompletionStatus)})
+	tflog.Info(ctx, "Successfully processed SailPoint Identity Profile", map[string]any{
+		"id":      identityProfileID,
+		"task_id": task.ID,
+	})
+}
+
+// processIdentityProfile starts the processing of the identities of a profile and waits up to timeout
+// for its task, reporting progress through progress.
+func processIdentityProfile(ctx context.Context, c *client.Client, id string, timeout time.Duration, progress func(string)) (*client.TaskStatusAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	taskResult, err := c.ProcessIdentityProfile(ctx, id)
+	if err != nil {
+		diagnostics.AddError(
+			"Error Processing SailPoint Identity Profile",
+			fmt.Sprintf("Could not start processing the identities of SailPoint Identity Profile %q: %s", id, err.Error()),
+		)
+		return nil, diagnostics
+	}
+	progress(fmt.Sprintf("Started processing identity profile %s (task %s)", id, taskResult.ID))
+
+	task, diags := common.WaitForTask(ctx, c, taskResult.ID, timeout, progress)
+	diagnostics.Append(diags...)
+	return task, diagnostics
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -25,6 +27,7 @@ var (
 	_ resource.Resource                = &identityProfileResource{}
 	_ resource.ResourceWithConfigure   = &identityProfileResource{}
 	_ resource.ResourceWithImportState = &identityProfileResource{}
+	_ resource.ResourceWithIdentity    = &identityProfileResource{}
 )
 
 type identityProfileResource struct {
@@ -53,6 +56,9 @@ func (r *identityProfileResource) Configure(ctx context.Context, req resource.Co
 
 // Schema implements resource.Resource.
 func (r *identityProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	processTimeout := common.ResourceTaskTimeoutAttribute()
+	processTimeout.MarkdownDescription = fmt.Sprintf("How long to wait for the processing started by `process_on_change`, as a duration such as `45m` or `1h30m`. Defaults to `%s`.", common.DefaultTaskTimeout)
+
 	resp.Schema = schema.Schema{
 		Description:         "Resource for SailPoint Identity Profile.",
 		MarkdownDescription: "Resource for SailPoint Identity Profile. Identity profiles define the source of identities and how identity attributes are mapped.",
@@ -79,6 +85,9 @@ func (r *identityProfileResource) Schema(_ context.Context, _ resource.SchemaReq
 					"type": schema.StringAttribute{
 						MarkdownDescription: "The type of the owner object. Must be `IDENTITY`.",
 						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.OwnerTypes...),
+						},
 					},
 					"id": schema.StringAttribute{
 						MarkdownDescription: "The ID of the owner.",
@@ -108,6 +117,9 @@ func (r *identityProfileResource) Schema(_ context.Context, _ resource.SchemaReq
 					"type": schema.StringAttribute{
 						MarkdownDescription: "The type of the source object. Always `SOURCE`.",
 						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.ObjectRefTypeSource),
+						},
 					},
 					"id": schema.StringAttribute{
 						MarkdownDescription: "The ID of the authoritative source.",
@@ -132,8 +144,10 @@ func (r *identityProfileResource) Schema(_ context.Context, _ resource.SchemaReq
 						Computed:            true,
 					},
 					"attribute_transforms": schema.ListNestedAttribute{
-						MarkdownDescription: "List of identity attribute transforms.",
-						Required:            true,
+						MarkdownDescription: "List of identity attribute transforms. When set, this list owns every mapping of the profile. " +
+							"Leave it unset to manage mappings individually with `sailpoint_identity_profile_attribute_mapping`: " +
+							"mappings on the profile are then neither tracked nor changed by this resource.",
+						Optional: true,
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
 								"identity_attribute_name": schema.StringAttribute{
@@ -161,6 +175,13 @@ func (r *identityProfileResource) Schema(_ context.Context, _ resource.SchemaReq
 					},
 				},
 			},
+			"process_on_change": schema.BoolAttribute{
+				MarkdownDescription: "Process the identities of the profile after an update that requires it, such as a change of `identity_attribute_config`, " +
+					"and wait for the processing task. Creating the profile does not process identities; use the `sailpoint_identity_profile_process` action for that. " +
+					"If the processing fails or times out, the update is still saved and the next plan does not retry it; run the `sailpoint_identity_profile_process` action instead. Defaults to `false`.",
+				Optional: true,
+			},
+			"process_timeout": processTimeout,
 			"created": schema.StringAttribute{
 				MarkdownDescription: "The date and time the identity profile was created.",
 				Computed:            true,
@@ -176,9 +197,14 @@ func (r *identityProfileResource) Schema(_ context.Context, _ resource.SchemaReq
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (r *identityProfileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IDIdentitySchema()
+}
+
 // Create implements resource.Resource.
 func (r *identityProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan identityProfileModel
+	var plan identityProfileResourceModel
 	tflog.Debug(ctx, "Getting plan for identity profile resource")
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -222,7 +248,7 @@ func (r *identityProfileResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	// Map the API response back to the resource model
-	var state identityProfileModel
+	state := identityProfileResourceModel{ProcessOnChange: plan.ProcessOnChange, ProcessTimeout: plan.ProcessTimeout}
 	tflog.Debug(ctx, "Mapping SailPoint Identity Profile API response to resource model", map[string]any{
 		"name": plan.Name.ValueString(),
 	})
@@ -230,9 +256,13 @@ func (r *identityProfileResource) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if plan.ignoresAttributeTransforms() {
+		state.IdentityAttributeConfig.AttributeTransforms = nil
+	}
 
 	// Set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -244,7 +274,7 @@ func (r *identityProfileResource) Create(ctx context.Context, req resource.Creat
 
 // Read implements resource.Resource.
 func (r *identityProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state identityProfileModel
+	var state identityProfileResourceModel
 	tflog.Debug(ctx, "Getting state for identity profile resource read")
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -287,14 +317,19 @@ func (r *identityProfileResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	// Map the response to the resource model
+	// Map the response to the resource model, leaving unmanaged attribute transforms out
+	ignoresAttributeTransforms := state.ignoresAttributeTransforms()
 	resp.Diagnostics.Append(state.FromAPI(ctx, *apiResponse)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if ignoresAttributeTransforms {
+		state.IdentityAttributeConfig.AttributeTransforms = nil
+	}
 
 	// Set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -306,7 +341,7 @@ func (r *identityProfileResource) Read(ctx context.Context, req resource.ReadReq
 
 // Update implements resource.Resource.
 func (r *identityProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan identityProfileModel
+	var plan identityProfileResourceModel
 	tflog.Debug(ctx, "Getting plan for identity profile resource update")
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -314,7 +349,7 @@ func (r *identityProfileResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Get current state to retrieve the ID
-	var state identityProfileModel
+	var state identityProfileResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -326,7 +361,7 @@ func (r *identityProfileResource) Update(ctx context.Context, req resource.Updat
 	tflog.Debug(ctx, "Building patch operations for identity profile update", map[string]any{
 		"id": identityProfileID,
 	})
-	patchOperations, diags := plan.ToPatchOperations(ctx, &state)
+	patchOperations, diags := plan.ToPatchOperations(ctx, &state.identityProfileModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -336,6 +371,10 @@ func (r *identityProfileResource) Update(ctx context.Context, req resource.Updat
 		tflog.Debug(ctx, "No changes detected, skipping update", map[string]any{
 			"id": identityProfileID,
 		})
+		state.ProcessOnChange = plan.ProcessOnChange
+		state.ProcessTimeout = plan.ProcessTimeout
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 		return
 	}
 
@@ -367,14 +406,18 @@ func (r *identityProfileResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Map the API response back to the resource model
-	var newState identityProfileModel
+	newState := identityProfileResourceModel{ProcessOnChange: plan.ProcessOnChange, ProcessTimeout: plan.ProcessTimeout}
 	resp.Diagnostics.Append(newState.FromAPI(ctx, *apiResponse)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if plan.ignoresAttributeTransforms() {
+		newState.IdentityAttributeConfig.AttributeTransforms = nil
+	}
 
 	// Set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: newState.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -382,11 +425,34 @@ func (r *identityProfileResource) Update(ctx context.Context, req resource.Updat
 		"id":   identityProfileID,
 		"name": newState.Name.ValueString(),
 	})
+
+	// Apply the new configuration to the identities of the profile when requested
+	if plan.ProcessOnChange.ValueBool() && apiResponse.IdentityRefreshRequired {
+		timeout, diags := common.TaskTimeout(plan.ProcessTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		_, diags = processIdentityProfile(ctx, r.client, identityProfileID, timeout, func(message string) {
+			tflog.Info(ctx, message, map[string]any{"id": identityProfileID})
+		})
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			// The update itself succeeded and is saved, so the next plan shows no change and
+			// does not retry the processing.
+			resp.Diagnostics.AddError(
+				"SailPoint Identity Profile Updated but Not Processed",
+				fmt.Sprintf("The changes to SailPoint Identity Profile %q were applied, but its identities were not processed. "+
+					"Process them with the sailpoint_identity_profile_process action, for example with terraform apply -invoke, "+
+					"or with \"Apply Changes\" in the SailPoint UI.", identityProfileID),
+			)
+		}
+	}
 }
 
 // Delete implements resource.Resource.
 func (r *identityProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state identityProfileModel
+	var state identityProfileResourceModel
 	tflog.Debug(ctx, "Getting state for identity profile resource deletion")
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -423,7 +489,7 @@ func (r *identityProfileResource) ImportState(ctx context.Context, req resource.
 		"import_id": req.ID,
 	})
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 
 	tflog.Info(ctx, "Successfully imported SailPoint Identity Profile resource", map[string]any{
 		"id": req.ID,
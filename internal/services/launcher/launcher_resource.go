@@ -11,11 +11,13 @@ import (
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common/planmodifiers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -23,6 +25,7 @@ var (
 	_ resource.Resource                = &launcherResource{}
 	_ resource.ResourceWithConfigure   = &launcherResource{}
 	_ resource.ResourceWithImportState = &launcherResource{}
+	_ resource.ResourceWithIdentity    = &launcherResource{}
 )
 
 type launcherResource struct {
@@ -65,14 +68,23 @@ func (r *launcherResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the launcher, limited to 255 characters.",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The description of the launcher, limited to 2000 characters.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2000),
+				},
 			},
 			"type": schema.StringAttribute{
 				MarkdownDescription: "The type of the launcher. Currently only `INTERACTIVE_PROCESS` is supported.",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.LauncherTypes...),
+				},
 			},
 			"disabled": schema.BoolAttribute{
 				MarkdownDescription: "Whether the launcher is disabled. Defaults to `false`.",
@@ -82,6 +94,9 @@ func (r *launcherResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"config": schema.StringAttribute{
 				MarkdownDescription: "JSON configuration associated with this launcher, restricted to a max size of 4KB.",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(4096),
+				},
 			},
 			"created": schema.StringAttribute{
 				MarkdownDescription: "The date and time the launcher was created.",
@@ -104,6 +119,9 @@ func (r *launcherResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 							"server-side. The provider applies the same normalization at plan time so " +
 							"`tofu apply` does not fail with `inconsistent result after apply`.",
 						Required: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.LauncherOwnerTypes...),
+						},
 						PlanModifiers: []planmodifier.String{
 							planmodifiers.NormalizeString(map[string]string{"IDENTITY": "USER"}),
 						},
@@ -125,6 +143,9 @@ func (r *launcherResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					"type": schema.StringAttribute{
 						MarkdownDescription: "The type of the reference (e.g., `WORKFLOW`).",
 						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.ObjectRefTypeWorkflow),
+						},
 					},
 					"id": schema.StringAttribute{
 						MarkdownDescription: "The ID of the referenced resource.",
@@ -140,6 +161,11 @@ func (r *launcherResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (r *launcherResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IDIdentitySchema()
+}
+
 // Create implements resource.Resource.
 func (r *launcherResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan launcherModel
@@ -200,6 +226,7 @@ func (r *launcherResource) Create(ctx context.Context, req resource.CreateReques
 		"id":   state.ID.ValueString(),
 	})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -265,6 +292,7 @@ func (r *launcherResource) Read(ctx context.Context, req resource.ReadRequest, r
 		"id": state.ID.ValueString(),
 	})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -343,6 +371,7 @@ func (r *launcherResource) Update(ctx context.Context, req resource.UpdateReques
 		"id": state.ID.ValueString(),
 	})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: newState.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -388,7 +417,7 @@ func (r *launcherResource) ImportState(ctx context.Context, req resource.ImportS
 		"id": req.ID,
 	})
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 
 	tflog.Info(ctx, "Successfully imported SailPoint Launcher resource", map[string]any{
 		"id": req.ID,
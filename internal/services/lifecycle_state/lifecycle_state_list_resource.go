@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lifecycle_state
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &lifecycleStateListResource{}
+	_ list.ListResourceWithConfigure = &lifecycleStateListResource{}
+)
+
+type lifecycleStateListResource struct {
+	client *client.Client
+}
+
+// lifecycleStateListModel represents the configuration of the lifecycle state list resource.
+type lifecycleStateListModel struct {
+	IdentityProfileID types.String `tfsdk:"identity_profile_id"`
+}
+
+// NewLifecycleStateListResource creates a new list resource for SailPoint Lifecycle States.
+func NewLifecycleStateListResource() list.ListResource {
+	return &lifecycleStateListResource{}
+}
+
+// Metadata implements list.ListResource.
+func (r *lifecycleStateListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lifecycle_state"
+}
+
+// Configure implements list.ListResourceWithConfigure.
+func (r *lifecycleStateListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "lifecycle_state list resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+// ListResourceConfigSchema implements list.ListResource.
+func (r *lifecycleStateListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the SailPoint Lifecycle States of an identity profile, to bring existing ones under management with `terraform query`. " +
+			"The API does not support filtering lifecycle states, so all of them are listed.",
+		Attributes: map[string]schema.Attribute{
+			"identity_profile_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the identity profile whose lifecycle states are listed.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// List implements list.ListResource.
+func (r *lifecycleStateListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config lifecycleStateListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	identityProfileID := config.IdentityProfileID.ValueString()
+
+	items, err := r.client.ListLifecycleStates(ctx, identityProfileID)
+	if err != nil {
+		diags.AddError(
+			"Error Listing SailPoint Lifecycle States",
+			fmt.Sprintf("Could not list the SailPoint Lifecycle States of identity profile %q: %s", identityProfileID, err.Error()),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, items, func(api client.LifecycleStateAPI, result *list.ListResult) {
+		result.DisplayName = api.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, lifecycleStateIdentityModel{
+			IdentityProfileID: config.IdentityProfileID,
+			ID:                types.StringValue(api.ID),
+		})...)
+		if !req.IncludeResource {
+			return
+		}
+
+		var state lifecycleStateModel
+		result.Diagnostics.Append(state.FromAPI(ctx, api, identityProfileID)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+	})
+}
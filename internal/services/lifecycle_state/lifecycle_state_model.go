@@ -32,6 +32,18 @@ type lifecycleStateModel struct {
 	AccessActionConfiguration types.Object `tfsdk:"access_action_configuration"`
 }
 
+// lifecycleStateIdentityModel represents the identity of a Lifecycle State resource. Lifecycle states
+// are scoped to their identity profile, so both IDs are needed to address one.
+type lifecycleStateIdentityModel struct {
+	IdentityProfileID types.String `tfsdk:"identity_profile_id"`
+	ID                types.String `tfsdk:"id"`
+}
+
+// identity returns the resource identity of the lifecycle state.
+func (m *lifecycleStateModel) identity() lifecycleStateIdentityModel {
+	return lifecycleStateIdentityModel{IdentityProfileID: m.IdentityProfileID, ID: m.ID}
+}
+
 // emailNotificationOptionModel represents the email notification configuration.
 type emailNotificationOptionModel struct {
 	NotifyManagers      types.Bool `tfsdk:"notify_managers"`
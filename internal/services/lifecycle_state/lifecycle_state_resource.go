@@ -11,9 +11,11 @@ import (
 
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -22,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -30,6 +33,7 @@ var (
 	_ resource.Resource                = &lifecycleStateResource{}
 	_ resource.ResourceWithConfigure   = &lifecycleStateResource{}
 	_ resource.ResourceWithImportState = &lifecycleStateResource{}
+	_ resource.ResourceWithIdentity    = &lifecycleStateResource{}
 )
 
 type lifecycleStateResource struct {
@@ -105,6 +109,9 @@ func (r *lifecycleStateResource) Schema(_ context.Context, _ resource.SchemaRequ
 			"identity_state": schema.StringAttribute{
 				MarkdownDescription: "The identity state associated with this lifecycle state. Possible values: `ACTIVE`, `INACTIVE_SHORT_TERM`, `INACTIVE_LONG_TERM`. Cannot be changed after creation.",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.LifecycleStateIdentityStates...),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -179,6 +186,9 @@ func (r *lifecycleStateResource) Schema(_ context.Context, _ resource.SchemaRequ
 						"action": schema.StringAttribute{
 							MarkdownDescription: "The action to perform. Possible values: `ENABLE`, `DISABLE`, `DELETE`.",
 							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(client.LifecycleStateAccountActions...),
+							},
 						},
 						"source_ids": schema.ListAttribute{
 							MarkdownDescription: "List of source IDs to apply the action to. Required if `all_sources` is false.",
@@ -233,6 +243,22 @@ func (r *lifecycleStateResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (r *lifecycleStateResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"identity_profile_id": identityschema.StringAttribute{
+				Description:       "The ID of the identity profile the lifecycle state belongs to.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the lifecycle state.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 // Create implements resource.Resource.
 func (r *lifecycleStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan lifecycleStateModel
@@ -296,6 +322,7 @@ func (r *lifecycleStateResource) Create(ctx context.Context, req resource.Create
 
 	// Set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -363,6 +390,7 @@ func (r *lifecycleStateResource) Read(ctx context.Context, req resource.ReadRequ
 
 	// Set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -408,6 +436,7 @@ func (r *lifecycleStateResource) Update(ctx context.Context, req resource.Update
 			"identity_profile_id": identityProfileID,
 			"lifecycle_state_id":  lifecycleStateID,
 		})
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 		return
 	}
 
@@ -449,6 +478,7 @@ func (r *lifecycleStateResource) Update(ctx context.Context, req resource.Update
 
 	// Set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newState.identity())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -497,30 +527,37 @@ func (r *lifecycleStateResource) Delete(ctx context.Context, req resource.Delete
 }
 
 // ImportState implements resource.ResourceWithImportState.
-// Import format: identity_profile_id/lifecycle_state_id.
+// Import format: identity_profile_id/lifecycle_state_id, or an identity with both attributes.
 func (r *lifecycleStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, "Importing lifecycle state resource", map[string]any{
 		"import_id": req.ID,
 	})
 
-	// Parse the import ID (format: identity_profile_id/lifecycle_state_id)
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: identity_profile_id/lifecycle_state_id, got: %s", req.ID),
-		)
-		return
+	var identity lifecycleStateIdentityModel
+	if req.ID != "" {
+		// Parse the import ID (format: identity_profile_id/lifecycle_state_id)
+		parts := strings.Split(req.ID, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected import ID format: identity_profile_id/lifecycle_state_id, got: %s", req.ID),
+			)
+			return
+		}
+		identity.IdentityProfileID = types.StringValue(parts[0])
+		identity.ID = types.StringValue(parts[1])
+	} else {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	identityProfileID := parts[0]
-	lifecycleStateID := parts[1]
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identity_profile_id"), identityProfileID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), lifecycleStateID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identity_profile_id"), identity.IdentityProfileID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
 
 	tflog.Info(ctx, "Successfully imported SailPoint Lifecycle State resource", map[string]any{
-		"identity_profile_id": identityProfileID,
-		"lifecycle_state_id":  lifecycleStateID,
+		"identity_profile_id": identity.IdentityProfileID.ValueString(),
+		"lifecycle_state_id":  identity.ID.ValueString(),
 	})
 }
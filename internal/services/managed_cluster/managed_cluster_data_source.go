@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &managedClusterDataSource{}
+	_ datasource.DataSourceWithConfigure = &managedClusterDataSource{}
+)
+
+type managedClusterDataSource struct {
+	client *client.Client
+}
+
+// NewManagedClusterDataSource creates a new data source for SailPoint Managed Cluster.
+func NewManagedClusterDataSource() datasource.DataSource {
+	return &managedClusterDataSource{}
+}
+
+func (d *managedClusterDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_cluster"
+}
+
+func (d *managedClusterDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "managed cluster data source")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = c
+}
+
+func (d *managedClusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for SailPoint Managed Cluster.",
+		MarkdownDescription: "Data source for SailPoint Managed Cluster. Look up a managed cluster by name to retrieve its health and the virtual appliances attached to it. " +
+			"Set `require_healthy` to fail the plan when the cluster is not healthy, before any on-premise source is touched.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the managed cluster. Must match exactly one managed cluster.",
+				Required:            true,
+			},
+			"require_healthy": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, reading the data source fails unless the cluster is operational and its `status` is `NORMAL`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the managed cluster.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the managed cluster.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the managed cluster (`idn` or `iai`).",
+				Computed:            true,
+			},
+			"client_type": schema.StringAttribute{
+				MarkdownDescription: "The type of client the cluster runs (e.g., `VA`, `CCG`).",
+				Computed:            true,
+			},
+			"configuration": schema.MapAttribute{
+				MarkdownDescription: "Cluster configuration key/value pairs.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The health of the cluster (e.g., `NORMAL`, `WARNING`, `FAILED`, `NO_CLIENTS`, `CONFIGURING`).",
+				Computed:            true,
+			},
+			"operational": schema.BoolAttribute{
+				MarkdownDescription: "Whether the cluster is operational.",
+				Computed:            true,
+			},
+			"client_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the virtual appliances attached to the cluster.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"clients": schema.ListNestedAttribute{
+				MarkdownDescription: "The virtual appliances attached to the cluster.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the managed client.",
+							Computed:            true,
+						},
+						"client_id": schema.StringAttribute{
+							MarkdownDescription: "The client ID the virtual appliance authenticates with.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the virtual appliance.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the virtual appliance.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the client (e.g., `VA`).",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The health of the virtual appliance (e.g., `NORMAL`, `WARNING`, `ERROR`, `FAILED`).",
+							Computed:            true,
+						},
+						"ip_address": schema.StringAttribute{
+							MarkdownDescription: "The IP address of the virtual appliance.",
+							Computed:            true,
+						},
+						"last_seen": schema.StringAttribute{
+							MarkdownDescription: "The date and time the virtual appliance last checked in.",
+							Computed:            true,
+						},
+						"since_last_seen": schema.StringAttribute{
+							MarkdownDescription: "Milliseconds elapsed since the virtual appliance last checked in.",
+							Computed:            true,
+						},
+						"va_version": schema.StringAttribute{
+							MarkdownDescription: "The software version of the virtual appliance.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "The date and time the managed cluster was created.",
+				Computed:            true,
+			},
+			"modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time the managed cluster was last modified.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *managedClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state managedClusterDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	tflog.Debug(ctx, "Reading managed cluster data source", map[string]any{"name": name})
+
+	filter := fmt.Sprintf("name eq %q", name)
+	clusters, err := d.client.ListManagedClusters(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Managed Cluster",
+			fmt.Sprintf("Could not look up SailPoint Managed Cluster %q: %s", name, err.Error()),
+		)
+		return
+	}
+
+	// Keep exact matches only, in case the server matches case-insensitively.
+	var matches []client.ManagedClusterAPI
+	for _, cluster := range clusters {
+		if cluster.Name == name {
+			matches = append(matches, cluster)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"SailPoint Managed Cluster Not Found",
+			fmt.Sprintf("No managed cluster named %q was found.", name),
+		)
+		return
+	case 1:
+	default:
+		ids := make([]string, len(matches))
+		for i, cluster := range matches {
+			ids[i] = cluster.ID
+		}
+		resp.Diagnostics.AddError(
+			"Multiple SailPoint Managed Clusters Found",
+			fmt.Sprintf("%d managed clusters are named %q (IDs: %s).", len(matches), name, strings.Join(ids, ", ")),
+		)
+		return
+	}
+
+	cluster := matches[0]
+	clients, err := d.client.ListManagedClusterClients(ctx, cluster.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Managed Cluster",
+			fmt.Sprintf("Could not read clients of SailPoint Managed Cluster %q: %s", cluster.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.FromAPI(ctx, &cluster, types.MapNull(types.StringType))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Clients = make([]managedClientModel, 0, len(clients))
+	for _, c := range clients {
+		state.Clients = append(state.Clients, managedClientFromAPI(c))
+	}
+
+	if state.RequireHealthy.ValueBool() && !state.IsHealthy() {
+		unhealthy := make([]string, 0)
+		for _, c := range state.Clients {
+			if c.Status.ValueString() != client.ManagedClusterStatusNormal {
+				unhealthy = append(unhealthy, fmt.Sprintf("  - %s: %s", c.Name.ValueString(), c.Status.ValueString()))
+			}
+		}
+		detail := fmt.Sprintf("Managed cluster %q (%s) has status %q and operational = %t.",
+			name, cluster.ID, state.Status.ValueString(), state.Operational.ValueBool())
+		if len(unhealthy) > 0 {
+			detail += "\nVirtual appliances not in NORMAL status:\n" + strings.Join(unhealthy, "\n")
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("require_healthy"), "SailPoint Managed Cluster Is Not Healthy", detail)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
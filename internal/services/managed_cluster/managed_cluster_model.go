@@ -0,0 +1,212 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_cluster
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// managedClusterModel represents the Terraform state for a Managed Cluster resource.
+type managedClusterModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Type          types.String `tfsdk:"type"`
+	ClientType    types.String `tfsdk:"client_type"`
+	Configuration types.Map    `tfsdk:"configuration"`
+	Status        types.String `tfsdk:"status"`
+	Operational   types.Bool   `tfsdk:"operational"`
+	ClientIDs     types.List   `tfsdk:"client_ids"`
+	Created       types.String `tfsdk:"created"`
+	Modified      types.String `tfsdk:"modified"`
+}
+
+// managedClusterDataSourceModel represents the Terraform state for the Managed Cluster data source,
+// which adds the attached clients and the health check switch to the resource attributes.
+type managedClusterDataSourceModel struct {
+	managedClusterModel
+	RequireHealthy types.Bool           `tfsdk:"require_healthy"`
+	Clients        []managedClientModel `tfsdk:"clients"`
+}
+
+// managedClientModel represents a virtual appliance attached to a managed cluster.
+type managedClientModel struct {
+	ID            types.String `tfsdk:"id"`
+	ClientID      types.String `tfsdk:"client_id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Type          types.String `tfsdk:"type"`
+	Status        types.String `tfsdk:"status"`
+	IPAddress     types.String `tfsdk:"ip_address"`
+	LastSeen      types.String `tfsdk:"last_seen"`
+	SinceLastSeen types.String `tfsdk:"since_last_seen"`
+	VAVersion     types.String `tfsdk:"va_version"`
+}
+
+// FromAPI maps the API response into the Terraform state.
+//
+// The server adds its own keys to the cluster configuration. When configured is a known map, only
+// the keys it contains are kept so those server-managed keys do not show up as drift; otherwise
+// (import, data source) the full configuration is stored.
+func (m *managedClusterModel) FromAPI(ctx context.Context, api *client.ManagedClusterAPI, configured types.Map) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	m.ID = types.StringValue(api.ID)
+	m.Name = types.StringValue(api.Name)
+	m.Description = common.StringOrNullIfEmpty(derefString(api.Description))
+	m.Type = types.StringValue(api.Type)
+	m.ClientType = common.StringOrNull(api.ClientType)
+	m.Status = common.StringOrNull(api.Status)
+	m.Operational = types.BoolPointerValue(api.Operational)
+	m.Created = common.StringOrNull(api.CreatedAt)
+	m.Modified = common.StringOrNull(api.UpdatedAt)
+
+	configuration := api.Configuration
+	if !configured.IsNull() && !configured.IsUnknown() {
+		configuration = make(map[string]string, len(configured.Elements()))
+		for key := range configured.Elements() {
+			if value, ok := api.Configuration[key]; ok {
+				configuration[key] = value
+			}
+		}
+	}
+	if len(configuration) == 0 && (configured.IsNull() || configured.IsUnknown()) {
+		m.Configuration = types.MapNull(types.StringType)
+	} else {
+		var diags diag.Diagnostics
+		m.Configuration, diags = types.MapValueFrom(ctx, types.StringType, configuration)
+		diagnostics.Append(diags...)
+	}
+
+	clientIDs := api.ClientIDs
+	if clientIDs == nil {
+		clientIDs = []string{}
+	}
+	var diags diag.Diagnostics
+	m.ClientIDs, diags = types.ListValueFrom(ctx, types.StringType, clientIDs)
+	diagnostics.Append(diags...)
+
+	return diagnostics
+}
+
+// ToAPI maps the Terraform state into an API create payload.
+func (m *managedClusterModel) ToAPI(ctx context.Context) (*client.ManagedClusterAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	api := &client.ManagedClusterAPI{
+		Name: m.Name.ValueString(),
+		Type: m.Type.ValueString(),
+	}
+
+	if !m.Description.IsNull() && !m.Description.IsUnknown() {
+		desc := m.Description.ValueString()
+		api.Description = &desc
+	}
+	if !m.ClientType.IsNull() && !m.ClientType.IsUnknown() {
+		clientType := m.ClientType.ValueString()
+		api.ClientType = &clientType
+	}
+	if !m.Configuration.IsNull() && !m.Configuration.IsUnknown() {
+		diagnostics.Append(m.Configuration.ElementsAs(ctx, &api.Configuration, false)...)
+	}
+
+	return api, diagnostics
+}
+
+// ToPatchOperations compares the plan (m) against state and returns JSON Patch ops for changed fields.
+// Configuration keys are patched one by one so that server-managed keys are left untouched.
+func (m *managedClusterModel) ToPatchOperations(ctx context.Context, state *managedClusterModel) ([]client.JSONPatchOperation, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	var ops []client.JSONPatchOperation
+
+	if !m.Name.Equal(state.Name) {
+		ops = append(ops, client.NewReplacePatch("/name", m.Name.ValueString()))
+	}
+
+	if !m.Description.Equal(state.Description) {
+		if !m.Description.IsNull() {
+			ops = append(ops, client.NewReplacePatch("/description", m.Description.ValueString()))
+		} else {
+			ops = append(ops, client.NewReplacePatch("/description", ""))
+		}
+	}
+
+	if !m.ClientType.IsUnknown() && !m.ClientType.IsNull() && !m.ClientType.Equal(state.ClientType) {
+		ops = append(ops, client.NewReplacePatch("/clientType", m.ClientType.ValueString()))
+	}
+
+	if !m.Configuration.IsUnknown() && !m.Configuration.IsNull() && !m.Configuration.Equal(state.Configuration) {
+		var planned, current map[string]string
+		diagnostics.Append(m.Configuration.ElementsAs(ctx, &planned, false)...)
+		if !state.Configuration.IsNull() && !state.Configuration.IsUnknown() {
+			diagnostics.Append(state.Configuration.ElementsAs(ctx, &current, false)...)
+		}
+		if diagnostics.HasError() {
+			return nil, diagnostics
+		}
+		ops = append(ops, configurationPatchOperations(planned, current)...)
+	}
+
+	return ops, diagnostics
+}
+
+// configurationPatchOperations returns the ops setting the planned configuration keys that differ from current, in key order.
+// Keys are never removed: a key dropped from the plan is no longer managed but stays on the cluster, which also
+// keeps the server-managed keys of an imported cluster intact.
+func configurationPatchOperations(planned, current map[string]string) []client.JSONPatchOperation {
+	keys := make([]string, 0, len(planned))
+	for key := range planned {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var ops []client.JSONPatchOperation
+	for _, key := range keys {
+		if value, ok := current[key]; !ok || value != planned[key] {
+			ops = append(ops, client.NewAddPatch(configurationPath(key), planned[key]))
+		}
+	}
+	return ops
+}
+
+// configurationPath returns the JSON Pointer to a configuration key, escaping it per RFC 6901.
+func configurationPath(key string) string {
+	return "/configuration/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
+
+// IsHealthy reports whether the cluster is operational and its status is NORMAL.
+func (m *managedClusterModel) IsHealthy() bool {
+	return m.Operational.ValueBool() && m.Status.ValueString() == client.ManagedClusterStatusNormal
+}
+
+// managedClientFromAPI maps a managed client from the API.
+func managedClientFromAPI(api client.ManagedClientAPI) managedClientModel {
+	return managedClientModel{
+		ID:            types.StringValue(api.ID),
+		ClientID:      common.StringOrNull(api.ClientID),
+		Name:          common.StringOrNull(api.Name),
+		Description:   common.StringOrNullIfEmpty(derefString(api.Description)),
+		Type:          common.StringOrNull(api.Type),
+		Status:        common.StringOrNull(api.Status),
+		IPAddress:     common.StringOrNull(api.IPAddress),
+		LastSeen:      common.StringOrNull(api.LastSeen),
+		SinceLastSeen: common.StringOrNull(api.SinceLastSeen),
+		VAVersion:     common.StringOrNull(api.VAVersion),
+	}
+}
+
+// derefString dereferences s, returning "" if nil.
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,276 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package managed_cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &managedClusterResource{}
+	_ resource.ResourceWithConfigure   = &managedClusterResource{}
+	_ resource.ResourceWithImportState = &managedClusterResource{}
+)
+
+type managedClusterResource struct {
+	client *client.Client
+}
+
+// NewManagedClusterResource creates a new Managed Cluster resource.
+func NewManagedClusterResource() resource.Resource {
+	return &managedClusterResource{}
+}
+
+func (r *managedClusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_cluster"
+}
+
+func (r *managedClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "managed cluster resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+func (r *managedClusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for SailPoint Managed Cluster.",
+		MarkdownDescription: "Resource for SailPoint Managed Cluster. A managed cluster groups the virtual appliances (VAs) that connect " +
+			"on-premise sources to Identity Security Cloud; reference its `id` from `sailpoint_source.cluster`. " +
+			"Virtual appliances are paired with the cluster outside of Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the managed cluster.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the managed cluster.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the managed cluster.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the managed cluster: `idn` for identity governance, `iai` for AI-driven identity analytics. " +
+					"Defaults to `idn`. Changing this forces a new resource.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("idn"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.ManagedClusterTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_type": schema.StringAttribute{
+				MarkdownDescription: "The type of client the cluster runs (`CCG`, `VA`, `INTERNAL` or `IIQ_HARVESTER`). Set by the server when omitted.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.ManagedClusterClientTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"configuration": schema.MapAttribute{
+				MarkdownDescription: "Cluster configuration key/value pairs (e.g., `gmtOffset`). " +
+					"Only the keys set here are managed: keys added by the server are ignored, and removing a key stops managing it without deleting it from the cluster. " +
+					"When omitted, reflects the full server configuration.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The health of the cluster (e.g., `NORMAL`, `WARNING`, `FAILED`, `NO_CLIENTS`, `CONFIGURING`).",
+				Computed:            true,
+			},
+			"operational": schema.BoolAttribute{
+				MarkdownDescription: "Whether the cluster is operational.",
+				Computed:            true,
+			},
+			"client_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the virtual appliances attached to the cluster.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "The date and time the managed cluster was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time the managed cluster was last modified.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *managedClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan managedClusterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReq, diags := plan.ToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating managed cluster", map[string]any{"name": plan.Name.ValueString()})
+	apiResp, err := r.client.CreateManagedCluster(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SailPoint Managed Cluster",
+			fmt.Sprintf("Could not create SailPoint Managed Cluster %q: %s", plan.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Creating SailPoint Managed Cluster", "Received nil response from SailPoint API")
+		return
+	}
+
+	var state managedClusterModel
+	resp.Diagnostics.Append(state.FromAPI(ctx, apiResp, plan.Configuration)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Successfully created managed cluster", map[string]any{
+		"id":   state.ID.ValueString(),
+		"name": state.Name.ValueString(),
+	})
+}
+
+func (r *managedClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state managedClusterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	apiResp, err := r.client.GetManagedCluster(ctx, id)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Info(ctx, "Managed cluster not found, removing from state", map[string]any{"id": id})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Managed Cluster",
+			fmt.Sprintf("Could not read SailPoint Managed Cluster %q: %s", id, err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Reading SailPoint Managed Cluster", "Received nil response from SailPoint API")
+		return
+	}
+
+	var newState managedClusterModel
+	resp.Diagnostics.Append(newState.FromAPI(ctx, apiResp, state.Configuration)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *managedClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan managedClusterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state managedClusterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	ops, diags := plan.ToPatchOperations(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.PatchManagedCluster(ctx, id, ops)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SailPoint Managed Cluster",
+			fmt.Sprintf("Could not update SailPoint Managed Cluster %q: %s", id, err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Updating SailPoint Managed Cluster", "Received nil response from SailPoint API")
+		return
+	}
+
+	var newState managedClusterModel
+	resp.Diagnostics.Append(newState.FromAPI(ctx, apiResp, plan.Configuration)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	tflog.Info(ctx, "Successfully updated managed cluster", map[string]any{
+		"id":   newState.ID.ValueString(),
+		"name": newState.Name.ValueString(),
+	})
+}
+
+func (r *managedClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state managedClusterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := r.client.DeleteManagedCluster(ctx, id); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SailPoint Managed Cluster",
+			fmt.Sprintf("Could not delete SailPoint Managed Cluster %q: %s", id, err.Error()),
+		)
+		return
+	}
+	tflog.Info(ctx, "Successfully deleted managed cluster", map[string]any{"id": id})
+}
+
+func (r *managedClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package notification_template
+
+import (
+	"context"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// notificationTemplateModel represents the Terraform state for the Notification Template resource.
+type notificationTemplateModel struct {
+	ID          types.String `tfsdk:"id"`
+	Key         types.String `tfsdk:"key"`
+	Medium      types.String `tfsdk:"medium"`
+	Locale      types.String `tfsdk:"locale"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Subject     types.String `tfsdk:"subject"`
+	Header      types.String `tfsdk:"header"`
+	Body        types.String `tfsdk:"body"`
+	Footer      types.String `tfsdk:"footer"`
+	From        types.String `tfsdk:"from"`
+	ReplyTo     types.String `tfsdk:"reply_to"`
+	Created     types.String `tfsdk:"created"`
+	Modified    types.String `tfsdk:"modified"`
+}
+
+// FromAPI maps the API response into the Terraform state.
+func (m *notificationTemplateModel) FromAPI(_ context.Context, api *client.NotificationTemplateAPI) diag.Diagnostics {
+	m.ID = types.StringValue(api.ID)
+	m.Key = types.StringValue(api.Key)
+	m.Medium = types.StringValue(api.Medium)
+	m.Locale = types.StringValue(api.Locale)
+	m.Name = common.StringOrNull(api.Name)
+	m.Description = common.StringOrNull(api.Description)
+	m.Subject = common.StringOrNull(api.Subject)
+	m.Header = common.StringOrNull(api.Header)
+	m.Body = common.StringOrNull(api.Body)
+	m.Footer = common.StringOrNull(api.Footer)
+	m.From = common.StringOrNull(api.From)
+	m.ReplyTo = common.StringOrNull(api.ReplyTo)
+	m.Created = common.StringOrNull(api.Created)
+	m.Modified = common.StringOrNull(api.Modified)
+	return nil
+}
+
+// ToAPI maps the Terraform model to the API request body.
+func (m *notificationTemplateModel) ToAPI(_ context.Context) (*client.NotificationTemplateAPI, diag.Diagnostics) {
+	return &client.NotificationTemplateAPI{
+		Key:         m.Key.ValueString(),
+		Medium:      m.Medium.ValueString(),
+		Locale:      m.Locale.ValueString(),
+		Name:        stringPointer(m.Name),
+		Description: stringPointer(m.Description),
+		Subject:     stringPointer(m.Subject),
+		Header:      stringPointer(m.Header),
+		Body:        stringPointer(m.Body),
+		Footer:      stringPointer(m.Footer),
+		From:        stringPointer(m.From),
+		ReplyTo:     stringPointer(m.ReplyTo),
+	}, nil
+}
+
+// stringPointer converts types.String to *string (null/unknown → nil).
+func stringPointer(s types.String) *string {
+	if s.IsNull() || s.IsUnknown() {
+		return nil
+	}
+	v := s.ValueString()
+	return &v
+}
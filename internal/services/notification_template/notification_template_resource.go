@@ -0,0 +1,286 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package notification_template
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &notificationTemplateResource{}
+	_ resource.ResourceWithConfigure   = &notificationTemplateResource{}
+	_ resource.ResourceWithImportState = &notificationTemplateResource{}
+)
+
+type notificationTemplateResource struct {
+	client *client.Client
+}
+
+// NewNotificationTemplateResource creates a new Notification Template resource.
+func NewNotificationTemplateResource() resource.Resource {
+	return &notificationTemplateResource{}
+}
+
+func (r *notificationTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_template"
+}
+
+func (r *notificationTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "notification template resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+// identityAttribute returns a required attribute of the key/medium/locale triple identifying the template.
+func identityAttribute(description string, validators ...validator.String) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Required:            true,
+		Validators:          validators,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// templateAttribute returns an optional attribute holding a Velocity template.
+func templateAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description + " Velocity syntax is checked at plan time.",
+		Optional:            true,
+		Validators: []validator.String{
+			validators.VelocitySyntax(),
+		},
+	}
+}
+
+// serverDefaultAttribute returns an optional attribute that SailPoint fills in when it is not set.
+func serverDefaultAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			validators.VelocitySyntax(),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *notificationTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for a SailPoint notification template.",
+		MarkdownDescription: "Resource for a SailPoint notification template. A template customizes the notification sent for a `key` " +
+			"(e.g., `cloud_manual_work_item_summary`) over a `medium` in a `locale`; the default templates are listed by " +
+			"`GET /notification-template-defaults`. Creating a template for a combination that already has a custom template replaces it, " +
+			"and destroying the resource restores the default template.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the notification template.",
+				Computed:            true,
+			},
+			"key": identityAttribute("The notification the template is for (e.g., `cloud_manual_work_item_summary`).",
+				stringvalidator.LengthAtLeast(1),
+			),
+			"medium": identityAttribute("The delivery medium. One of `"+strings.Join(client.NotificationTemplateMediums, "`, `")+"`.",
+				stringvalidator.OneOf(client.NotificationTemplateMediums...),
+			),
+			"locale": schema.StringAttribute{
+				MarkdownDescription: "The locale of the template. Defaults to `en`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("en"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the template.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the template.",
+				Optional:            true,
+			},
+			"subject":  templateAttribute("The subject of the notification."),
+			"header":   templateAttribute("The header of the notification, prepended to the body."),
+			"body":     templateAttribute("The body of the notification. HTML for the `EMAIL` medium."),
+			"footer":   templateAttribute("The footer of the notification, appended to the body."),
+			"from":     serverDefaultAttribute("The sender email address, which must be a verified sender (see `sailpoint_verified_from_address`). Velocity syntax is checked at plan time."),
+			"reply_to": serverDefaultAttribute("The reply-to email address. Velocity syntax is checked at plan time."),
+			"created": schema.StringAttribute{
+				MarkdownDescription: "The date and time the template was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time the template was last modified.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *notificationTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan notificationTemplateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating notification template", map[string]any{"key": plan.Key.ValueString()})
+	state, diags := r.save(ctx, &plan, "Error Creating SailPoint Notification Template", "create")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "Successfully created notification template", map[string]any{"id": state.ID.ValueString()})
+}
+
+func (r *notificationTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state notificationTemplateModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, medium, locale := state.Key.ValueString(), state.Medium.ValueString(), state.Locale.ValueString()
+	name := key + "/" + medium + "/" + locale
+	filter := fmt.Sprintf("key eq %q and medium eq %q and locale eq %q", key, medium, locale)
+	templates, err := r.client.ListNotificationTemplates(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Notification Template",
+			fmt.Sprintf("Could not read SailPoint Notification Template %q: %s", name, err.Error()),
+		)
+		return
+	}
+
+	// Keep exact matches only, in case the server matches case-insensitively.
+	var template *client.NotificationTemplateAPI
+	for i := range templates {
+		if templates[i].Key == key && templates[i].Medium == medium && templates[i].Locale == locale {
+			template = &templates[i]
+			break
+		}
+	}
+	if template == nil {
+		tflog.Info(ctx, "Notification template not found, removing from state", map[string]any{"template": name})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(state.FromAPI(ctx, template)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *notificationTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan notificationTemplateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.save(ctx, &plan, "Error Updating SailPoint Notification Template", "update")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "Successfully updated notification template", map[string]any{"id": state.ID.ValueString()})
+}
+
+func (r *notificationTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state notificationTemplateModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, medium, locale := state.Key.ValueString(), state.Medium.ValueString(), state.Locale.ValueString()
+	if err := r.client.DeleteNotificationTemplate(ctx, key, medium, locale); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SailPoint Notification Template",
+			fmt.Sprintf("Could not delete SailPoint Notification Template %q: %s", key+"/"+medium+"/"+locale, err.Error()),
+		)
+		return
+	}
+	tflog.Info(ctx, "Successfully deleted notification template", map[string]any{"id": state.ID.ValueString()})
+}
+
+// ImportState implements resource.ResourceWithImportState.
+// Import format: key/medium/locale.
+func (r *notificationTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: key/medium/locale (e.g., cloud_manual_work_item_summary/EMAIL/en), got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("medium"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("locale"), parts[2])...)
+}
+
+// save creates or replaces the template for the plan's key, medium and locale and returns the new state.
+func (r *notificationTemplateResource) save(ctx context.Context, plan *notificationTemplateModel, errorTitle, operation string) (*notificationTemplateModel, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	body, diags := plan.ToAPI(ctx)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	apiResp, err := r.client.SaveNotificationTemplate(ctx, body)
+	if err != nil {
+		diagnostics.AddError(
+			errorTitle,
+			fmt.Sprintf("Could not %s SailPoint Notification Template %q: %s", operation, body.Key+"/"+body.Medium+"/"+body.Locale, err.Error()),
+		)
+		return nil, diagnostics
+	}
+	if apiResp == nil {
+		diagnostics.AddError(errorTitle, "Received nil response from SailPoint API")
+		return nil, diagnostics
+	}
+
+	var state notificationTemplateModel
+	diagnostics.Append(state.FromAPI(ctx, apiResp)...)
+	return &state, diagnostics
+}
@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package password_policy
+
+import (
+	"context"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// passwordPolicyModel represents the Terraform state for the Password Policy resource.
+type passwordPolicyModel struct {
+	ID                                    types.String `tfsdk:"id"`
+	Name                                  types.String `tfsdk:"name"`
+	Description                           types.String `tfsdk:"description"`
+	SourceIDs                             types.Set    `tfsdk:"source_ids"`
+	DefaultPolicy                         types.Bool   `tfsdk:"default_policy"`
+	MinLength                             types.Int64  `tfsdk:"min_length"`
+	MaxLength                             types.Int64  `tfsdk:"max_length"`
+	MinAlpha                              types.Int64  `tfsdk:"min_alpha"`
+	MinLower                              types.Int64  `tfsdk:"min_lower"`
+	MinUpper                              types.Int64  `tfsdk:"min_upper"`
+	MinNumeric                            types.Int64  `tfsdk:"min_numeric"`
+	MinSpecial                            types.Int64  `tfsdk:"min_special"`
+	MinCharacterTypes                     types.Int64  `tfsdk:"min_character_types"`
+	MaxRepeatedChars                      types.Int64  `tfsdk:"max_repeated_chars"`
+	UseDictionary                         types.Bool   `tfsdk:"use_dictionary"`
+	UseIdentityAttributes                 types.Bool   `tfsdk:"use_identity_attributes"`
+	UseAccountAttributes                  types.Bool   `tfsdk:"use_account_attributes"`
+	ValidateAgainstAccountID              types.Bool   `tfsdk:"validate_against_account_id"`
+	AccountIDMinWordLength                types.Int64  `tfsdk:"account_id_min_word_length"`
+	ValidateAgainstAccountName            types.Bool   `tfsdk:"validate_against_account_name"`
+	AccountNameMinWordLength              types.Int64  `tfsdk:"account_name_min_word_length"`
+	EnablePasswordExpiration              types.Bool   `tfsdk:"enable_password_expiration"`
+	PasswordExpiration                    types.Int64  `tfsdk:"password_expiration"`
+	FirstExpirationReminder               types.Int64  `tfsdk:"first_expiration_reminder"`
+	RequireStrongAuthn                    types.Bool   `tfsdk:"require_strong_authn"`
+	RequireStrongAuthOffNetwork           types.Bool   `tfsdk:"require_strong_auth_off_network"`
+	RequireStrongAuthUntrustedGeographies types.Bool   `tfsdk:"require_strong_auth_untrusted_geographies"`
+	Created                               types.String `tfsdk:"created"`
+	Modified                              types.String `tfsdk:"modified"`
+}
+
+// FromAPI maps the API response into the Terraform state.
+func (m *passwordPolicyModel) FromAPI(ctx context.Context, api *client.PasswordPolicyAPI) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	m.ID = types.StringValue(api.ID)
+	m.Name = types.StringValue(api.Name)
+	m.Description = types.StringNull()
+	if api.Description != nil {
+		m.Description = common.StringOrNullIfEmpty(*api.Description)
+	}
+	m.DefaultPolicy = types.BoolValue(api.DefaultPolicy != nil && *api.DefaultPolicy)
+	m.MinLength = types.Int64PointerValue(api.MinLength)
+	m.MaxLength = types.Int64PointerValue(api.MaxLength)
+	m.MinAlpha = types.Int64PointerValue(api.MinAlpha)
+	m.MinLower = types.Int64PointerValue(api.MinLower)
+	m.MinUpper = types.Int64PointerValue(api.MinUpper)
+	m.MinNumeric = types.Int64PointerValue(api.MinNumeric)
+	m.MinSpecial = types.Int64PointerValue(api.MinSpecial)
+	m.MinCharacterTypes = types.Int64PointerValue(api.MinCharacterTypes)
+	m.MaxRepeatedChars = types.Int64PointerValue(api.MaxRepeatedChars)
+	m.UseDictionary = types.BoolPointerValue(api.UseDictionary)
+	m.UseIdentityAttributes = types.BoolPointerValue(api.UseIdentityAttributes)
+	m.UseAccountAttributes = types.BoolPointerValue(api.UseAccountAttributes)
+	m.ValidateAgainstAccountID = types.BoolPointerValue(api.ValidateAgainstAccountID)
+	m.AccountIDMinWordLength = types.Int64PointerValue(api.AccountIDMinWordLength)
+	m.ValidateAgainstAccountName = types.BoolPointerValue(api.ValidateAgainstAccountName)
+	m.AccountNameMinWordLength = types.Int64PointerValue(api.AccountNameMinWordLength)
+	m.EnablePasswordExpiration = types.BoolPointerValue(api.EnablePasswdExpiration)
+	m.PasswordExpiration = types.Int64PointerValue(api.PasswordExpiration)
+	m.FirstExpirationReminder = types.Int64PointerValue(api.FirstExpirationReminder)
+	m.RequireStrongAuthn = types.BoolPointerValue(api.RequireStrongAuthn)
+	m.RequireStrongAuthOffNetwork = types.BoolPointerValue(api.RequireStrongAuthOffNetwork)
+	m.RequireStrongAuthUntrustedGeographies = types.BoolPointerValue(api.RequireStrongAuthUntrustedGeographies)
+	m.Created = common.StringOrNull(api.Created)
+	m.Modified = common.StringOrNull(api.Modified)
+
+	sourceIDs := api.SourceIDs
+	if sourceIDs == nil {
+		sourceIDs = []string{}
+	}
+	var diags diag.Diagnostics
+	m.SourceIDs, diags = types.SetValueFrom(ctx, types.StringType, sourceIDs)
+	diagnostics.Append(diags...)
+
+	return diagnostics
+}
+
+// ApplyTo overlays the known values of the plan onto current, the policy read from the tenant
+// (empty on create), so that the full policy can be PUT without resetting the settings left unset
+// in Terraform.
+func (m *passwordPolicyModel) ApplyTo(ctx context.Context, current *client.PasswordPolicyAPI) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	current.Name = m.Name.ValueString()
+	if !m.Description.IsUnknown() {
+		// An empty description clears it; omitting it would keep the current one.
+		description := m.Description.ValueString()
+		current.Description = &description
+	}
+	if !m.SourceIDs.IsNull() && !m.SourceIDs.IsUnknown() {
+		current.SourceIDs = make([]string, 0, len(m.SourceIDs.Elements()))
+		diagnostics.Append(m.SourceIDs.ElementsAs(ctx, &current.SourceIDs, false)...)
+	}
+	if current.SourceIDs == nil {
+		current.SourceIDs = []string{}
+	}
+
+	setInt64(&current.MinLength, m.MinLength)
+	setInt64(&current.MaxLength, m.MaxLength)
+	setInt64(&current.MinAlpha, m.MinAlpha)
+	setInt64(&current.MinLower, m.MinLower)
+	setInt64(&current.MinUpper, m.MinUpper)
+	setInt64(&current.MinNumeric, m.MinNumeric)
+	setInt64(&current.MinSpecial, m.MinSpecial)
+	setInt64(&current.MinCharacterTypes, m.MinCharacterTypes)
+	setInt64(&current.MaxRepeatedChars, m.MaxRepeatedChars)
+	setBool(&current.UseDictionary, m.UseDictionary)
+	setBool(&current.UseIdentityAttributes, m.UseIdentityAttributes)
+	setBool(&current.UseAccountAttributes, m.UseAccountAttributes)
+	setBool(&current.ValidateAgainstAccountID, m.ValidateAgainstAccountID)
+	setInt64(&current.AccountIDMinWordLength, m.AccountIDMinWordLength)
+	setBool(&current.ValidateAgainstAccountName, m.ValidateAgainstAccountName)
+	setInt64(&current.AccountNameMinWordLength, m.AccountNameMinWordLength)
+	setBool(&current.EnablePasswdExpiration, m.EnablePasswordExpiration)
+	setInt64(&current.PasswordExpiration, m.PasswordExpiration)
+	setInt64(&current.FirstExpirationReminder, m.FirstExpirationReminder)
+	setBool(&current.RequireStrongAuthn, m.RequireStrongAuthn)
+	setBool(&current.RequireStrongAuthOffNetwork, m.RequireStrongAuthOffNetwork)
+	setBool(&current.RequireStrongAuthUntrustedGeographies, m.RequireStrongAuthUntrustedGeographies)
+
+	return diagnostics
+}
+
+// setBool overwrites dst with v when v is known.
+func setBool(dst **bool, v types.Bool) {
+	if !v.IsNull() && !v.IsUnknown() {
+		value := v.ValueBool()
+		*dst = &value
+	}
+}
+
+// setInt64 overwrites dst with v when v is known.
+func setInt64(dst **int64, v types.Int64) {
+	if !v.IsNull() && !v.IsUnknown() {
+		value := v.ValueInt64()
+		*dst = &value
+	}
+}
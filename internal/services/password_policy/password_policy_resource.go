@@ -0,0 +1,349 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package password_policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &passwordPolicyResource{}
+	_ resource.ResourceWithConfigure      = &passwordPolicyResource{}
+	_ resource.ResourceWithImportState    = &passwordPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &passwordPolicyResource{}
+)
+
+type passwordPolicyResource struct {
+	client *client.Client
+}
+
+// NewPasswordPolicyResource creates a new Password Policy resource.
+func NewPasswordPolicyResource() resource.Resource {
+	return &passwordPolicyResource{}
+}
+
+func (r *passwordPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password_policy"
+}
+
+func (r *passwordPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "password policy resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+// settingInt64Attribute returns the schema of a numeric policy setting. Unset settings keep their tenant value.
+func settingInt64Attribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// settingBoolAttribute returns the schema of a boolean policy setting. Unset settings keep their tenant value.
+func settingBoolAttribute(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *passwordPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for a SailPoint password policy.",
+		MarkdownDescription: "Resource for a SailPoint password policy, which sets the rules passwords must follow on the sources in `source_ids`. " +
+			"Settings left unset keep the value SailPoint assigns, and are not reset when other settings change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the password policy.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the password policy.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the password policy.",
+				Optional:            true,
+			},
+			"source_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the sources the policy applies to. A source has at most one password policy. " +
+					"When omitted, the sources assigned to the policy are left unchanged.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_policy": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is the tenant's default password policy.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"min_length":          settingInt64Attribute("The minimum password length."),
+			"max_length":          settingInt64Attribute("The maximum password length."),
+			"min_alpha":           settingInt64Attribute("The minimum number of letters."),
+			"min_lower":           settingInt64Attribute("The minimum number of lowercase letters."),
+			"min_upper":           settingInt64Attribute("The minimum number of uppercase letters."),
+			"min_numeric":         settingInt64Attribute("The minimum number of digits."),
+			"min_special":         settingInt64Attribute("The minimum number of special characters."),
+			"min_character_types": settingInt64Attribute("The minimum number of character types (lowercase, uppercase, digits, special characters) a password must mix."),
+			"max_repeated_chars":  settingInt64Attribute("The maximum number of times a character may be repeated consecutively."),
+			"use_dictionary":      settingBoolAttribute("Whether passwords are rejected when they contain a word from the password dictionary."),
+			"use_identity_attributes": settingBoolAttribute("Whether passwords are rejected when they contain identity attribute values " +
+				"(e.g., first name, last name, email)."),
+			"use_account_attributes": settingBoolAttribute("Whether passwords are rejected when they contain account attribute values."),
+			"validate_against_account_id": settingBoolAttribute("Whether passwords are rejected when they contain a word of the account ID " +
+				"at least `account_id_min_word_length` characters long."),
+			"account_id_min_word_length": settingInt64Attribute("The minimum length of the account ID words checked by `validate_against_account_id`."),
+			"validate_against_account_name": settingBoolAttribute("Whether passwords are rejected when they contain a word of the account name " +
+				"at least `account_name_min_word_length` characters long."),
+			"account_name_min_word_length": settingInt64Attribute("The minimum length of the account name words checked by `validate_against_account_name`."),
+			"enable_password_expiration":   settingBoolAttribute("Whether passwords expire."),
+			"password_expiration":          settingInt64Attribute("The number of days after which passwords expire, when `enable_password_expiration` is `true`."),
+			"first_expiration_reminder":    settingInt64Attribute("The number of days before expiration the first reminder is sent."),
+			"require_strong_authn":         settingBoolAttribute("Whether a password change requires strong authentication."),
+			"require_strong_auth_off_network": settingBoolAttribute("Whether a password change requires strong authentication " +
+				"when made from outside the trusted networks."),
+			"require_strong_auth_untrusted_geographies": settingBoolAttribute("Whether a password change requires strong authentication " +
+				"when made from an untrusted geography."),
+			"created": schema.StringAttribute{
+				MarkdownDescription: "The date and time the password policy was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time the password policy was last modified.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the configured minimums fit within max_length.
+func (r *passwordPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config passwordPolicyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !isKnown(config.MaxLength) {
+		return
+	}
+	maxLength := config.MaxLength.ValueInt64()
+
+	if isKnown(config.MinLength) && config.MinLength.ValueInt64() > maxLength {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_length"),
+			"Invalid Password Length",
+			fmt.Sprintf("`min_length` (%d) must not be greater than `max_length` (%d).", config.MinLength.ValueInt64(), maxLength),
+		)
+	}
+
+	var required int64
+	for _, v := range []types.Int64{config.MinLower, config.MinUpper, config.MinNumeric, config.MinSpecial} {
+		if isKnown(v) {
+			required += v.ValueInt64()
+		}
+	}
+	if required > maxLength {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_length"),
+			"Invalid Password Length",
+			fmt.Sprintf("`min_lower`, `min_upper`, `min_numeric` and `min_special` add up to %d characters, more than `max_length` (%d).", required, maxLength),
+		)
+	}
+}
+
+func isKnown(v types.Int64) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
+
+func (r *passwordPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan passwordPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiReq client.PasswordPolicyAPI
+	resp.Diagnostics.Append(plan.ApplyTo(ctx, &apiReq)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.CreatePasswordPolicy(ctx, &apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SailPoint Password Policy",
+			fmt.Sprintf("Could not create SailPoint Password Policy %q: %s", plan.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Creating SailPoint Password Policy", "Received nil response from SailPoint API")
+		return
+	}
+
+	var state passwordPolicyModel
+	resp.Diagnostics.Append(state.FromAPI(ctx, apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Successfully created password policy", map[string]any{
+		"id":   state.ID.ValueString(),
+		"name": state.Name.ValueString(),
+	})
+}
+
+func (r *passwordPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state passwordPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	apiResp, err := r.client.GetPasswordPolicy(ctx, id)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Info(ctx, "Password policy not found, removing from state", map[string]any{"id": id})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Password Policy",
+			fmt.Sprintf("Could not read SailPoint Password Policy %q: %s", id, err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Reading SailPoint Password Policy", "Received nil response from SailPoint API")
+		return
+	}
+
+	var newState passwordPolicyModel
+	resp.Diagnostics.Append(newState.FromAPI(ctx, apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *passwordPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan passwordPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueString()
+	current, err := r.client.GetPasswordPolicy(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SailPoint Password Policy",
+			fmt.Sprintf("Could not read SailPoint Password Policy %q before updating it: %s", id, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.ApplyTo(ctx, current)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.UpdatePasswordPolicy(ctx, id, current)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SailPoint Password Policy",
+			fmt.Sprintf("Could not update SailPoint Password Policy %q: %s", id, err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Updating SailPoint Password Policy", "Received nil response from SailPoint API")
+		return
+	}
+
+	var newState passwordPolicyModel
+	resp.Diagnostics.Append(newState.FromAPI(ctx, apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	tflog.Info(ctx, "Successfully updated password policy", map[string]any{
+		"id":   newState.ID.ValueString(),
+		"name": newState.Name.ValueString(),
+	})
+}
+
+func (r *passwordPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state passwordPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := r.client.DeletePasswordPolicy(ctx, id); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SailPoint Password Policy",
+			fmt.Sprintf("Could not delete SailPoint Password Policy %q: %s", id, err.Error()),
+		)
+		return
+	}
+	tflog.Info(ctx, "Successfully deleted password policy", map[string]any{"id": id})
+}
+
+func (r *passwordPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
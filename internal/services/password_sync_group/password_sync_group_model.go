@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package password_sync_group
+
+import (
+	"context"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// passwordSyncGroupModel represents the Terraform state for the Password Sync Group resource.
+type passwordSyncGroupModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	PasswordPolicyID types.String `tfsdk:"password_policy_id"`
+	SourceIDs        types.Set    `tfsdk:"source_ids"`
+	Created          types.String `tfsdk:"created"`
+	Modified         types.String `tfsdk:"modified"`
+}
+
+// FromAPI maps the API response into the Terraform state.
+func (m *passwordSyncGroupModel) FromAPI(ctx context.Context, api *client.PasswordSyncGroupAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(api.ID)
+	m.Name = types.StringValue(api.Name)
+	m.PasswordPolicyID = types.StringValue(api.PasswordPolicyID)
+	m.SourceIDs, diags = types.SetValueFrom(ctx, types.StringType, api.SourceIDs)
+	m.Created = common.StringOrNull(api.Created)
+	m.Modified = common.StringOrNull(api.Modified)
+
+	return diags
+}
+
+// ToAPI converts the Terraform model into the API request.
+func (m *passwordSyncGroupModel) ToAPI(ctx context.Context) (*client.PasswordSyncGroupAPI, diag.Diagnostics) {
+	api := &client.PasswordSyncGroupAPI{
+		Name:             m.Name.ValueString(),
+		PasswordPolicyID: m.PasswordPolicyID.ValueString(),
+	}
+	diags := m.SourceIDs.ElementsAs(ctx, &api.SourceIDs, false)
+	return api, diags
+}
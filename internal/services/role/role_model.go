@@ -109,6 +109,12 @@ type roleModel struct {
 	Modified            types.String              `tfsdk:"modified"`
 }
 
+// roleResourceModel adds the tag attributes, which only the resource has, to roleModel.
+type roleResourceModel struct {
+	roleModel
+	common.TagsModel
+}
+
 // ---------------------------------------------------------------------------
 // FromAPI
 // ---------------------------------------------------------------------------
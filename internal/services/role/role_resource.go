@@ -10,11 +10,13 @@ import (
 
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -23,6 +25,8 @@ var (
 	_ resource.Resource                = &roleResource{}
 	_ resource.ResourceWithConfigure   = &roleResource{}
 	_ resource.ResourceWithImportState = &roleResource{}
+	_ resource.ResourceWithIdentity    = &roleResource{}
+	_ resource.ResourceWithModifyPlan  = &roleResource{}
 )
 
 type roleResource struct {
@@ -46,13 +50,13 @@ func (r *roleResource) Configure(ctx context.Context, req resource.ConfigureRequ
 
 // Shared attribute helpers.
 
-func objectRefSingle(desc string, required bool) schema.SingleNestedAttribute {
+func objectRefSingle(desc string, required bool, allowedTypes ...string) schema.SingleNestedAttribute {
 	return schema.SingleNestedAttribute{
 		MarkdownDescription: desc,
 		Required:            required,
 		Optional:            !required,
 		Attributes: map[string]schema.Attribute{
-			"type": schema.StringAttribute{Required: true},
+			"type": objectRefTypeAttr(allowedTypes),
 			"id":   schema.StringAttribute{Required: true},
 			"name": schema.StringAttribute{
 				Computed: true,
@@ -64,13 +68,13 @@ func objectRefSingle(desc string, required bool) schema.SingleNestedAttribute {
 	}
 }
 
-func objectRefSet(desc string) schema.SetNestedAttribute {
+func objectRefSet(desc string, allowedTypes ...string) schema.SetNestedAttribute {
 	return schema.SetNestedAttribute{
 		MarkdownDescription: desc,
 		Optional:            true,
 		NestedObject: schema.NestedAttributeObject{
 			Attributes: map[string]schema.Attribute{
-				"type": schema.StringAttribute{Required: true},
+				"type": objectRefTypeAttr(allowedTypes),
 				"id":   schema.StringAttribute{Required: true},
 				"name": schema.StringAttribute{
 					Computed: true,
@@ -83,6 +87,14 @@ func objectRefSet(desc string) schema.SetNestedAttribute {
 	}
 }
 
+// objectRefTypeAttr is the required `type` of an object reference, restricted to allowedTypes.
+func objectRefTypeAttr(allowedTypes []string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Required:   true,
+		Validators: []validator.String{stringvalidator.OneOf(allowedTypes...)},
+	}
+}
+
 func approvalSchemesAttr(desc string) schema.ListNestedAttribute {
 	return schema.ListNestedAttribute{
 		MarkdownDescription: desc,
@@ -92,6 +104,9 @@ func approvalSchemesAttr(desc string) schema.ListNestedAttribute {
 				"approver_type": schema.StringAttribute{
 					MarkdownDescription: "One of `OWNER`, `MANAGER`, `GOVERNANCE_GROUP`, `WORKFLOW`.",
 					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(client.RoleApproverTypes...),
+					},
 				},
 				"approver_id": schema.StringAttribute{
 					MarkdownDescription: "ID of the approver. Required when `approver_type` is `GOVERNANCE_GROUP` or `WORKFLOW`.",
@@ -105,16 +120,38 @@ func approvalSchemesAttr(desc string) schema.ListNestedAttribute {
 // criteriaLeafAttrs is the level-3 (leaf) criteria attribute set.
 func criteriaLeafAttrs() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
-		"operation": schema.StringAttribute{Required: true},
-		"key": schema.SingleNestedAttribute{
-			Optional: true,
-			Attributes: map[string]schema.Attribute{
-				"type":      schema.StringAttribute{Required: true},
-				"property":  schema.StringAttribute{Required: true},
-				"source_id": schema.StringAttribute{Optional: true},
+		"operation":    criteriaOperationAttr(),
+		"key":          criteriaKeyAttr(),
+		"string_value": schema.StringAttribute{Optional: true},
+	}
+}
+
+// criteriaOperationAttr is the `operation` attribute shared by every level of the criteria tree.
+func criteriaOperationAttr() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "One of `EQUALS`, `NOT_EQUALS`, `CONTAINS`, `STARTS_WITH`, `ENDS_WITH`, `AND`, `OR`.",
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(client.RoleCriteriaOperations...),
+		},
+	}
+}
+
+// criteriaKeyAttr is the `key` attribute shared by every level of the criteria tree.
+func criteriaKeyAttr() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "One of `IDENTITY`, `ACCOUNT`, `ENTITLEMENT`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.RoleCriteriaKeyTypes...),
+				},
 			},
+			"property":  schema.StringAttribute{Required: true},
+			"source_id": schema.StringAttribute{Optional: true},
 		},
-		"string_value": schema.StringAttribute{Optional: true},
 	}
 }
 
@@ -134,17 +171,23 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"name": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The name of the role (max 128 chars).",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
 			},
 			"description": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "Description (max 2000 chars).",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2000),
+				},
 			},
 			"enabled":         schema.BoolAttribute{Optional: true, Computed: true, MarkdownDescription: "Whether the role is enabled."},
 			"requestable":     schema.BoolAttribute{Optional: true, Computed: true, MarkdownDescription: "Whether the role can be requested. Defaults to `false`."},
 			"dimensional":     schema.BoolAttribute{Optional: true, Computed: true, MarkdownDescription: "Whether this is a dimensional role."},
-			"owner":           objectRefSingle("The owner of the role. Typically `type = IDENTITY`.", true),
-			"access_profiles": objectRefSet("Access profiles bundled into this role."),
-			"entitlements":    objectRefSet("Entitlements bundled directly into this role."),
+			"owner":           objectRefSingle("The owner of the role. Typically `type = IDENTITY`.", true, client.OwnerTypes...),
+			"access_profiles": objectRefSet("Access profiles bundled into this role.", client.ObjectRefTypeAccessProfile),
+			"entitlements":    objectRefSet("Entitlements bundled directly into this role.", client.ObjectRefTypeEntitlement),
 			"segments": schema.SetAttribute{
 				MarkdownDescription: "Segment UUIDs the role is visible in.",
 				Optional:            true,
@@ -155,7 +198,7 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"type": schema.StringAttribute{Required: true},
+						"type": objectRefTypeAttr(client.AdditionalOwnerTypes),
 						"id":   schema.StringAttribute{Required: true},
 						"name": schema.StringAttribute{
 							Computed: true,
@@ -173,34 +216,23 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					"type": schema.StringAttribute{
 						MarkdownDescription: "`STANDARD` or `IDENTITY_LIST`.",
 						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.RoleMembershipTypes...),
+						},
 					},
 					"criteria": schema.SingleNestedAttribute{
 						MarkdownDescription: "Criteria tree for STANDARD membership. Max 3 levels deep.",
 						Optional:            true,
 						Attributes: map[string]schema.Attribute{
-							"operation": schema.StringAttribute{Required: true},
-							"key": schema.SingleNestedAttribute{
-								Optional: true,
-								Attributes: map[string]schema.Attribute{
-									"type":      schema.StringAttribute{Required: true},
-									"property":  schema.StringAttribute{Required: true},
-									"source_id": schema.StringAttribute{Optional: true},
-								},
-							},
+							"operation":    criteriaOperationAttr(),
+							"key":          criteriaKeyAttr(),
 							"string_value": schema.StringAttribute{Optional: true},
 							"children": schema.ListNestedAttribute{
 								Optional: true,
 								NestedObject: schema.NestedAttributeObject{
 									Attributes: map[string]schema.Attribute{
-										"operation": schema.StringAttribute{Required: true},
-										"key": schema.SingleNestedAttribute{
-											Optional: true,
-											Attributes: map[string]schema.Attribute{
-												"type":      schema.StringAttribute{Required: true},
-												"property":  schema.StringAttribute{Required: true},
-												"source_id": schema.StringAttribute{Optional: true},
-											},
-										},
+										"operation":    criteriaOperationAttr(),
+										"key":          criteriaKeyAttr(),
 										"string_value": schema.StringAttribute{Optional: true},
 										"children": schema.ListNestedAttribute{
 											Optional: true,
@@ -218,8 +250,12 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 						Optional:            true,
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
-								"id":   schema.StringAttribute{Required: true},
-								"type": schema.StringAttribute{Optional: true, Computed: true},
+								"id": schema.StringAttribute{Required: true},
+								"type": schema.StringAttribute{
+									Optional:   true,
+									Computed:   true,
+									Validators: []validator.String{stringvalidator.OneOf(client.ObjectRefTypeIdentity)},
+								},
 								"name": schema.StringAttribute{
 									Computed: true,
 									PlanModifiers: []planmodifier.String{
@@ -247,8 +283,14 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					"max_permitted_access_duration": schema.SingleNestedAttribute{
 						Optional: true,
 						Attributes: map[string]schema.Attribute{
-							"value":     schema.Int64Attribute{Optional: true},
-							"time_unit": schema.StringAttribute{Optional: true},
+							"value": schema.Int64Attribute{Optional: true},
+							"time_unit": schema.StringAttribute{
+								MarkdownDescription: "One of `HOURS`, `DAYS`, `WEEKS`, `MONTHS`.",
+								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(client.AccessDurationTimeUnits...),
+								},
+							},
 						},
 					},
 					"approval_schemes": approvalSchemesAttr("Ordered approval chain for access requests."),
@@ -262,7 +304,7 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					"approval_schemes":         approvalSchemesAttr("Ordered approval chain for revoke requests."),
 				},
 			},
-			"dimension_refs": objectRefSet("Dimensions referenced by this role (when dimensional=true)."),
+			"dimension_refs": objectRefSet("Dimensions referenced by this role (when dimensional=true).", client.ObjectRefTypeDimension),
 			"created": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -270,12 +312,23 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"modified": schema.StringAttribute{Computed: true},
+			"tags":     common.TagsAttribute(),
+			"tags_all": common.TagsAllAttribute(),
 		},
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (r *roleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IDIdentitySchema()
+}
+
+func (r *roleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ModifyPlanTags(ctx, r.client, req, resp)
+}
+
 func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan roleModel
+	var plan roleResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -305,7 +358,10 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tags, diags := plan.TagsModel.Apply(ctx, r.client, client.ObjectRefTypeRole, state.ID.ValueString(), nil)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &roleResourceModel{roleModel: state, TagsModel: tags})...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 	tflog.Info(ctx, "Successfully created role", map[string]any{
 		"id":   state.ID.ValueString(),
 		"name": state.Name.ValueString(),
@@ -313,7 +369,7 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 }
 
 func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state roleModel
+	var state roleResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -339,26 +395,34 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	resp.Diagnostics.Append(state.FromAPI(ctx, apiResp)...)
+	resp.Diagnostics.Append(state.TagsModel.Read(ctx, r.client, client.ObjectRefTypeRole, id)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 }
 
 func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan roleModel
+	var plan roleResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var state roleModel
+	var state roleResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	id := state.ID.ValueString()
-	ops, diags := plan.ToPatchOperations(ctx, &state)
+	ops, diags := plan.ToPatchOperations(ctx, &state.roleModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, diags := plan.TagsModel.Apply(ctx, r.client, client.ObjectRefTypeRole, id, &state.TagsModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -382,11 +446,12 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &roleResourceModel{roleModel: newState, TagsModel: tags})...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: newState.ID})...)
 }
 
 func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state roleModel
+	var state roleResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -403,5 +468,5 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -10,11 +10,13 @@ import (
 
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -22,6 +24,7 @@ var (
 	_ resource.Resource                = &segmentResource{}
 	_ resource.ResourceWithConfigure   = &segmentResource{}
 	_ resource.ResourceWithImportState = &segmentResource{}
+	_ resource.ResourceWithIdentity    = &segmentResource{}
 )
 
 type segmentResource struct {
@@ -78,6 +81,9 @@ func (r *segmentResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					"type": schema.StringAttribute{
 						MarkdownDescription: "The type of the owner object. Must be `IDENTITY`.",
 						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.OwnerTypes...),
+						},
 					},
 					"id": schema.StringAttribute{
 						MarkdownDescription: "The ID of the owner.",
@@ -103,6 +109,9 @@ func (r *segmentResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							"operator": schema.StringAttribute{
 								MarkdownDescription: "Operator for this node. One of `AND`, `EQUALS`.",
 								Required:            true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(client.SegmentExpressionOperators...),
+								},
 							},
 							"attribute": schema.StringAttribute{
 								MarkdownDescription: "Identity attribute to compare. Required when `operator` is `EQUALS`.",
@@ -130,6 +139,9 @@ func (r *segmentResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 										"operator": schema.StringAttribute{
 											MarkdownDescription: "Operator for this leaf. Typically `EQUALS`.",
 											Required:            true,
+											Validators: []validator.String{
+												stringvalidator.OneOf(client.SegmentExpressionOperators...),
+											},
 										},
 										"attribute": schema.StringAttribute{
 											MarkdownDescription: "Identity attribute to compare.",
@@ -171,6 +183,11 @@ func (r *segmentResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (r *segmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IDIdentitySchema()
+}
+
 func (r *segmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan segmentModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -204,6 +221,7 @@ func (r *segmentResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 	tflog.Info(ctx, "Successfully created segment", map[string]any{
 		"id":   state.ID.ValueString(),
 		"name": state.Name.ValueString(),
@@ -241,6 +259,7 @@ func (r *segmentResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 }
 
 func (r *segmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -281,6 +300,7 @@ func (r *segmentResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: newState.ID})...)
 	tflog.Info(ctx, "Successfully updated segment", map[string]any{
 		"id":   newState.ID.ValueString(),
 		"name": newState.Name.ValueString(),
@@ -306,5 +326,5 @@ func (r *segmentResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *segmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
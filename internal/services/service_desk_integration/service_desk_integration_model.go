@@ -0,0 +1,223 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package service_desk_integration
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// serviceDeskIntegrationModel represents the Terraform state for the Service Desk Integration resource.
+type serviceDeskIntegrationModel struct {
+	ID                        types.String             `tfsdk:"id"`
+	Name                      types.String             `tfsdk:"name"`
+	Description               types.String             `tfsdk:"description"`
+	Type                      types.String             `tfsdk:"type"`
+	Owner                     *common.ObjectRefModel   `tfsdk:"owner"`
+	Cluster                   *common.ObjectRefModel   `tfsdk:"cluster"`
+	ProvisioningConfig        *provisioningConfigModel `tfsdk:"provisioning_config"`
+	BeforeProvisioningRule    *common.ObjectRefModel   `tfsdk:"before_provisioning_rule"`
+	Attributes                jsontypes.Normalized     `tfsdk:"attributes"`
+	SecretAttributesWO        jsontypes.Normalized     `tfsdk:"secret_attributes_wo"`
+	SecretAttributesWOVersion types.Int64              `tfsdk:"secret_attributes_wo_version"`
+	Created                   types.String             `tfsdk:"created"`
+	Modified                  types.String             `tfsdk:"modified"`
+}
+
+// provisioningConfigModel defines the sources an integration provisions.
+type provisioningConfigModel struct {
+	UniversalManager              types.Bool              `tfsdk:"universal_manager"`
+	ManagedResourceRefs           []common.ObjectRefModel `tfsdk:"managed_resource_refs"`
+	PlanInitializerScript         types.String            `tfsdk:"plan_initializer_script"`
+	NoProvisioningRequests        types.Bool              `tfsdk:"no_provisioning_requests"`
+	ProvisioningRequestExpiration types.Int64             `tfsdk:"provisioning_request_expiration"`
+}
+
+// statusCheckConfigModel represents the Terraform state for the Service Desk Status Check Config resource.
+type statusCheckConfigModel struct {
+	ID                   types.String `tfsdk:"id"`
+	CheckIntervalMinutes types.Int64  `tfsdk:"provisioning_status_check_interval_minutes"`
+	MaxStatusCheckDays   types.Int64  `tfsdk:"provisioning_max_status_check_days"`
+}
+
+// FromAPI maps the API response into the Terraform state.
+//
+// The attributes of an integration include server-managed keys and, once written, the secrets
+// sent through secret_attributes_wo. When configured is a known object, only the keys it contains
+// are kept; otherwise (import) every attribute returned by the API is stored. The write-only
+// attribute stays null and its version is carried over from prior.
+func (m *serviceDeskIntegrationModel) FromAPI(ctx context.Context, api *client.ServiceDeskIntegrationAPI, configured jsontypes.Normalized, prior *serviceDeskIntegrationModel) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	m.ID = types.StringValue(api.ID)
+	m.Name = types.StringValue(api.Name)
+	m.Description = types.StringValue(api.Description)
+	m.Type = types.StringValue(api.Type)
+	m.Owner = objectRefFromAPI(ctx, api.OwnerRef, &diagnostics)
+	m.Cluster = objectRefFromAPI(ctx, api.ClusterRef, &diagnostics)
+	m.BeforeProvisioningRule = objectRefFromAPI(ctx, api.BeforeProvisioningRule, &diagnostics)
+	m.Created = common.StringOrNull(api.Created)
+	m.Modified = common.StringOrNull(api.Modified)
+
+	// The API returns a provisioning config with default values for integrations created without one,
+	// which is only kept when a provisioning config is managed.
+	managesProvisioning := prior != nil && prior.ProvisioningConfig != nil
+	m.ProvisioningConfig = nil
+	if config := api.ProvisioningConfig; config != nil && (managesProvisioning || !isDefaultProvisioningConfig(config)) {
+		m.ProvisioningConfig = &provisioningConfigModel{
+			UniversalManager:              types.BoolValue(config.UniversalManager),
+			NoProvisioningRequests:        types.BoolValue(config.NoProvisioningRequests),
+			ProvisioningRequestExpiration: types.Int64PointerValue(config.ProvisioningRequestExpiration),
+			PlanInitializerScript:         types.StringNull(),
+		}
+		if config.PlanInitializerScript != nil {
+			m.ProvisioningConfig.PlanInitializerScript = common.StringOrNullIfEmpty(config.PlanInitializerScript.Source)
+		}
+		if len(config.ManagedResourceRefs) > 0 {
+			m.ProvisioningConfig.ManagedResourceRefs = make([]common.ObjectRefModel, len(config.ManagedResourceRefs))
+			for i, ref := range config.ManagedResourceRefs {
+				diagnostics.Append(m.ProvisioningConfig.ManagedResourceRefs[i].FromAPI(ctx, ref)...)
+			}
+		}
+	}
+
+	attributes := api.Attributes
+	if configuredAttributes, diags := common.UnmarshalJSONField[map[string]interface{}](configured); configuredAttributes != nil {
+		attributes = make(map[string]interface{}, len(*configuredAttributes))
+		for key := range *configuredAttributes {
+			if value, ok := api.Attributes[key]; ok {
+				attributes[key] = value
+			}
+		}
+	} else {
+		diagnostics.Append(diags...)
+	}
+	var diags diag.Diagnostics
+	m.Attributes, diags = common.MarshalJSONOrDefault(attributes, "{}")
+	diagnostics.Append(diags...)
+
+	m.SecretAttributesWO = jsontypes.NewNormalizedNull()
+	m.SecretAttributesWOVersion = types.Int64Null()
+	if prior != nil {
+		m.SecretAttributesWOVersion = prior.SecretAttributesWOVersion
+	}
+
+	return diagnostics
+}
+
+// ApplyTo overlays the plan onto current, the integration read from the tenant (empty on create),
+// so that attributes not set in Terraform survive the PUT. Write-only values are not part of the
+// plan, so the secret attributes are taken from config.
+func (m *serviceDeskIntegrationModel) ApplyTo(ctx context.Context, current *client.ServiceDeskIntegrationAPI, config *serviceDeskIntegrationModel) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	current.Name = m.Name.ValueString()
+	current.Description = m.Description.ValueString()
+	current.Type = m.Type.ValueString()
+	current.OwnerRef = objectRefToAPI(ctx, m.Owner, &diagnostics)
+	current.ClusterRef = objectRefToAPI(ctx, m.Cluster, &diagnostics)
+	current.BeforeProvisioningRule = objectRefToAPI(ctx, m.BeforeProvisioningRule, &diagnostics)
+
+	current.ProvisioningConfig = nil
+	if m.ProvisioningConfig != nil {
+		provisioning := &client.ServiceDeskProvisioningConfigAPI{
+			UniversalManager:       m.ProvisioningConfig.UniversalManager.ValueBool(),
+			NoProvisioningRequests: m.ProvisioningConfig.NoProvisioningRequests.ValueBool(),
+			ManagedResourceRefs:    make([]client.ObjectRefAPI, 0, len(m.ProvisioningConfig.ManagedResourceRefs)),
+		}
+		if !m.ProvisioningConfig.ProvisioningRequestExpiration.IsNull() && !m.ProvisioningConfig.ProvisioningRequestExpiration.IsUnknown() {
+			provisioning.ProvisioningRequestExpiration = m.ProvisioningConfig.ProvisioningRequestExpiration.ValueInt64Pointer()
+		}
+		if !m.ProvisioningConfig.PlanInitializerScript.IsNull() && !m.ProvisioningConfig.PlanInitializerScript.IsUnknown() {
+			provisioning.PlanInitializerScript = &client.ServiceDeskScriptAPI{Source: m.ProvisioningConfig.PlanInitializerScript.ValueString()}
+		}
+		for _, ref := range m.ProvisioningConfig.ManagedResourceRefs {
+			api, diags := ref.ToAPI(ctx)
+			diagnostics.Append(diags...)
+			provisioning.ManagedResourceRefs = append(provisioning.ManagedResourceRefs, api)
+		}
+		current.ProvisioningConfig = provisioning
+	}
+
+	attributes := make(map[string]interface{}, len(current.Attributes))
+	maps.Copy(attributes, current.Attributes)
+	if configured, diags := common.UnmarshalJSONField[map[string]interface{}](m.Attributes); configured != nil {
+		maps.Copy(attributes, *configured)
+	} else {
+		diagnostics.Append(diags...)
+	}
+	if config != nil {
+		if secrets, diags := common.UnmarshalJSONField[map[string]interface{}](config.SecretAttributesWO); secrets != nil {
+			maps.Copy(attributes, *secrets)
+		} else {
+			diagnostics.Append(diags...)
+		}
+	}
+	current.Attributes = attributes
+
+	return diagnostics
+}
+
+// FromAPI maps the API response into the Terraform state.
+func (m *statusCheckConfigModel) FromAPI(api *client.ServiceDeskStatusCheckConfigAPI) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	m.ID = types.StringValue(statusCheckConfigID)
+	m.CheckIntervalMinutes = int64FromAPI(api.ProvisioningStatusCheckIntervalMinutes, "provisioningStatusCheckIntervalMinutes", &diagnostics)
+	m.MaxStatusCheckDays = int64FromAPI(api.ProvisioningMaxStatusCheckDays, "provisioningMaxStatusCheckDays", &diagnostics)
+
+	return diagnostics
+}
+
+// ToAPI converts the Terraform model into the API request.
+func (m *statusCheckConfigModel) ToAPI() *client.ServiceDeskStatusCheckConfigAPI {
+	return &client.ServiceDeskStatusCheckConfigAPI{
+		ProvisioningStatusCheckIntervalMinutes: strconv.FormatInt(m.CheckIntervalMinutes.ValueInt64(), 10),
+		ProvisioningMaxStatusCheckDays:         strconv.FormatInt(m.MaxStatusCheckDays.ValueInt64(), 10),
+	}
+}
+
+func isDefaultProvisioningConfig(config *client.ServiceDeskProvisioningConfigAPI) bool {
+	return !config.UniversalManager && !config.NoProvisioningRequests && len(config.ManagedResourceRefs) == 0 &&
+		(config.PlanInitializerScript == nil || config.PlanInitializerScript.Source == "")
+}
+
+func objectRefFromAPI(ctx context.Context, api *client.ObjectRefAPI, diagnostics *diag.Diagnostics) *common.ObjectRefModel {
+	if api == nil || api.ID == "" {
+		return nil
+	}
+	ref, diags := common.NewObjectRefFromAPIPtr(ctx, *api)
+	diagnostics.Append(diags...)
+	return ref
+}
+
+func objectRefToAPI(ctx context.Context, m *common.ObjectRefModel, diagnostics *diag.Diagnostics) *client.ObjectRefAPI {
+	if m == nil {
+		return nil
+	}
+	api, diags := common.NewObjectRefToAPIPtr(ctx, *m)
+	diagnostics.Append(diags...)
+	return api
+}
+
+// int64FromAPI parses a number the API encodes as a string.
+func int64FromAPI(value, field string, diagnostics *diag.Diagnostics) types.Int64 {
+	if value == "" {
+		return types.Int64Null()
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		diagnostics.AddError("Error Parsing SailPoint Service Desk Status Check Config", fmt.Sprintf("Invalid %s %q: %s", field, value, err.Error()))
+		return types.Int64Null()
+	}
+	return types.Int64Value(n)
+}
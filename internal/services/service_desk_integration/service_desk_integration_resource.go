@@ -0,0 +1,373 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package service_desk_integration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common/planmodifiers"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &serviceDeskIntegrationResource{}
+	_ resource.ResourceWithConfigure      = &serviceDeskIntegrationResource{}
+	_ resource.ResourceWithImportState    = &serviceDeskIntegrationResource{}
+	_ resource.ResourceWithValidateConfig = &serviceDeskIntegrationResource{}
+)
+
+type serviceDeskIntegrationResource struct {
+	client *client.Client
+}
+
+// NewServiceDeskIntegrationResource creates a new Service Desk Integration resource.
+func NewServiceDeskIntegrationResource() resource.Resource {
+	return &serviceDeskIntegrationResource{}
+}
+
+func (r *serviceDeskIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_desk_integration"
+}
+
+func (r *serviceDeskIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "service desk integration resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+// objectRefAttribute returns the schema of a reference to an object of the given type.
+func objectRefAttribute(description, refType string, required bool) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Required:            required,
+		Optional:            !required,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The type of the referenced object (`%s`).", refType),
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(refType),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the referenced object.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the referenced object. Resolved by the server from the ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					planmodifiers.UseStateForUnknownUnlessSiblingChanges("id"),
+				},
+			},
+		},
+	}
+}
+
+func (r *serviceDeskIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	managedResourceRef := objectRefAttribute("", client.ObjectRefTypeSource, true)
+
+	resp.Schema = schema.Schema{
+		Description: "Resource for a SailPoint service desk integration.",
+		MarkdownDescription: "Resource for a SailPoint service desk integration (SDIM), which turns provisioning requests for the sources in " +
+			"`provisioning_config.managed_resource_refs` into tickets in an external service desk such as ServiceNow. " +
+			"Connection credentials go in `secret_attributes_wo`, a write-only attribute that requires Terraform 1.11 or later.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the integration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the integration.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the integration.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The integration type (e.g., `ServiceNowSDIM`). Changing this forces a new resource.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner":   objectRefAttribute("The owner of the integration.", client.ObjectRefTypeIdentity, false),
+			"cluster": objectRefAttribute("The cluster that runs the integration, for integrations reaching an on-premise service desk.", client.ObjectRefTypeCluster, false),
+			"provisioning_config": schema.SingleNestedAttribute{
+				MarkdownDescription: "The sources the integration provisions.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"universal_manager": schema.BoolAttribute{
+						MarkdownDescription: "Whether the integration provisions every source without a connector of its own. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"managed_resource_refs": schema.ListNestedAttribute{
+						MarkdownDescription: "The sources (type `SOURCE`) whose provisioning requests become tickets.",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: managedResourceRef.Attributes,
+						},
+					},
+					"plan_initializer_script": schema.StringAttribute{
+						MarkdownDescription: "A BeanShell script run to initialize the provisioning plan before the ticket is created.",
+						Optional:            true,
+					},
+					"no_provisioning_requests": schema.BoolAttribute{
+						MarkdownDescription: "Whether provisioning requests are skipped and only the ticket is created. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"provisioning_request_expiration": schema.Int64Attribute{
+						MarkdownDescription: "The number of hours after which an unanswered provisioning request expires.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"before_provisioning_rule": objectRefAttribute("The BeforeProvisioning rule run before each request is sent (see `sailpoint_connector_rule`).", client.ObjectRefTypeRule, false),
+			"attributes": schema.StringAttribute{
+				MarkdownDescription: "JSON object of the connection settings of the integration type (e.g., `url`, `username`, `ticketType`). " +
+					"Only the keys set here are managed: keys added by the server are ignored, and removing a key stops managing it without deleting it. " +
+					"Put credentials in `secret_attributes_wo` instead.",
+				Required:   true,
+				CustomType: jsontypes.NormalizedType{},
+			},
+			"secret_attributes_wo": schema.StringAttribute{
+				MarkdownDescription: "JSON object of secret connection settings (e.g., `password`), merged into `attributes` when the integration is written. " +
+					"Write-only: it is sent to SailPoint but never stored in the state. Change `secret_attributes_wo_version` to send new values.",
+				Optional:   true,
+				WriteOnly:  true,
+				Sensitive:  true,
+				CustomType: jsontypes.NormalizedType{},
+			},
+			"secret_attributes_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "A version number to change whenever `secret_attributes_wo` changes, so the new values are sent.",
+				Optional:            true,
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "The date and time the integration was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time the integration was last modified.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that attributes and secret_attributes_wo are JSON objects with no key in common.
+func (r *serviceDeskIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config serviceDeskIntegrationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes, diags := common.UnmarshalJSONField[map[string]interface{}](config.Attributes)
+	if diags.HasError() {
+		resp.Diagnostics.AddAttributeError(path.Root("attributes"), "Invalid Attributes", "`attributes` must be a JSON object.")
+		return
+	}
+	secrets, diags := common.UnmarshalJSONField[map[string]interface{}](config.SecretAttributesWO)
+	if diags.HasError() {
+		resp.Diagnostics.AddAttributeError(path.Root("secret_attributes_wo"), "Invalid Secret Attributes", "`secret_attributes_wo` must be a JSON object.")
+		return
+	}
+	if attributes == nil || secrets == nil {
+		return
+	}
+	for key := range *secrets {
+		if _, ok := (*attributes)[key]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("secret_attributes_wo"),
+				"Duplicate Attribute",
+				fmt.Sprintf("%q is set in both `attributes` and `secret_attributes_wo`; set it in only one of them.", key),
+			)
+		}
+	}
+}
+
+func (r *serviceDeskIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config serviceDeskIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiReq client.ServiceDeskIntegrationAPI
+	resp.Diagnostics.Append(plan.ApplyTo(ctx, &apiReq, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.CreateServiceDeskIntegration(ctx, &apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SailPoint Service Desk Integration",
+			fmt.Sprintf("Could not create SailPoint Service Desk Integration %q: %s", plan.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Creating SailPoint Service Desk Integration", "Received nil response from SailPoint API")
+		return
+	}
+
+	var state serviceDeskIntegrationModel
+	resp.Diagnostics.Append(state.FromAPI(ctx, apiResp, plan.Attributes, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Successfully created service desk integration", map[string]any{
+		"id":   state.ID.ValueString(),
+		"name": state.Name.ValueString(),
+	})
+}
+
+func (r *serviceDeskIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceDeskIntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	apiResp, err := r.client.GetServiceDeskIntegration(ctx, id)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Info(ctx, "Service desk integration not found, removing from state", map[string]any{"id": id})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Service Desk Integration",
+			fmt.Sprintf("Could not read SailPoint Service Desk Integration %q: %s", id, err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Reading SailPoint Service Desk Integration", "Received nil response from SailPoint API")
+		return
+	}
+
+	var newState serviceDeskIntegrationModel
+	resp.Diagnostics.Append(newState.FromAPI(ctx, apiResp, state.Attributes, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *serviceDeskIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config serviceDeskIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueString()
+	current, err := r.client.GetServiceDeskIntegration(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SailPoint Service Desk Integration",
+			fmt.Sprintf("Could not read SailPoint Service Desk Integration %q before updating it: %s", id, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.ApplyTo(ctx, current, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.UpdateServiceDeskIntegration(ctx, id, current)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SailPoint Service Desk Integration",
+			fmt.Sprintf("Could not update SailPoint Service Desk Integration %q: %s", id, err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Updating SailPoint Service Desk Integration", "Received nil response from SailPoint API")
+		return
+	}
+
+	var newState serviceDeskIntegrationModel
+	resp.Diagnostics.Append(newState.FromAPI(ctx, apiResp, plan.Attributes, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	tflog.Info(ctx, "Successfully updated service desk integration", map[string]any{
+		"id":   newState.ID.ValueString(),
+		"name": newState.Name.ValueString(),
+	})
+}
+
+func (r *serviceDeskIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serviceDeskIntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := r.client.DeleteServiceDeskIntegration(ctx, id); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SailPoint Service Desk Integration",
+			fmt.Sprintf("Could not delete SailPoint Service Desk Integration %q: %s", id, err.Error()),
+		)
+		return
+	}
+	tflog.Info(ctx, "Successfully deleted service desk integration", map[string]any{"id": id})
+}
+
+func (r *serviceDeskIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
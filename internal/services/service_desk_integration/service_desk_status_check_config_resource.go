@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package service_desk_integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// statusCheckConfigID is the fixed ID of the tenant's single status check configuration.
+const statusCheckConfigID = "status-check-configuration"
+
+var (
+	_ resource.Resource                = &statusCheckConfigResource{}
+	_ resource.ResourceWithConfigure   = &statusCheckConfigResource{}
+	_ resource.ResourceWithImportState = &statusCheckConfigResource{}
+)
+
+type statusCheckConfigResource struct {
+	client *client.Client
+}
+
+// NewStatusCheckConfigResource creates a new Service Desk Status Check Config resource.
+func NewStatusCheckConfigResource() resource.Resource {
+	return &statusCheckConfigResource{}
+}
+
+func (r *statusCheckConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_desk_status_check_config"
+}
+
+func (r *statusCheckConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "service desk status check config resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+func (r *statusCheckConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for the SailPoint service desk status check configuration.",
+		MarkdownDescription: "Resource for the SailPoint service desk status check configuration, which controls how often and for how long " +
+			"the tickets created by service desk integrations are polled for completion. There is a single configuration per tenant: " +
+			"destroying the resource only removes it from the state and leaves the tenant settings unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The fixed identifier of the configuration (`" + statusCheckConfigID + "`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provisioning_status_check_interval_minutes": schema.Int64Attribute{
+				MarkdownDescription: "The number of minutes between two checks of a ticket.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"provisioning_max_status_check_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days after which a ticket is no longer checked.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *statusCheckConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan statusCheckConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.put(ctx, &plan, "Error Creating SailPoint Service Desk Status Check Config")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "Successfully created service desk status check config")
+}
+
+func (r *statusCheckConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	apiResp, err := r.client.GetServiceDeskStatusCheckConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Service Desk Status Check Config",
+			fmt.Sprintf("Could not read SailPoint Service Desk Status Check Config: %s", err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Reading SailPoint Service Desk Status Check Config", "Received nil response from SailPoint API")
+		return
+	}
+
+	var state statusCheckConfigModel
+	resp.Diagnostics.Append(state.FromAPI(apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *statusCheckConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan statusCheckConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.put(ctx, &plan, "Error Updating SailPoint Service Desk Status Check Config")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "Successfully updated service desk status check config")
+}
+
+// Delete leaves the tenant settings as they are: the API has no way to restore the defaults.
+func (r *statusCheckConfigResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removed service desk status check config from state; tenant settings are unchanged")
+}
+
+// ImportState accepts any ID, as there is a single status check configuration per tenant.
+func (r *statusCheckConfigResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), statusCheckConfigID)...)
+}
+
+// put writes the plan and returns the new state.
+func (r *statusCheckConfigResource) put(ctx context.Context, plan *statusCheckConfigModel, errorTitle string) (*statusCheckConfigModel, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	apiResp, err := r.client.UpdateServiceDeskStatusCheckConfig(ctx, plan.ToAPI())
+	if err != nil {
+		diagnostics.AddError(errorTitle, fmt.Sprintf("Could not update SailPoint Service Desk Status Check Config: %s", err.Error()))
+		return nil, diagnostics
+	}
+	if apiResp == nil {
+		diagnostics.AddError(errorTitle, "Received nil response from SailPoint API")
+		return nil, diagnostics
+	}
+
+	var state statusCheckConfigModel
+	diagnostics.Append(state.FromAPI(apiResp)...)
+	return &state, diagnostics
+}
@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sod_policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &sodPolicyDataSource{}
+	_ datasource.DataSourceWithConfigure = &sodPolicyDataSource{}
+)
+
+type sodPolicyDataSource struct {
+	client *client.Client
+}
+
+// NewSODPolicyDataSource creates a new data source for SailPoint SOD Policy.
+func NewSODPolicyDataSource() datasource.DataSource {
+	return &sodPolicyDataSource{}
+}
+
+func (d *sodPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sod_policy"
+}
+
+func (d *sodPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "SOD policy data source")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = c
+}
+
+var objectRefDataSourceAttributes = map[string]schema.Attribute{
+	"type": schema.StringAttribute{Computed: true},
+	"id":   schema.StringAttribute{Computed: true},
+	"name": schema.StringAttribute{Computed: true},
+}
+
+func accessCriteriaDataSourceAttribute(side string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("The %s side of the conflict.", side),
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Display name of the %s side of the conflict.", side),
+				Computed:            true,
+			},
+			"criteria_list": schema.SetNestedAttribute{
+				MarkdownDescription: "The entitlements on this side of the conflict.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: objectRefDataSourceAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *sodPolicyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Data source for SailPoint SOD Policy.",
+		MarkdownDescription: "Data source for SailPoint Separation of Duties (SOD) Policy. Look up a SOD policy by ID to retrieve its configuration and report schedule.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the SOD policy.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the SOD policy.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the SOD policy.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the SOD policy (`GENERAL` or `CONFLICTING_ACCESS_BASED`).",
+				Computed:            true,
+			},
+			"owner": schema.SingleNestedAttribute{
+				MarkdownDescription: "The owner of the SOD policy.",
+				Computed:            true,
+				Attributes:          objectRefDataSourceAttributes,
+			},
+			"external_policy_reference": schema.StringAttribute{
+				MarkdownDescription: "Reference to the policy in an external system.",
+				Computed:            true,
+			},
+			"policy_query": schema.StringAttribute{
+				MarkdownDescription: "Search query selecting the identities in violation of a `GENERAL` policy.",
+				Computed:            true,
+			},
+			"compensating_controls": schema.StringAttribute{
+				MarkdownDescription: "Compensating controls that mitigate the risk of a violation.",
+				Computed:            true,
+			},
+			"correction_advice": schema.StringAttribute{
+				MarkdownDescription: "Advice on how to correct a violation of the policy.",
+				Computed:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Whether the policy is enforced (`ENFORCED` or `NOT_ENFORCED`).",
+				Computed:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags for the SOD policy.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"violation_owner_assignment_config": schema.SingleNestedAttribute{
+				MarkdownDescription: "Who owns the violations raised by the policy.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"assignment_rule": schema.StringAttribute{
+						MarkdownDescription: "How violation owners are assigned (`MANAGER` or `STATIC`).",
+						Computed:            true,
+					},
+					"owner": schema.SingleNestedAttribute{
+						MarkdownDescription: "The owner of the violations when `assignment_rule` is `STATIC`.",
+						Computed:            true,
+						Attributes:          objectRefDataSourceAttributes,
+					},
+				},
+			},
+			"conflicting_access_criteria": schema.SingleNestedAttribute{
+				MarkdownDescription: "The two sides of a conflicting access policy.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"left_criteria":  accessCriteriaDataSourceAttribute("left"),
+					"right_criteria": accessCriteriaDataSourceAttribute("right"),
+				},
+			},
+			"schedule": schema.SingleNestedAttribute{
+				MarkdownDescription: "Schedule on which the policy's violation report is run and emailed.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The schedule type.",
+						Computed:            true,
+					},
+					"months": schema.ListAttribute{
+						MarkdownDescription: "Months the schedule runs in.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"days": schema.ListAttribute{
+						MarkdownDescription: "Days the schedule runs on.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"hours": schema.ListAttribute{
+						MarkdownDescription: "Hours of the day the schedule runs at.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"expiration": schema.StringAttribute{
+						MarkdownDescription: "Date and time after which the schedule stops running.",
+						Computed:            true,
+					},
+					"time_zone_id": schema.StringAttribute{
+						MarkdownDescription: "Time zone the hours are expressed in.",
+						Computed:            true,
+					},
+					"recipients": schema.SetAttribute{
+						MarkdownDescription: "IDs of the identities the violation report is emailed to.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+					"email_empty_results": schema.BoolAttribute{
+						MarkdownDescription: "Whether the report is emailed even when there are no violations.",
+						Computed:            true,
+					},
+				},
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "The date and time the SOD policy was created.",
+				Computed:            true,
+			},
+			"modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time the SOD policy was last modified.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *sodPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state sodPolicyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	tflog.Debug(ctx, "Reading SOD policy data source", map[string]any{"id": id})
+
+	apiResp, err := d.client.GetSODPolicy(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint SOD Policy",
+			fmt.Sprintf("Could not read SailPoint SOD Policy %q: %s", id, err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Reading SailPoint SOD Policy", "Received nil response from SailPoint API")
+		return
+	}
+
+	schedule, diags := readSchedule(ctx, d.client, id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(state.FromAPI(ctx, apiResp, schedule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sod_policy
+
+import (
+	"context"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sodPolicyModel represents the Terraform state for a SOD Policy resource and data source.
+type sodPolicyModel struct {
+	ID                             types.String                            `tfsdk:"id"`
+	Name                           types.String                            `tfsdk:"name"`
+	Description                    types.String                            `tfsdk:"description"`
+	Type                           types.String                            `tfsdk:"type"`
+	Owner                          *common.ObjectRefModel                  `tfsdk:"owner"`
+	ExternalPolicyReference        types.String                            `tfsdk:"external_policy_reference"`
+	PolicyQuery                    types.String                            `tfsdk:"policy_query"`
+	CompensatingControls           types.String                            `tfsdk:"compensating_controls"`
+	CorrectionAdvice               types.String                            `tfsdk:"correction_advice"`
+	State                          types.String                            `tfsdk:"state"`
+	Tags                           types.Set                               `tfsdk:"tags"`
+	ViolationOwnerAssignmentConfig *sodViolationOwnerAssignmentConfigModel `tfsdk:"violation_owner_assignment_config"`
+	ConflictingAccessCriteria      *sodConflictingAccessCriteriaModel      `tfsdk:"conflicting_access_criteria"`
+	Schedule                       *sodPolicyScheduleModel                 `tfsdk:"schedule"`
+	Created                        types.String                            `tfsdk:"created"`
+	Modified                       types.String                            `tfsdk:"modified"`
+}
+
+// sodViolationOwnerAssignmentConfigModel configures who owns the violations raised by the policy.
+type sodViolationOwnerAssignmentConfigModel struct {
+	AssignmentRule types.String           `tfsdk:"assignment_rule"`
+	Owner          *common.ObjectRefModel `tfsdk:"owner"`
+}
+
+// sodConflictingAccessCriteriaModel holds the left and right sides of a conflicting access policy.
+type sodConflictingAccessCriteriaModel struct {
+	LeftCriteria  *sodAccessCriteriaModel `tfsdk:"left_criteria"`
+	RightCriteria *sodAccessCriteriaModel `tfsdk:"right_criteria"`
+}
+
+// sodAccessCriteriaModel is one side of a conflicting access policy.
+type sodAccessCriteriaModel struct {
+	Name         types.String            `tfsdk:"name"`
+	CriteriaList []common.ObjectRefModel `tfsdk:"criteria_list"`
+}
+
+// sodPolicyScheduleModel is the schedule on which the policy's violation report is run and emailed.
+type sodPolicyScheduleModel struct {
+	common.ScheduleModel
+	Recipients        types.Set  `tfsdk:"recipients"`
+	EmailEmptyResults types.Bool `tfsdk:"email_empty_results"`
+}
+
+// FromAPI maps the API policy and its schedule (nil when the policy has none) into the Terraform state.
+func (m *sodPolicyModel) FromAPI(ctx context.Context, api *client.SODPolicyAPI, schedule *client.SODPolicyScheduleAPI) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	m.ID = types.StringValue(api.ID)
+	m.Name = types.StringValue(api.Name)
+	m.Description = common.StringOrNull(api.Description)
+	m.Type = types.StringValue(api.Type)
+	m.ExternalPolicyReference = common.StringOrNull(api.ExternalPolicyReference)
+	m.PolicyQuery = common.StringOrNull(api.PolicyQuery)
+	m.CompensatingControls = common.StringOrNull(api.CompensatingControls)
+	m.CorrectionAdvice = common.StringOrNull(api.CorrectionAdvice)
+	m.State = common.StringOrNull(api.State)
+	m.Created = common.StringOrNull(api.Created)
+	m.Modified = common.StringOrNull(api.Modified)
+
+	if api.OwnerRef != nil {
+		owner, diags := common.NewObjectRefFromAPIPtr(ctx, *api.OwnerRef)
+		diagnostics.Append(diags...)
+		m.Owner = owner
+	} else {
+		m.Owner = nil
+	}
+
+	if len(api.Tags) > 0 {
+		tags, diags := types.SetValueFrom(ctx, types.StringType, api.Tags)
+		diagnostics.Append(diags...)
+		m.Tags = tags
+	} else {
+		m.Tags = types.SetNull(types.StringType)
+	}
+
+	m.ViolationOwnerAssignmentConfig = nil
+	if cfg := api.ViolationOwnerAssignmentConfig; cfg != nil && (cfg.AssignmentRule != nil || cfg.OwnerRef != nil) {
+		m.ViolationOwnerAssignmentConfig = &sodViolationOwnerAssignmentConfigModel{
+			AssignmentRule: common.StringOrNull(cfg.AssignmentRule),
+		}
+		if cfg.OwnerRef != nil && cfg.OwnerRef.ID != "" {
+			owner, diags := common.NewObjectRefFromAPIPtr(ctx, *cfg.OwnerRef)
+			diagnostics.Append(diags...)
+			m.ViolationOwnerAssignmentConfig.Owner = owner
+		}
+	}
+
+	m.ConflictingAccessCriteria = nil
+	if criteria := api.ConflictingAccessCriteria; criteria != nil && (criteria.LeftCriteria != nil || criteria.RightCriteria != nil) {
+		m.ConflictingAccessCriteria = &sodConflictingAccessCriteriaModel{}
+		var diags diag.Diagnostics
+		m.ConflictingAccessCriteria.LeftCriteria, diags = accessCriteriaFromAPI(ctx, criteria.LeftCriteria)
+		diagnostics.Append(diags...)
+		m.ConflictingAccessCriteria.RightCriteria, diags = accessCriteriaFromAPI(ctx, criteria.RightCriteria)
+		diagnostics.Append(diags...)
+	}
+
+	m.Schedule = nil
+	if schedule != nil && schedule.Schedule != nil {
+		m.Schedule = &sodPolicyScheduleModel{
+			EmailEmptyResults: types.BoolValue(schedule.EmailEmptyResults),
+		}
+		diagnostics.Append(m.Schedule.ScheduleModel.FromAPI(ctx, *schedule.Schedule)...)
+
+		recipients := make([]string, 0, len(schedule.Recipients))
+		for _, recipient := range schedule.Recipients {
+			recipients = append(recipients, recipient.ID)
+		}
+		var diags diag.Diagnostics
+		m.Schedule.Recipients, diags = types.SetValueFrom(ctx, types.StringType, recipients)
+		diagnostics.Append(diags...)
+	}
+
+	return diagnostics
+}
+
+// ToAPI maps the Terraform state into an API create/replace payload. The schedule is sent separately.
+func (m *sodPolicyModel) ToAPI(ctx context.Context) (*client.SODPolicyAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	api := &client.SODPolicyAPI{
+		Name:                    m.Name.ValueString(),
+		Type:                    m.Type.ValueString(),
+		Description:             stringPointer(m.Description),
+		ExternalPolicyReference: stringPointer(m.ExternalPolicyReference),
+		PolicyQuery:             stringPointer(m.PolicyQuery),
+		CompensatingControls:    stringPointer(m.CompensatingControls),
+		CorrectionAdvice:        stringPointer(m.CorrectionAdvice),
+		State:                   stringPointer(m.State),
+	}
+
+	if m.Owner != nil {
+		owner, diags := common.NewObjectRefToAPIPtr(ctx, *m.Owner)
+		diagnostics.Append(diags...)
+		api.OwnerRef = owner
+	}
+
+	if !m.Tags.IsNull() && !m.Tags.IsUnknown() {
+		var tags []string
+		diagnostics.Append(m.Tags.ElementsAs(ctx, &tags, false)...)
+		api.Tags = tags
+	}
+
+	if cfg := m.ViolationOwnerAssignmentConfig; cfg != nil {
+		api.ViolationOwnerAssignmentConfig = &client.SODViolationOwnerAssignmentConfigAPI{
+			AssignmentRule: stringPointer(cfg.AssignmentRule),
+		}
+		if cfg.Owner != nil {
+			owner, diags := common.NewObjectRefToAPIPtr(ctx, *cfg.Owner)
+			diagnostics.Append(diags...)
+			api.ViolationOwnerAssignmentConfig.OwnerRef = owner
+		}
+	}
+
+	if criteria := m.ConflictingAccessCriteria; criteria != nil {
+		api.ConflictingAccessCriteria = &client.SODPolicyConflictingAccessCriteriaAPI{}
+		var diags diag.Diagnostics
+		api.ConflictingAccessCriteria.LeftCriteria, diags = accessCriteriaToAPI(ctx, criteria.LeftCriteria)
+		diagnostics.Append(diags...)
+		api.ConflictingAccessCriteria.RightCriteria, diags = accessCriteriaToAPI(ctx, criteria.RightCriteria)
+		diagnostics.Append(diags...)
+	}
+
+	return api, diagnostics
+}
+
+// ScheduleToAPI maps the schedule into an API payload. It returns nil when no schedule is configured.
+func (m *sodPolicyModel) ScheduleToAPI(ctx context.Context) (*client.SODPolicyScheduleAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	if m.Schedule == nil {
+		return nil, diagnostics
+	}
+
+	schedule, diags := m.Schedule.ScheduleModel.ToAPI(ctx)
+	diagnostics.Append(diags...)
+
+	var recipients []string
+	if !m.Schedule.Recipients.IsNull() && !m.Schedule.Recipients.IsUnknown() {
+		diagnostics.Append(m.Schedule.Recipients.ElementsAs(ctx, &recipients, false)...)
+	}
+
+	api := &client.SODPolicyScheduleAPI{
+		Schedule:          &schedule,
+		Recipients:        make([]client.ObjectRefAPI, 0, len(recipients)),
+		EmailEmptyResults: m.Schedule.EmailEmptyResults.ValueBool(),
+	}
+	for _, recipient := range recipients {
+		api.Recipients = append(api.Recipients, client.ObjectRefAPI{Type: client.ObjectRefTypeIdentity, ID: recipient})
+	}
+
+	return api, diagnostics
+}
+
+func accessCriteriaFromAPI(ctx context.Context, api *client.SODAccessCriteriaAPI) (*sodAccessCriteriaModel, diag.Diagnostics) {
+	if api == nil {
+		return nil, nil
+	}
+	criteriaList, diags := common.MapSliceFromAPI(ctx, api.CriteriaList, common.NewObjectRefFromAPI)
+	return &sodAccessCriteriaModel{
+		Name:         common.StringOrNull(api.Name),
+		CriteriaList: criteriaList,
+	}, diags
+}
+
+func accessCriteriaToAPI(ctx context.Context, m *sodAccessCriteriaModel) (*client.SODAccessCriteriaAPI, diag.Diagnostics) {
+	if m == nil {
+		return nil, nil
+	}
+	var diagnostics diag.Diagnostics
+	api := &client.SODAccessCriteriaAPI{
+		Name:         stringPointer(m.Name),
+		CriteriaList: make([]client.ObjectRefAPI, len(m.CriteriaList)),
+	}
+	for i := range m.CriteriaList {
+		var diags diag.Diagnostics
+		api.CriteriaList[i], diags = common.NewObjectRefToAPI(ctx, m.CriteriaList[i])
+		diagnostics.Append(diags...)
+	}
+	return api, diagnostics
+}
+
+// stringPointer converts types.String to *string (null/unknown → nil).
+func stringPointer(s types.String) *string {
+	if s.IsNull() || s.IsUnknown() {
+		return nil
+	}
+	v := s.ValueString()
+	return &v
+}
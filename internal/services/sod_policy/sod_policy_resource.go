@@ -0,0 +1,545 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sod_policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &sodPolicyResource{}
+	_ resource.ResourceWithConfigure      = &sodPolicyResource{}
+	_ resource.ResourceWithImportState    = &sodPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &sodPolicyResource{}
+)
+
+type sodPolicyResource struct {
+	client *client.Client
+}
+
+// NewSODPolicyResource creates a new SOD Policy resource.
+func NewSODPolicyResource() resource.Resource {
+	return &sodPolicyResource{}
+}
+
+func (r *sodPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sod_policy"
+}
+
+func (r *sodPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "SOD policy resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+// ownerAttribute returns the schema of an owner reference accepting identities and governance groups.
+func ownerAttribute(description string, required bool) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Required:            required,
+		Optional:            !required,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the owner. One of `IDENTITY`, `GOVERNANCE_GROUP`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.SODPolicyOwnerTypes...),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the owner.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the owner. Resolved by the server from the owner ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// accessCriteriaAttribute returns the schema of one side of a conflicting access policy.
+func accessCriteriaAttribute(side string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("The %s side of the conflict.", side),
+		Required:            true,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Display name of the %s side of the conflict.", side),
+				Optional:            true,
+			},
+			"criteria_list": schema.SetNestedAttribute{
+				MarkdownDescription: "The entitlements on this side of the conflict.",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the access item. Must be `ENTITLEMENT`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(client.SODAccessCriteriaTypes...),
+							},
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the entitlement.",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the entitlement. Resolved by the server from the ID.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *sodPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for SailPoint SOD Policy.",
+		MarkdownDescription: "Resource for SailPoint Separation of Duties (SOD) Policy. `CONFLICTING_ACCESS_BASED` policies flag identities holding " +
+			"entitlements from both sides of `conflicting_access_criteria`; `GENERAL` policies flag identities matching `policy_query`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the SOD policy.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the SOD policy.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the SOD policy.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the SOD policy. One of `GENERAL`, `CONFLICTING_ACCESS_BASED`. Changing this forces a new resource.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.SODPolicyTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner": ownerAttribute("The owner of the SOD policy.", true),
+			"external_policy_reference": schema.StringAttribute{
+				MarkdownDescription: "Reference to the policy in an external system (e.g., a corporate policy document ID).",
+				Optional:            true,
+			},
+			"policy_query": schema.StringAttribute{
+				MarkdownDescription: "Search query selecting the identities in violation. Required when `type` is `GENERAL`.",
+				Optional:            true,
+			},
+			"compensating_controls": schema.StringAttribute{
+				MarkdownDescription: "Compensating controls that mitigate the risk of a violation.",
+				Optional:            true,
+			},
+			"correction_advice": schema.StringAttribute{
+				MarkdownDescription: "Advice on how to correct a violation of the policy.",
+				Optional:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Whether the policy is enforced. One of `ENFORCED`, `NOT_ENFORCED`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.SODPolicyStates...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags for the SOD policy.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"violation_owner_assignment_config": schema.SingleNestedAttribute{
+				MarkdownDescription: "Who owns the violations raised by the policy.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"assignment_rule": schema.StringAttribute{
+						MarkdownDescription: "How violation owners are assigned. `MANAGER` assigns violations to the manager of the violating identity, " +
+							"`STATIC` assigns them to `owner`. When omitted, violations are unowned.",
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.SODViolationAssignmentRules...),
+						},
+					},
+					"owner": ownerAttribute("The owner of the violations. Required when `assignment_rule` is `STATIC`.", false),
+				},
+			},
+			"conflicting_access_criteria": schema.SingleNestedAttribute{
+				MarkdownDescription: "The two sides of a conflicting access policy. An identity holding access from both sides is in violation. " +
+					"Required when `type` is `CONFLICTING_ACCESS_BASED`.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"left_criteria":  accessCriteriaAttribute("left"),
+					"right_criteria": accessCriteriaAttribute("right"),
+				},
+			},
+			"schedule": schema.SingleNestedAttribute{
+				MarkdownDescription: "Schedule on which the policy's violation report is run and emailed. Removing it deletes the schedule.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The schedule type. One of `DAILY`, `WEEKLY`, `MONTHLY`, `ANNUALLY`, `CALENDAR`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.ScheduleTypes...),
+						},
+					},
+					"months": schema.ListAttribute{
+						MarkdownDescription: "Months the schedule runs in (`1`-`12`), for `ANNUALLY` schedules.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"days": schema.ListAttribute{
+						MarkdownDescription: "Days the schedule runs on: weekdays (`MON`-`SUN`) for `WEEKLY` schedules, days of the month (`1`-`31`, `L` for the last day) for `MONTHLY` and `ANNUALLY` schedules, or ISO-8601 dates for `CALENDAR` schedules.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"hours": schema.ListAttribute{
+						MarkdownDescription: "Hours of the day the schedule runs at (`0`-`23`).",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"expiration": schema.StringAttribute{
+						MarkdownDescription: "ISO-8601 date and time after which the schedule stops running.",
+						Optional:            true,
+					},
+					"time_zone_id": schema.StringAttribute{
+						MarkdownDescription: "Time zone the hours are expressed in (e.g., `America/Chicago`). Defaults to GMT.",
+						Optional:            true,
+					},
+					"recipients": schema.SetAttribute{
+						MarkdownDescription: "IDs of the identities the violation report is emailed to.",
+						Required:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+					},
+					"email_empty_results": schema.BoolAttribute{
+						MarkdownDescription: "Whether the report is emailed even when there are no violations. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "The date and time the SOD policy was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified": schema.StringAttribute{
+				MarkdownDescription: "The date and time the SOD policy was last modified.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the attributes each policy type and assignment rule depend on.
+func (r *sodPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config sodPolicyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Type.IsUnknown() && !config.Type.IsNull() {
+		switch config.Type.ValueString() {
+		case client.SODPolicyTypeConflictingAccess:
+			if config.ConflictingAccessCriteria == nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("conflicting_access_criteria"),
+					"Missing Conflicting Access Criteria",
+					fmt.Sprintf("`conflicting_access_criteria` is required when `type` is %q.", client.SODPolicyTypeConflictingAccess),
+				)
+			}
+		case client.SODPolicyTypeGeneral:
+			if config.ConflictingAccessCriteria != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("conflicting_access_criteria"),
+					"Unexpected Conflicting Access Criteria",
+					fmt.Sprintf("`conflicting_access_criteria` is only supported when `type` is %q.", client.SODPolicyTypeConflictingAccess),
+				)
+			}
+			if config.PolicyQuery.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("policy_query"),
+					"Missing Policy Query",
+					fmt.Sprintf("`policy_query` is required when `type` is %q.", client.SODPolicyTypeGeneral),
+				)
+			}
+		}
+	}
+
+	if cfg := config.ViolationOwnerAssignmentConfig; cfg != nil && !cfg.AssignmentRule.IsUnknown() {
+		switch {
+		case cfg.AssignmentRule.ValueString() == "STATIC" && cfg.Owner == nil:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("violation_owner_assignment_config").AtName("owner"),
+				"Missing Violation Owner",
+				"`owner` is required when `assignment_rule` is \"STATIC\".",
+			)
+		case cfg.AssignmentRule.ValueString() != "STATIC" && cfg.Owner != nil:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("violation_owner_assignment_config").AtName("owner"),
+				"Unexpected Violation Owner",
+				"`owner` is only used when `assignment_rule` is \"STATIC\".",
+			)
+		}
+	}
+}
+
+func (r *sodPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sodPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReq, diags := plan.ToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating SOD policy", map[string]any{"name": plan.Name.ValueString()})
+	apiResp, err := r.client.CreateSODPolicy(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SailPoint SOD Policy",
+			fmt.Sprintf("Could not create SailPoint SOD Policy %q: %s", plan.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Creating SailPoint SOD Policy", "Received nil response from SailPoint API")
+		return
+	}
+
+	schedule, diags := r.applySchedule(ctx, apiResp.ID, &plan, nil)
+	if diags.HasError() {
+		// Keep the policy in state so the next apply sets the schedule instead of creating a duplicate policy.
+		var state sodPolicyModel
+		resp.Diagnostics.Append(state.FromAPI(ctx, apiResp, nil)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	var state sodPolicyModel
+	resp.Diagnostics.Append(state.FromAPI(ctx, apiResp, schedule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Successfully created SOD policy", map[string]any{
+		"id":   state.ID.ValueString(),
+		"name": state.Name.ValueString(),
+	})
+}
+
+func (r *sodPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sodPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	apiResp, err := r.client.GetSODPolicy(ctx, id)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Info(ctx, "SOD policy not found, removing from state", map[string]any{"id": id})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint SOD Policy",
+			fmt.Sprintf("Could not read SailPoint SOD Policy %q: %s", id, err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Reading SailPoint SOD Policy", "Received nil response from SailPoint API")
+		return
+	}
+
+	schedule, diags := readSchedule(ctx, r.client, id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(state.FromAPI(ctx, apiResp, schedule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *sodPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan sodPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state sodPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	apiReq, diags := plan.ToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.UpdateSODPolicy(ctx, id, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SailPoint SOD Policy",
+			fmt.Sprintf("Could not update SailPoint SOD Policy %q: %s", id, err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Updating SailPoint SOD Policy", "Received nil response from SailPoint API")
+		return
+	}
+
+	schedule, diags := r.applySchedule(ctx, id, &plan, state.Schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var newState sodPolicyModel
+	resp.Diagnostics.Append(newState.FromAPI(ctx, apiResp, schedule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	tflog.Info(ctx, "Successfully updated SOD policy", map[string]any{
+		"id":   newState.ID.ValueString(),
+		"name": newState.Name.ValueString(),
+	})
+}
+
+func (r *sodPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sodPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := r.client.DeleteSODPolicy(ctx, id); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SailPoint SOD Policy",
+			fmt.Sprintf("Could not delete SailPoint SOD Policy %q: %s", id, err.Error()),
+		)
+		return
+	}
+	tflog.Info(ctx, "Successfully deleted SOD policy", map[string]any{"id": id})
+}
+
+func (r *sodPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applySchedule brings the policy's report schedule in line with the plan: it is set when
+// configured and deleted when it was removed. It returns the resulting schedule, if any.
+func (r *sodPolicyResource) applySchedule(ctx context.Context, id string, plan *sodPolicyModel, prior *sodPolicyScheduleModel) (*client.SODPolicyScheduleAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	if plan.Schedule == nil {
+		if prior != nil {
+			if err := r.client.DeleteSODPolicySchedule(ctx, id); err != nil {
+				diagnostics.AddError(
+					"Error Deleting SailPoint SOD Policy Schedule",
+					fmt.Sprintf("Could not delete the schedule of SailPoint SOD Policy %q: %s", id, err.Error()),
+				)
+			}
+		}
+		return nil, diagnostics
+	}
+
+	scheduleReq, diags := plan.ScheduleToAPI(ctx)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	schedule, err := r.client.SetSODPolicySchedule(ctx, id, scheduleReq)
+	if err != nil {
+		diagnostics.AddError(
+			"Error Setting SailPoint SOD Policy Schedule",
+			fmt.Sprintf("Could not set the schedule of SailPoint SOD Policy %q: %s", id, err.Error()),
+		)
+		return nil, diagnostics
+	}
+	return schedule, diagnostics
+}
+
+// readSchedule fetches the policy's report schedule, returning nil when the policy has none.
+func readSchedule(ctx context.Context, c *client.Client, id string) (*client.SODPolicyScheduleAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	schedule, err := c.GetSODPolicySchedule(ctx, id)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return nil, diagnostics
+		}
+		diagnostics.AddError(
+			"Error Reading SailPoint SOD Policy Schedule",
+			fmt.Sprintf("Could not read the schedule of SailPoint SOD Policy %q: %s", id, err.Error()),
+		)
+		return nil, diagnostics
+	}
+	return schedule, diagnostics
+}
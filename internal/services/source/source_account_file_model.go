@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package source
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sourceAccountFileModel represents the Terraform state for a file aggregated into a delimited file source.
+type sourceAccountFileModel struct {
+	SourceID            types.String `tfsdk:"source_id"`
+	Type                types.String `tfsdk:"type"`
+	FilePath            types.String `tfsdk:"file_path"`
+	FileHash            types.String `tfsdk:"file_hash"`
+	DisableOptimization types.Bool   `tfsdk:"disable_optimization"`
+	Timeout             types.String `tfsdk:"timeout"`
+	TaskID              types.String `tfsdk:"task_id"`
+	CompletionStatus    types.String `tfsdk:"completion_status"`
+}
+
+// readFileWithHash returns the content of a file and its hex-encoded SHA-256 hash.
+func readFileWithHash(path string) ([]byte, string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+	sum := sha256.Sum256(content)
+	return content, hex.EncodeToString(sum[:]), nil
+}
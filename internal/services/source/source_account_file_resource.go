@@ -0,0 +1,339 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package source
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &sourceAccountFileResource{}
+	_ resource.ResourceWithConfigure      = &sourceAccountFileResource{}
+	_ resource.ResourceWithModifyPlan     = &sourceAccountFileResource{}
+	_ resource.ResourceWithValidateConfig = &sourceAccountFileResource{}
+)
+
+type sourceAccountFileResource struct {
+	client *client.Client
+}
+
+// NewSourceAccountFileResource creates a new resource for a file aggregated into a Source.
+func NewSourceAccountFileResource() resource.Resource {
+	return &sourceAccountFileResource{}
+}
+
+// Metadata implements resource.Resource.
+func (r *sourceAccountFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_account_file"
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *sourceAccountFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "source account file resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+// Schema implements resource.Resource.
+func (r *sourceAccountFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads a local CSV file to a SailPoint delimited file source and aggregates it.",
+		MarkdownDescription: "Uploads a local CSV file to a SailPoint delimited file source (e.g. one created with `provision_as_csv`) and waits for the resulting aggregation task. " +
+			"The file is hashed at plan time and uploaded again whenever its content changes. Errors reported by the task fail the apply; its warnings are shown as warnings. " +
+			"Destroying the resource does not remove the aggregated accounts or entitlements from the source.",
+		Attributes: map[string]schema.Attribute{
+			"source_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the delimited file source. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "What the file contains: `ACCOUNTS` or `ENTITLEMENTS`. Defaults to `ACCOUNTS`. Changing this forces a new resource.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(aggregationTypeAccounts),
+				Validators: []validator.String{
+					stringvalidator.OneOf(aggregationTypeAccounts, aggregationTypeEntitlements),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_path": schema.StringAttribute{
+				MarkdownDescription: "The path of the CSV file to upload.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"file_hash": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 hash of the last uploaded file, hex-encoded.",
+				Computed:            true,
+			},
+			"disable_optimization": schema.BoolAttribute{
+				MarkdownDescription: "Process every account, including those unchanged since the last aggregation. Only valid for `ACCOUNTS`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"timeout": common.ResourceTaskTimeoutAttribute(),
+			"task_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the aggregation task of the last upload.",
+				Computed:            true,
+			},
+			"completion_status": schema.StringAttribute{
+				MarkdownDescription: "The completion status of the aggregation task of the last upload (e.g., `SUCCESS`, `WARNING`).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (r *sourceAccountFileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config sourceAccountFileModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.ValueString() == aggregationTypeEntitlements && config.DisableOptimization.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("disable_optimization"),
+			"Invalid Attribute Combination",
+			"disable_optimization only applies to account files.",
+		)
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan. It hashes the file so that a change of
+// content plans an upload, and keeps the task attributes of the last upload otherwise.
+func (r *sourceAccountFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan sourceAccountFileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.FilePath.IsUnknown() {
+		return
+	}
+
+	_, hash, err := readFileWithHash(plan.FilePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file_path"),
+			"Error Reading File",
+			fmt.Sprintf("Could not read file %q: %s", plan.FilePath.ValueString(), err.Error()),
+		)
+		return
+	}
+	plan.FileHash = types.StringValue(hash)
+
+	var state *sourceAccountFileModel
+	if !req.State.Raw.IsNull() {
+		state = &sourceAccountFileModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if state != nil && state.FileHash.Equal(plan.FileHash) && state.SourceID.Equal(plan.SourceID) && state.Type.Equal(plan.Type) {
+		plan.TaskID = state.TaskID
+		plan.CompletionStatus = state.CompletionStatus
+	} else {
+		plan.TaskID = types.StringUnknown()
+		plan.CompletionStatus = types.StringUnknown()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create implements resource.Resource.
+func (r *sourceAccountFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sourceAccountFileModel
+	tflog.Debug(ctx, "Getting plan for source account file resource")
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.upload(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Successfully created SailPoint Source account file resource", map[string]any{
+		"source_id": plan.SourceID.ValueString(),
+		"task_id":   plan.TaskID.ValueString(),
+	})
+}
+
+// Read implements resource.Resource. The uploaded file cannot be read back, so only the
+// existence of the source is checked.
+func (r *sourceAccountFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sourceAccountFileModel
+	tflog.Debug(ctx, "Getting state for source account file resource read")
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceID := state.SourceID.ValueString()
+
+	_, err := r.client.GetSource(ctx, sourceID)
+	if err != nil {
+		// If the source was deleted outside of Terraform, remove the file from state
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Info(ctx, "SailPoint Source not found, removing account file from state", map[string]any{
+				"source_id": sourceID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Source Account File",
+			fmt.Sprintf("Could not read SailPoint Source %q: %s", sourceID, err.Error()),
+		)
+		return
+	}
+}
+
+// Update implements resource.Resource.
+func (r *sourceAccountFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan sourceAccountFileModel
+	tflog.Debug(ctx, "Getting plan for source account file resource update")
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state sourceAccountFileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only a change of content is uploaded; other changes only affect the next upload
+	if plan.FileHash.IsUnknown() || !plan.FileHash.Equal(state.FileHash) {
+		resp.Diagnostics.Append(r.upload(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Successfully updated SailPoint Source account file resource", map[string]any{
+		"source_id": plan.SourceID.ValueString(),
+		"task_id":   plan.TaskID.ValueString(),
+	})
+}
+
+// Delete implements resource.Resource. Aggregated accounts and entitlements are left on the source.
+func (r *sourceAccountFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sourceAccountFileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Removed SailPoint Source account file from state; aggregated data is left on the source", map[string]any{
+		"source_id": state.SourceID.ValueString(),
+	})
+}
+
+// upload uploads the file to the source, waits for the aggregation task and records the file hash
+// and task in m.
+func (r *sourceAccountFileResource) upload(ctx context.Context, m *sourceAccountFileModel) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	sourceID := m.SourceID.ValueString()
+	filePath := m.FilePath.ValueString()
+	aggregationType := m.Type.ValueString()
+
+	timeout, diags := common.TaskTimeout(m.Timeout)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	content, hash, err := readFileWithHash(filePath)
+	if err != nil {
+		diagnostics.AddError("Error Reading File", fmt.Sprintf("Could not read file %q: %s", filePath, err.Error()))
+		return diagnostics
+	}
+	if !m.FileHash.IsUnknown() && m.FileHash.ValueString() != hash {
+		diagnostics.AddError(
+			"File Changed Since Plan",
+			fmt.Sprintf("The content of %q changed after the plan was made. Run terraform apply again to upload the new content.", filePath),
+		)
+		return diagnostics
+	}
+
+	file := &client.SourceLoadFileAPI{Name: filepath.Base(filePath), Content: bytes.NewReader(content)}
+
+	tflog.Debug(ctx, "Uploading file to source via SailPoint API", map[string]any{
+		"source_id": sourceID,
+		"type":      aggregationType,
+		"file":      file.Name,
+		"size":      len(content),
+	})
+	var loadTask *client.SourceLoadTaskAPI
+	if aggregationType == aggregationTypeEntitlements {
+		loadTask, err = r.client.LoadSourceEntitlements(ctx, sourceID, file)
+	} else {
+		loadTask, err = r.client.LoadSourceAccounts(ctx, sourceID, m.DisableOptimization.ValueBool(), file)
+	}
+	if err != nil {
+		diagnostics.AddError(
+			"Error Uploading SailPoint Source File",
+			fmt.Sprintf("Could not upload %q to SailPoint Source %q: %s", filePath, sourceID, err.Error()),
+		)
+		return diagnostics
+	}
+
+	task, diags := common.WaitForTask(ctx, r.client, loadTask.Task.ID, timeout, func(message string) {
+		tflog.Info(ctx, message, map[string]any{"source_id": sourceID})
+	})
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	m.FileHash = types.StringValue(hash)
+	m.TaskID = types.StringValue(task.ID)
+	m.CompletionStatus = types.StringValue(*task.CompletionStatus)
+	return diagnostics
+}
@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package source
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Aggregation types of the source aggregate action.
+const (
+	aggregationTypeAccounts     = "ACCOUNTS"
+	aggregationTypeEntitlements = "ENTITLEMENTS"
+)
+
+var (
+	_ action.Action                   = &sourceAggregateAction{}
+	_ action.ActionWithConfigure      = &sourceAggregateAction{}
+	_ action.ActionWithValidateConfig = &sourceAggregateAction{}
+)
+
+type sourceAggregateAction struct {
+	client *client.Client
+}
+
+// sourceAggregateActionModel represents the configuration of the source aggregate action.
+type sourceAggregateActionModel struct {
+	SourceID            types.String `tfsdk:"source_id"`
+	Type                types.String `tfsdk:"type"`
+	DisableOptimization types.Bool   `tfsdk:"disable_optimization"`
+	Timeout             types.String `tfsdk:"timeout"`
+}
+
+// NewSourceAggregateAction creates a new action aggregating a Source.
+func NewSourceAggregateAction() action.Action {
+	return &sourceAggregateAction{}
+}
+
+// Metadata implements action.Action.
+func (a *sourceAggregateAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_aggregate"
+}
+
+// Configure implements action.ActionWithConfigure.
+func (a *sourceAggregateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "source aggregate action")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	a.client = c
+}
+
+// Schema implements action.Action.
+func (a *sourceAggregateAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an account or entitlement aggregation of a SailPoint Source.",
+		MarkdownDescription: "Runs an account or entitlement aggregation of a SailPoint Source and waits for the aggregation task to complete. " +
+			"Errors reported by the task fail the action; its warnings are shown as warnings.",
+		Attributes: map[string]schema.Attribute{
+			"source_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the source to aggregate.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "What to aggregate: `ACCOUNTS` or `ENTITLEMENTS`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(aggregationTypeAccounts, aggregationTypeEntitlements),
+				},
+			},
+			"disable_optimization": schema.BoolAttribute{
+				MarkdownDescription: "Process every account, including those unchanged since the last aggregation. Only valid for `ACCOUNTS`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"timeout": common.TaskTimeoutAttribute(),
+		},
+	}
+}
+
+// ValidateConfig implements action.ActionWithValidateConfig.
+func (a *sourceAggregateAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config sourceAggregateActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.ValueString() == aggregationTypeEntitlements && config.DisableOptimization.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("disable_optimization"),
+			"Invalid Attribute Combination",
+			"disable_optimization only applies to account aggregations.",
+		)
+	}
+}
+
+// Invoke implements action.Action.
+func (a *sourceAggregateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sourceAggregateActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := common.TaskTimeout(config.Timeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceID := config.SourceID.ValueString()
+	aggregationType := config.Type.ValueString()
+
+	var loadTask *client.SourceLoadTaskAPI
+	var err error
+	if aggregationType == aggregationTypeEntitlements {
+		loadTask, err = a.client.LoadSourceEntitlements(ctx, sourceID, nil)
+	} else {
+		loadTask, err = a.client.LoadSourceAccounts(ctx, sourceID, config.DisableOptimization.ValueBool(), nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Aggregating SailPoint Source",
+			fmt.Sprintf("Could not start the %s aggregation of SailPoint Source %q: %s", aggregationType, sourceID, err.Error()),
+		)
+		return
+	}
+
+	taskID := loadTask.Task.ID
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Started %s aggregation of source %s (task %s)", aggregationType, sourceID, taskID)})
+
+	task, diags := common.WaitForTask(ctx, a.client, taskID, timeout, func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("%s aggregation of source %s completed with status %s", aggregationType, sourceID, *task.CompletionStatus)})
+	tflog.Info(ctx, "Successfully aggregated SailPoint Source", map[string]any{
+		"source_id": sourceID,
+		"type":      aggregationType,
+		"task_id":   taskID,
+	})
+}
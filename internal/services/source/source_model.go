@@ -39,6 +39,12 @@ type sourceModel struct {
 	Modified                  types.String           `tfsdk:"modified"`
 }
 
+// sourceResourceModel adds the tag attributes, which only the resource has, to sourceModel.
+type sourceResourceModel struct {
+	sourceModel
+	common.TagsModel
+}
+
 // FromAPI maps fields from the API response to the Terraform model.
 func (m *sourceModel) FromAPI(ctx context.Context, api client.SourceAPI) diag.Diagnostics {
 	var diagnostics diag.Diagnostics
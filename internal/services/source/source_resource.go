@@ -12,12 +12,14 @@ import (
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common/planmodifiers"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,6 +28,8 @@ var (
 	_ resource.Resource                = &sourceResource{}
 	_ resource.ResourceWithConfigure   = &sourceResource{}
 	_ resource.ResourceWithImportState = &sourceResource{}
+	_ resource.ResourceWithIdentity    = &sourceResource{}
+	_ resource.ResourceWithModifyPlan  = &sourceResource{}
 )
 
 type sourceResource struct {
@@ -79,6 +83,9 @@ func (r *sourceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					"type": schema.StringAttribute{
 						MarkdownDescription: "The type of the owner (e.g., `IDENTITY`).",
 						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.OwnerTypes...),
+						},
 					},
 					"id": schema.StringAttribute{
 						MarkdownDescription: "The ID of the owner.",
@@ -100,6 +107,9 @@ func (r *sourceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					"type": schema.StringAttribute{
 						MarkdownDescription: "The type of the cluster (e.g., `CLUSTER`).",
 						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.ObjectRefTypeCluster),
+						},
 					},
 					"id": schema.StringAttribute{
 						MarkdownDescription: "The ID of the cluster.",
@@ -214,13 +224,25 @@ func (r *sourceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				MarkdownDescription: "The date and time when the source was last modified.",
 				Computed:            true,
 			},
+			"tags":     common.TagsAttribute(),
+			"tags_all": common.TagsAllAttribute(),
 		},
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (r *sourceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IDIdentitySchema()
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+func (r *sourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ModifyPlanTags(ctx, r.client, req, resp)
+}
+
 // Create implements resource.Resource.
 func (r *sourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan sourceModel
+	var plan sourceResourceModel
 	tflog.Debug(ctx, "Getting plan for source resource")
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -269,7 +291,10 @@ func (r *sourceResource) Create(ctx context.Context, req resource.CreateRequest,
 		state.ConnectorAttributes = plan.ConnectorAttributes
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tags, diags := plan.TagsModel.Apply(ctx, r.client, client.ObjectRefTypeSource, state.ID.ValueString(), nil)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &sourceResourceModel{sourceModel: state, TagsModel: tags})...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -281,7 +306,7 @@ func (r *sourceResource) Create(ctx context.Context, req resource.CreateRequest,
 
 // Read implements resource.Resource.
 func (r *sourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var priorState sourceModel
+	var priorState sourceResourceModel
 	tflog.Debug(ctx, "Getting state for source resource read")
 	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
 	if resp.Diagnostics.HasError() {
@@ -328,7 +353,14 @@ func (r *sourceResource) Read(ctx context.Context, req resource.ReadRequest, res
 		state.ConnectorAttributes = priorState.ConnectorAttributes
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tags := priorState.TagsModel
+	resp.Diagnostics.Append(tags.Read(ctx, r.client, client.ObjectRefTypeSource, state.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &sourceResourceModel{sourceModel: state, TagsModel: tags})...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -340,14 +372,14 @@ func (r *sourceResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 // Update implements resource.Resource.
 func (r *sourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan sourceModel
+	var plan sourceResourceModel
 	tflog.Debug(ctx, "Getting plan for source resource update")
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var state sourceModel
+	var state sourceResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -359,7 +391,13 @@ func (r *sourceResource) Update(ctx context.Context, req resource.UpdateRequest,
 	tflog.Debug(ctx, "Building patch operations for source update", map[string]any{
 		"id": sourceID,
 	})
-	patchOperations, diags := plan.ToPatchOperations(ctx, &state)
+	patchOperations, diags := plan.ToPatchOperations(ctx, &state.sourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, diags := plan.TagsModel.Apply(ctx, r.client, client.ObjectRefTypeSource, sourceID, &state.TagsModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -369,6 +407,8 @@ func (r *sourceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		tflog.Debug(ctx, "No changes detected, skipping update", map[string]any{
 			"id": sourceID,
 		})
+		resp.Diagnostics.Append(resp.State.Set(ctx, &sourceResourceModel{sourceModel: state.sourceModel, TagsModel: tags})...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 		return
 	}
 
@@ -402,7 +442,8 @@ func (r *sourceResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Preserve user-managed connector_attributes from the plan
 	newState.ConnectorAttributes = plan.ConnectorAttributes
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &sourceResourceModel{sourceModel: newState, TagsModel: tags})...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: newState.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -414,7 +455,7 @@ func (r *sourceResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 // Delete implements resource.Resource.
 func (r *sourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state sourceModel
+	var state sourceResourceModel
 	tflog.Debug(ctx, "Getting state for source resource deletion")
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -443,7 +484,7 @@ func (r *sourceResource) ImportState(ctx context.Context, req resource.ImportSta
 		"id": req.ID,
 	})
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 
 	tflog.Info(ctx, "Successfully imported SailPoint Source resource", map[string]any{
 		"id": req.ID,
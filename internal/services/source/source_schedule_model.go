@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package source
+
+import (
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sourceScheduleModel represents the Terraform state for a Source Schedule.
+type sourceScheduleModel struct {
+	SourceID       types.String `tfsdk:"source_id"`
+	Type           types.String `tfsdk:"type"`
+	CronExpression types.String `tfsdk:"cron_expression"`
+}
+
+// FromAPI maps fields from the API response to the Terraform model.
+func (m *sourceScheduleModel) FromAPI(api client.SourceScheduleAPI, sourceID string) {
+	m.SourceID = types.StringValue(sourceID)
+	m.Type = types.StringValue(api.Type)
+	m.CronExpression = types.StringValue(api.CronExpression)
+}
+
+// ToAPI maps fields from the Terraform model to the API create request.
+func (m *sourceScheduleModel) ToAPI() client.SourceScheduleAPI {
+	return client.SourceScheduleAPI{
+		Type:           m.Type.ValueString(),
+		CronExpression: m.CronExpression.ValueString(),
+	}
+}
+
+// ToPatchOperations returns the JSON Patch operations setting the planned cron expression.
+func (m *sourceScheduleModel) ToPatchOperations() []client.JSONPatchOperation {
+	return []client.JSONPatchOperation{
+		client.NewReplacePatch("/cronExpression", m.CronExpression.ValueString()),
+	}
+}
@@ -0,0 +1,365 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &sourceScheduleResource{}
+	_ resource.ResourceWithConfigure   = &sourceScheduleResource{}
+	_ resource.ResourceWithImportState = &sourceScheduleResource{}
+)
+
+type sourceScheduleResource struct {
+	client *client.Client
+}
+
+// NewSourceScheduleResource creates a new resource for Source Schedule.
+func NewSourceScheduleResource() resource.Resource {
+	return &sourceScheduleResource{}
+}
+
+// Metadata implements resource.Resource.
+func (r *sourceScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_schedule"
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *sourceScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "source schedule resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+// Schema implements resource.Resource.
+func (r *sourceScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an aggregation schedule of a SailPoint Source.",
+		MarkdownDescription: "Manages an aggregation schedule of a SailPoint Source. " +
+			"A source has at most one schedule per type: `ACCOUNT_AGGREGATION` for accounts and `GROUP_AGGREGATION` for entitlements. " +
+			"If the source already has a schedule of this type, it is adopted and updated to match your configuration.",
+		Attributes: map[string]schema.Attribute{
+			"source_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the source. Changing this forces a new resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the schedule: `ACCOUNT_AGGREGATION` or `GROUP_AGGREGATION`. Changing this forces a new resource.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.SourceScheduleTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cron_expression": schema.StringAttribute{
+				MarkdownDescription: "When the aggregation runs, as a Quartz cron expression with seconds first and exactly one of day-of-month and day-of-week set to `?` " +
+					"(e.g., `0 0 5,13,21 * * ?` runs at 5am, 1pm and 9pm every day).",
+				Required: true,
+				Validators: []validator.String{
+					validators.QuartzCron(),
+				},
+			},
+		},
+	}
+}
+
+// Create implements resource.Resource.
+func (r *sourceScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sourceScheduleModel
+	tflog.Debug(ctx, "Getting plan for source schedule resource")
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceID := plan.SourceID.ValueString()
+	scheduleType := plan.Type.ValueString()
+
+	// Check if the source already has a schedule of this type.
+	// If it does, adopt it via PATCH; otherwise, create a new one via POST.
+	tflog.Debug(ctx, "Checking if source schedule already exists for adoption", map[string]any{
+		"source_id":     sourceID,
+		"schedule_type": scheduleType,
+	})
+	_, getErr := r.client.GetSourceSchedule(ctx, sourceID, scheduleType)
+
+	var apiResponse *client.SourceScheduleAPI
+	switch {
+	case getErr == nil:
+		// Schedule already exists — adopt it via PATCH update
+		tflog.Info(ctx, "Source schedule already exists, adopting via update", map[string]any{
+			"source_id":     sourceID,
+			"schedule_type": scheduleType,
+		})
+		var err error
+		apiResponse, err = r.client.PatchSourceSchedule(ctx, sourceID, scheduleType, plan.ToPatchOperations())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Adopting SailPoint Source Schedule",
+				fmt.Sprintf("Could not adopt existing SailPoint Source Schedule %q for source %q: %s",
+					scheduleType, sourceID, err.Error()),
+			)
+			return
+		}
+
+	case errors.Is(getErr, client.ErrNotFound):
+		// Schedule does not exist — create it via POST
+		tflog.Debug(ctx, "Creating source schedule via SailPoint API", map[string]any{
+			"source_id":     sourceID,
+			"schedule_type": scheduleType,
+		})
+		apiCreateRequest := plan.ToAPI()
+		var err error
+		apiResponse, err = r.client.CreateSourceSchedule(ctx, sourceID, &apiCreateRequest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating SailPoint Source Schedule",
+				fmt.Sprintf("Could not create SailPoint Source Schedule %q for source %q: %s",
+					scheduleType, sourceID, err.Error()),
+			)
+			return
+		}
+
+	default:
+		// Unexpected error checking for existing schedule
+		resp.Diagnostics.AddError(
+			"Error Checking SailPoint Source Schedule",
+			fmt.Sprintf("Could not check if SailPoint Source Schedule %q already exists for source %q: %s",
+				scheduleType, sourceID, getErr.Error()),
+		)
+		return
+	}
+
+	if apiResponse == nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SailPoint Source Schedule",
+			"Received nil response from SailPoint API",
+		)
+		return
+	}
+
+	// Map the API response back to the resource model
+	var state sourceScheduleModel
+	state.FromAPI(*apiResponse, sourceID)
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Successfully created SailPoint Source Schedule resource", map[string]any{
+		"source_id":     sourceID,
+		"schedule_type": state.Type.ValueString(),
+	})
+}
+
+// Read implements resource.Resource.
+func (r *sourceScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sourceScheduleModel
+	tflog.Debug(ctx, "Getting state for source schedule resource read")
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceID := state.SourceID.ValueString()
+	scheduleType := state.Type.ValueString()
+
+	// Read the schedule from SailPoint
+	tflog.Debug(ctx, "Fetching source schedule from SailPoint", map[string]any{
+		"source_id":     sourceID,
+		"schedule_type": scheduleType,
+	})
+	apiResponse, err := r.client.GetSourceSchedule(ctx, sourceID, scheduleType)
+	if err != nil {
+		// If resource was deleted outside of Terraform, remove it from state
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Info(ctx, "SailPoint Source Schedule not found, removing from state", map[string]any{
+				"source_id":     sourceID,
+				"schedule_type": scheduleType,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Source Schedule",
+			fmt.Sprintf("Could not read SailPoint Source Schedule %q for source %q: %s",
+				scheduleType, sourceID, err.Error()),
+		)
+		tflog.Error(ctx, "Failed to read SailPoint Source Schedule", map[string]any{
+			"source_id":     sourceID,
+			"schedule_type": scheduleType,
+			"error":         err.Error(),
+		})
+		return
+	}
+
+	if apiResponse == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Source Schedule",
+			"Received nil response from SailPoint API",
+		)
+		return
+	}
+
+	// Map the response to the resource model
+	state.FromAPI(*apiResponse, sourceID)
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Successfully read SailPoint Source Schedule resource", map[string]any{
+		"source_id":     sourceID,
+		"schedule_type": scheduleType,
+	})
+}
+
+// Update implements resource.Resource.
+func (r *sourceScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan sourceScheduleModel
+	tflog.Debug(ctx, "Getting plan for source schedule resource update")
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceID := plan.SourceID.ValueString()
+	scheduleType := plan.Type.ValueString()
+
+	// Update the schedule via the API client (PATCH)
+	tflog.Debug(ctx, "Updating source schedule via SailPoint API", map[string]any{
+		"source_id":     sourceID,
+		"schedule_type": scheduleType,
+	})
+	apiResponse, err := r.client.PatchSourceSchedule(ctx, sourceID, scheduleType, plan.ToPatchOperations())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SailPoint Source Schedule",
+			fmt.Sprintf("Could not update SailPoint Source Schedule %q for source %q: %s",
+				scheduleType, sourceID, err.Error()),
+		)
+		tflog.Error(ctx, "Failed to update SailPoint Source Schedule", map[string]any{
+			"source_id":     sourceID,
+			"schedule_type": scheduleType,
+			"error":         err.Error(),
+		})
+		return
+	}
+
+	if apiResponse == nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SailPoint Source Schedule",
+			"Received nil response from SailPoint API",
+		)
+		return
+	}
+
+	// Map the API response back to the resource model
+	var newState sourceScheduleModel
+	newState.FromAPI(*apiResponse, sourceID)
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Successfully updated SailPoint Source Schedule resource", map[string]any{
+		"source_id":     sourceID,
+		"schedule_type": scheduleType,
+	})
+}
+
+// Delete implements resource.Resource.
+func (r *sourceScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sourceScheduleModel
+	tflog.Debug(ctx, "Getting state for source schedule resource deletion")
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceID := state.SourceID.ValueString()
+	scheduleType := state.Type.ValueString()
+
+	tflog.Debug(ctx, "Deleting source schedule via SailPoint API", map[string]any{
+		"source_id":     sourceID,
+		"schedule_type": scheduleType,
+	})
+	err := r.client.DeleteSourceSchedule(ctx, sourceID, scheduleType)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SailPoint Source Schedule",
+			fmt.Sprintf("Could not delete SailPoint Source Schedule %q for source %q: %s",
+				scheduleType, sourceID, err.Error()),
+		)
+		tflog.Error(ctx, "Failed to delete SailPoint Source Schedule", map[string]any{
+			"source_id":     sourceID,
+			"schedule_type": scheduleType,
+			"error":         err.Error(),
+		})
+		return
+	}
+	tflog.Info(ctx, "Successfully deleted SailPoint Source Schedule resource", map[string]any{
+		"source_id":     sourceID,
+		"schedule_type": scheduleType,
+	})
+}
+
+// ImportState implements resource.ResourceWithImportState.
+// Import format: source_id/type.
+func (r *sourceScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing source schedule resource", map[string]any{
+		"import_id": req.ID,
+	})
+
+	// Parse the import ID (format: source_id/type)
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || !slices.Contains(client.SourceScheduleTypes, parts[1]) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: source_id/type, with type one of %s, got: %s",
+				strings.Join(client.SourceScheduleTypes, ", "), req.ID),
+		)
+		return
+	}
+
+	sourceID := parts[0]
+	scheduleType := parts[1]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_id"), sourceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), scheduleType)...)
+
+	tflog.Info(ctx, "Successfully imported SailPoint Source Schedule resource", map[string]any{
+		"source_id":     sourceID,
+		"schedule_type": scheduleType,
+	})
+}
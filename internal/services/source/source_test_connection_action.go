@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ action.Action              = &sourceTestConnectionAction{}
+	_ action.ActionWithConfigure = &sourceTestConnectionAction{}
+)
+
+type sourceTestConnectionAction struct {
+	client *client.Client
+}
+
+// sourceTestConnectionActionModel represents the configuration of the source test connection action.
+type sourceTestConnectionActionModel struct {
+	SourceID types.String `tfsdk:"source_id"`
+}
+
+// NewSourceTestConnectionAction creates a new action testing the connection of a Source.
+func NewSourceTestConnectionAction() action.Action {
+	return &sourceTestConnectionAction{}
+}
+
+// Metadata implements action.Action.
+func (a *sourceTestConnectionAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_test_connection"
+}
+
+// Configure implements action.ActionWithConfigure.
+func (a *sourceTestConnectionAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "source test connection action")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	a.client = c
+}
+
+// Schema implements action.Action.
+func (a *sourceTestConnectionAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Tests the connection of a SailPoint Source.",
+		MarkdownDescription: "Tests the connection of a SailPoint Source with its current configuration, as the **Test Connection** button does in the UI. " +
+			"A failed test is reported as an error, with the details returned by the connector.",
+		Attributes: map[string]schema.Attribute{
+			"source_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the source to test.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// Invoke implements action.Action.
+func (a *sourceTestConnectionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sourceTestConnectionActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceID := config.SourceID.ValueString()
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Testing the connection of source %s", sourceID)})
+	result, err := a.client.CheckSourceConnection(ctx, sourceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Testing SailPoint Source Connection",
+			fmt.Sprintf("Could not test the connection of SailPoint Source %q: %s", sourceID, err.Error()),
+		)
+		return
+	}
+
+	if result.Status != client.SourceConnectionStatusSuccess {
+		details, _ := json.Marshal(result.Details)
+		resp.Diagnostics.AddError(
+			"SailPoint Source Connection Failed",
+			fmt.Sprintf("The connection test of SailPoint Source %q returned status %s. Details: %s", sourceID, result.Status, details),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Connection of source %s succeeded in %dms", sourceID, result.ElapsedMillis)})
+	tflog.Info(ctx, "Successfully tested SailPoint Source connection", map[string]any{
+		"source_id":      sourceID,
+		"elapsed_millis": result.ElapsedMillis,
+	})
+}
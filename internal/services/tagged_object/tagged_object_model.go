@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagged_object
+
+import (
+	"context"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// taggedObjectModel represents the Terraform state for the Tagged Object resource.
+type taggedObjectModel struct {
+	ID        types.String          `tfsdk:"id"`
+	ObjectRef common.ObjectRefModel `tfsdk:"object_ref"`
+	Tags      types.Set             `tfsdk:"tags"`
+}
+
+// taggedObjectsDataSourceModel represents the Terraform state for the Tagged Objects data source.
+type taggedObjectsDataSourceModel struct {
+	Tag        types.String             `tfsdk:"tag"`
+	ObjectType types.String             `tfsdk:"object_type"`
+	Objects    []taggedObjectEntryModel `tfsdk:"objects"`
+}
+
+// taggedObjectEntryModel is one object returned by the Tagged Objects data source.
+type taggedObjectEntryModel struct {
+	ObjectRef common.ObjectRefModel `tfsdk:"object_ref"`
+	Tags      types.Set             `tfsdk:"tags"`
+}
+
+// taggedObjectID builds the resource ID, which is also its import ID.
+func taggedObjectID(objectType, id string) string {
+	return objectType + "/" + id
+}
+
+// FromAPI maps the API response into the Terraform state.
+func (m *taggedObjectModel) FromAPI(ctx context.Context, api *client.TaggedObjectAPI) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	m.ID = types.StringValue(taggedObjectID(api.ObjectRef.Type, api.ObjectRef.ID))
+	diagnostics.Append(m.ObjectRef.FromAPI(ctx, api.ObjectRef)...)
+
+	tags, diags := types.SetValueFrom(ctx, types.StringType, api.Tags)
+	diagnostics.Append(diags...)
+	m.Tags = tags
+
+	return diagnostics
+}
+
+// ToAPI maps the Terraform model to the API request body.
+func (m *taggedObjectModel) ToAPI(ctx context.Context) (*client.TaggedObjectAPI, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	ref, diags := m.ObjectRef.ToAPI(ctx)
+	diagnostics.Append(diags...)
+
+	tags := make([]string, 0)
+	if !m.Tags.IsNull() && !m.Tags.IsUnknown() {
+		diagnostics.Append(m.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+
+	return &client.TaggedObjectAPI{ObjectRef: ref, Tags: tags}, diagnostics
+}
+
+// taggedObjectEntryFromAPI maps one listed tagged object into the data source state.
+func taggedObjectEntryFromAPI(ctx context.Context, api client.TaggedObjectAPI) (taggedObjectEntryModel, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	var entry taggedObjectEntryModel
+
+	diagnostics.Append(entry.ObjectRef.FromAPI(ctx, api.ObjectRef)...)
+	tags, diags := types.SetValueFrom(ctx, types.StringType, api.Tags)
+	diagnostics.Append(diags...)
+	entry.Tags = tags
+
+	return entry, diagnostics
+}
@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagged_object
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &taggedObjectResource{}
+	_ resource.ResourceWithConfigure   = &taggedObjectResource{}
+	_ resource.ResourceWithImportState = &taggedObjectResource{}
+)
+
+type taggedObjectResource struct {
+	client *client.Client
+}
+
+// NewTaggedObjectResource creates a new Tagged Object resource.
+func NewTaggedObjectResource() resource.Resource {
+	return &taggedObjectResource{}
+}
+
+func (r *taggedObjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tagged_object"
+}
+
+func (r *taggedObjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "tagged object resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+func (r *taggedObjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for the tags set on a SailPoint object.",
+		MarkdownDescription: "Resource for the tags set on a SailPoint object, such as a role, access profile or entitlement. " +
+			"The resource owns the full set of tags on the object: tags added outside Terraform are removed on the next apply, " +
+			"and destroying the resource removes every tag from the object. " +
+			"Do not use it on an object whose resource also sets `tags` or receives the provider's `default_tags`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The tagged object, as `type/id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_ref": schema.SingleNestedAttribute{
+				MarkdownDescription: "The object to tag.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the object. One of `" + strings.Join(client.TaggableObjectTypes, "`, `") + "`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.TaggableObjectTypes...),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the object.",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the object.",
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "The tags to set on the object.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+func (r *taggedObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan taggedObjectModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := taggedObjectID(plan.ObjectRef.Type.ValueString(), plan.ObjectRef.ID.ValueString())
+	tflog.Debug(ctx, "Creating tagged object", map[string]any{"object": id})
+
+	state, diags := r.set(ctx, &plan, "Error Creating SailPoint Tagged Object", "create")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "Successfully created tagged object", map[string]any{"object": id})
+}
+
+func (r *taggedObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state taggedObjectModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectType := state.ObjectRef.Type.ValueString()
+	objectID := state.ObjectRef.ID.ValueString()
+	apiResp, err := r.client.GetTaggedObject(ctx, objectType, objectID)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			tflog.Info(ctx, "Tagged object not found, removing from state", map[string]any{
+				"object": taggedObjectID(objectType, objectID),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Tagged Object",
+			fmt.Sprintf("Could not read SailPoint Tagged Object %q: %s", taggedObjectID(objectType, objectID), err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Reading SailPoint Tagged Object", "Received nil response from SailPoint API")
+		return
+	}
+
+	resp.Diagnostics.Append(state.FromAPI(ctx, apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *taggedObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan taggedObjectModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.set(ctx, &plan, "Error Updating SailPoint Tagged Object", "update")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "Successfully updated tagged object", map[string]any{"object": state.ID.ValueString()})
+}
+
+func (r *taggedObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state taggedObjectModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectType := state.ObjectRef.Type.ValueString()
+	objectID := state.ObjectRef.ID.ValueString()
+	if err := r.client.DeleteTaggedObject(ctx, objectType, objectID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SailPoint Tagged Object",
+			fmt.Sprintf("Could not delete SailPoint Tagged Object %q: %s", taggedObjectID(objectType, objectID), err.Error()),
+		)
+		return
+	}
+	tflog.Info(ctx, "Successfully deleted tagged object", map[string]any{"object": taggedObjectID(objectType, objectID)})
+}
+
+// ImportState implements resource.ResourceWithImportState.
+// Import format: type/id.
+func (r *taggedObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: type/id (e.g., ROLE/2c9180...), got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_ref").AtName("type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_ref").AtName("id"), parts[1])...)
+}
+
+// set PUTs the planned tags and returns the resulting state.
+func (r *taggedObjectResource) set(ctx context.Context, plan *taggedObjectModel, errorTitle, operation string) (*taggedObjectModel, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	body, diags := plan.ToAPI(ctx)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	apiResp, err := r.client.SetTaggedObject(ctx, body)
+	if err != nil {
+		diagnostics.AddError(
+			errorTitle,
+			fmt.Sprintf("Could not %s SailPoint Tagged Object %q: %s", operation, taggedObjectID(body.ObjectRef.Type, body.ObjectRef.ID), err.Error()),
+		)
+		return nil, diagnostics
+	}
+	if apiResp == nil {
+		diagnostics.AddError(errorTitle, "Received nil response from SailPoint API")
+		return nil, diagnostics
+	}
+
+	var state taggedObjectModel
+	diagnostics.Append(state.FromAPI(ctx, apiResp)...)
+	return &state, diagnostics
+}
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagged_object
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &taggedObjectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &taggedObjectsDataSource{}
+)
+
+type taggedObjectsDataSource struct {
+	client *client.Client
+}
+
+// NewTaggedObjectsDataSource creates a new data source for SailPoint Tagged Objects.
+func NewTaggedObjectsDataSource() datasource.DataSource {
+	return &taggedObjectsDataSource{}
+}
+
+func (d *taggedObjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tagged_objects"
+}
+
+func (d *taggedObjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "tagged objects data source")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = c
+}
+
+func (d *taggedObjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source for SailPoint Tagged Objects.",
+		MarkdownDescription: "Data source for SailPoint Tagged Objects. Lists the objects carrying a given tag. " +
+			"Each `object_ref` has the same `type`/`id`/`name` shape as the object references used by other resources, " +
+			"so entries can be passed to them directly.",
+		Attributes: map[string]schema.Attribute{
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "The tag to look up.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"object_type": schema.StringAttribute{
+				MarkdownDescription: "Only return objects of this type. One of `" + strings.Join(client.TaggableObjectTypes, "`, `") + "`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.TaggableObjectTypes...),
+				},
+			},
+			"objects": schema.ListNestedAttribute{
+				MarkdownDescription: "The objects carrying the tag.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"object_ref": schema.SingleNestedAttribute{
+							MarkdownDescription: "The tagged object.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "The type of the object.",
+									Computed:            true,
+								},
+								"id": schema.StringAttribute{
+									MarkdownDescription: "The ID of the object.",
+									Computed:            true,
+								},
+								"name": schema.StringAttribute{
+									MarkdownDescription: "The name of the object.",
+									Computed:            true,
+								},
+							},
+						},
+						"tags": schema.SetAttribute{
+							MarkdownDescription: "All tags set on the object.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *taggedObjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state taggedObjectsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tag := state.Tag.ValueString()
+	filter := fmt.Sprintf("tagName eq %q", tag)
+	if objectType := state.ObjectType.ValueString(); objectType != "" {
+		filter += fmt.Sprintf(" and objectRef.type eq %q", objectType)
+	}
+	tflog.Debug(ctx, "Reading tagged objects data source", map[string]any{"filters": filter})
+
+	objects, err := d.client.ListTaggedObjects(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Tagged Objects",
+			fmt.Sprintf("Could not list SailPoint Tagged Objects with tag %q: %s", tag, err.Error()),
+		)
+		return
+	}
+
+	state.Objects = make([]taggedObjectEntryModel, 0, len(objects))
+	for _, object := range objects {
+		entry, diags := taggedObjectEntryFromAPI(ctx, object)
+		resp.Diagnostics.Append(diags...)
+		state.Objects = append(state.Objects, entry)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
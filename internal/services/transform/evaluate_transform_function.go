@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ function.Function = &evaluateTransformFunction{}
+
+type evaluateTransformFunction struct{}
+
+func NewEvaluateTransformFunction() function.Function {
+	return &evaluateTransformFunction{}
+}
+
+// Metadata implements function.Function.
+func (f *evaluateTransformFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "evaluate_transform"
+}
+
+// Definition implements function.Function.
+func (f *evaluateTransformFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Evaluates a SailPoint transform locally.",
+		MarkdownDescription: "Evaluates a SailPoint (Seaspray) transform locally, without calling the SailPoint API, so transforms and their expected " +
+			"outputs can be tested with `terraform test` and `check` blocks. Returns the transform output, or null when the transform produces no value.\n\n" +
+			"Supported types: `lower`, `upper`, `trim`, `concat`, `substring`, `indexOf`, `lastIndexOf`, `getEndOfString`, `replace`, `replaceAll`, " +
+			"`split`, `leftPad`, `rightPad`, `lookup`, `static`, `conditional`, `firstValid`, `identityAttribute`, `accountAttribute`, `dateFormat`, " +
+			"`dateMath`, `dateCompare`, `base64Encode`, `base64Decode`, `decomposeDiacriticalMarks` and `e164phone`. Nested transforms are evaluated " +
+			"recursively. Types that depend on SailPoint state or randomness (e.g. `rule`, `reference`, `uuid`, `randomAlphaNumeric`) return an error.\n\n" +
+			"`static` values and `conditional` conditions are rendered with a subset of Velocity: `$var`/`${var}`/`$!var` references, String methods " +
+			"such as `substring`, `toUpperCase` and `length`, and `#if`/`#elseif`/`#else`/`#end`. Regular expressions use Go (RE2) syntax, which " +
+			"covers most but not all Java patterns. `e164phone` approximates libphonenumber and does not check national numbering plans.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "transform_json",
+				MarkdownDescription: "The transform as JSON: an object with `type` and `attributes`, e.g. `jsonencode({ type = \"lower\", attributes = {} })`.",
+			},
+			function.DynamicParameter{
+				Name:           "inputs",
+				AllowNullValue: true,
+				MarkdownDescription: "An object describing the identity the transform runs against. All members are optional: `input` is the implicit " +
+					"input of transforms without an `input` attribute; `identity_attributes` maps attribute names to values for `identityAttribute`; " +
+					"`account_attributes` maps source names to attribute maps for `accountAttribute`; `now` is the RFC 3339 reference time used by " +
+					"`now` in `dateMath` and `dateCompare`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run implements function.Function.
+func (f *evaluateTransformFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var transformJSON string
+	var inputsArg types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &transformJSON, &inputsArg))
+	if resp.Error != nil {
+		return
+	}
+
+	inputs, err := transformInputsFromDynamic(inputsArg)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid inputs: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, "Evaluating transform locally")
+	result, err := evaluateTransform([]byte(transformJSON), inputs)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Error evaluating transform: %s", err))
+		return
+	}
+
+	value := types.StringNull()
+	if result != nil {
+		value = types.StringValue(*result)
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, value))
+}
+
+// transformInputsFromDynamic converts the `inputs` argument (any object or
+// map) into transformInputs by way of its JSON form.
+func transformInputsFromDynamic(v types.Dynamic) (transformInputs, error) {
+	var inputs transformInputs
+	if v.IsNull() || v.IsUnderlyingValueNull() {
+		return inputs, nil
+	}
+
+	goValue, err := dynamicToGo(v.UnderlyingValue())
+	if err != nil {
+		return inputs, err
+	}
+	if _, ok := goValue.(map[string]any); !ok {
+		return inputs, errors.New("must be an object")
+	}
+
+	raw, err := json.Marshal(goValue)
+	if err != nil {
+		return inputs, err
+	}
+	var decoded struct {
+		transformInputs
+		Now *string `json:"now"`
+	}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return inputs, err
+	}
+	inputs = decoded.transformInputs
+	if decoded.Now != nil {
+		now, err := time.Parse(time.RFC3339, *decoded.Now)
+		if err != nil {
+			return inputs, fmt.Errorf("`now` must be an RFC 3339 timestamp: %w", err)
+		}
+		inputs.Now = &now
+	}
+	return inputs, nil
+}
+
+// dynamicToGo converts a Terraform value into the Go representation
+// encoding/json would produce for the equivalent JSON document.
+func dynamicToGo(v attr.Value) (any, error) {
+	if v == nil || v.IsNull() {
+		return nil, nil
+	}
+	if v.IsUnknown() {
+		return nil, errors.New("contains unknown values")
+	}
+
+	switch v := v.(type) {
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.NumberValue:
+		f, _ := v.ValueBigFloat().Float64()
+		return f, nil
+	case basetypes.Int64Value:
+		return float64(v.ValueInt64()), nil
+	case basetypes.Float64Value:
+		return v.ValueFloat64(), nil
+	case basetypes.DynamicValue:
+		return dynamicToGo(v.UnderlyingValue())
+	case basetypes.ObjectValue:
+		return dynamicMapToGo(v.Attributes())
+	case basetypes.MapValue:
+		return dynamicMapToGo(v.Elements())
+	case basetypes.ListValue:
+		return dynamicListToGo(v.Elements())
+	case basetypes.SetValue:
+		return dynamicListToGo(v.Elements())
+	case basetypes.TupleValue:
+		return dynamicListToGo(v.Elements())
+	}
+	return nil, fmt.Errorf("unsupported value type %s", v.Type(context.Background()))
+}
+
+func dynamicMapToGo(elements map[string]attr.Value) (map[string]any, error) {
+	out := make(map[string]any, len(elements))
+	for k, e := range elements {
+		value, err := dynamicToGo(e)
+		if err != nil {
+			return nil, err
+		}
+		out[k] = value
+	}
+	return out, nil
+}
+
+func dynamicListToGo(elements []attr.Value) ([]any, error) {
+	out := make([]any, len(elements))
+	for i, e := range elements {
+		value, err := dynamicToGo(e)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = value
+	}
+	return out, nil
+}
@@ -0,0 +1,703 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transform
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common/velocity"
+	"golang.org/x/text/unicode/norm"
+)
+
+// transformInputs is the identity context a transform is evaluated against.
+// SailPoint builds it from the identity profile and the identity's accounts;
+// offline it is supplied by the caller.
+type transformInputs struct {
+	// Input is the implicit input of every transform without an explicit
+	// `input` attribute, i.e. the source attribute value the identity profile
+	// mapping feeds into the transform.
+	Input *string `json:"input"`
+	// IdentityAttributes resolves `identityAttribute` transforms by name.
+	IdentityAttributes map[string]any `json:"identity_attributes"`
+	// AccountAttributes resolves `accountAttribute` transforms by source name,
+	// then attribute name.
+	AccountAttributes map[string]map[string]any `json:"account_attributes"`
+	// Now is the reference time for `now` in `dateMath` and `dateCompare`.
+	Now *time.Time `json:"-"`
+}
+
+// transformNode is a transform definition as found at the top level and in
+// nested `input`/`values`/... attributes. Attributes are kept raw so each
+// type decodes exactly what it needs (and `replaceAll` can keep table order).
+type transformNode struct {
+	Type       string                     `json:"type"`
+	Attributes map[string]json.RawMessage `json:"attributes"`
+}
+
+// errUnsupportedTransform is returned for transform types whose result
+// depends on SailPoint state or randomness and cannot be computed offline.
+var errUnsupportedTransform = errors.New("cannot be evaluated locally")
+
+// transformEvaluator evaluates the deterministic Seaspray transform types.
+type transformEvaluator struct {
+	inputs transformInputs
+}
+
+// evaluateTransform evaluates a transform JSON document (an object with
+// `type` and `attributes`, as accepted by the transforms API) against the
+// given inputs. A nil result is a null transform output.
+func evaluateTransform(transformJSON []byte, inputs transformInputs) (*string, error) {
+	e := &transformEvaluator{inputs: inputs}
+	return e.eval(transformJSON, "$")
+}
+
+func (e *transformEvaluator) eval(raw json.RawMessage, at string) (*string, error) {
+	var node transformNode
+	if err := json.Unmarshal(raw, &node); err != nil {
+		return nil, fmt.Errorf("%s: transform must be a JSON object with `type` and `attributes`: %w", at, err)
+	}
+	if node.Type == "" {
+		return nil, fmt.Errorf("%s: transform is missing `type`", at)
+	}
+
+	t := transformCall{e: e, node: node, at: at}
+	input, err := t.input()
+	if err != nil {
+		return nil, err
+	}
+
+	switch node.Type {
+	case "lower":
+		return mapString(input, strings.ToLower), nil
+	case "upper":
+		return mapString(input, strings.ToUpper), nil
+	case "trim":
+		return mapString(input, strings.TrimSpace), nil
+	case "base64Encode":
+		return mapString(input, func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }), nil
+	case "base64Decode":
+		return t.base64Decode(input)
+	case "decomposeDiacriticalMarks":
+		return mapString(input, decomposeDiacriticalMarks), nil
+	case "concat":
+		return t.concat()
+	case "substring":
+		return t.substring(input)
+	case "indexOf":
+		return t.indexOf(input, strings.Index)
+	case "lastIndexOf":
+		return t.indexOf(input, strings.LastIndex)
+	case "getEndOfString":
+		return t.getEndOfString(input)
+	case "replace":
+		return t.replace(input)
+	case "replaceAll":
+		return t.replaceAll(input)
+	case "split":
+		return t.split(input)
+	case "leftPad":
+		return t.pad(input, true)
+	case "rightPad":
+		return t.pad(input, false)
+	case "lookup":
+		return t.lookup(input)
+	case "static":
+		return t.static()
+	case "conditional":
+		return t.conditional()
+	case "firstValid":
+		return t.firstValid()
+	case "identityAttribute":
+		return t.identityAttribute()
+	case "accountAttribute":
+		return t.accountAttribute()
+	case "dateFormat":
+		return t.dateFormat(input)
+	case "dateMath":
+		return t.dateMath(input)
+	case "dateCompare":
+		return t.dateCompare()
+	case "e164phone":
+		return t.e164phone(input)
+	}
+	return nil, fmt.Errorf("%s: %q transform %w", at, node.Type, errUnsupportedTransform)
+}
+
+// transformCall is one transform being evaluated, with helpers to decode its
+// attributes and report errors at its JSON path.
+type transformCall struct {
+	e    *transformEvaluator
+	node transformNode
+	at   string
+}
+
+func (t transformCall) errorf(format string, args ...any) error {
+	return fmt.Errorf("%s: %s transform: %s", t.at, t.node.Type, fmt.Sprintf(format, args...))
+}
+
+func (t transformCall) attrPath(name string) string {
+	return t.at + ".attributes." + name
+}
+
+// input returns the explicit `input` attribute when set, and the implicit
+// input from transformInputs otherwise.
+func (t transformCall) input() (*string, error) {
+	if _, ok := t.node.Attributes["input"]; !ok {
+		return t.e.inputs.Input, nil
+	}
+	return t.value("input")
+}
+
+// value resolves a string-or-transform attribute. Missing attributes and
+// JSON null are a null value.
+func (t transformCall) value(name string) (*string, error) {
+	raw, ok := t.node.Attributes[name]
+	if !ok {
+		return nil, nil
+	}
+	return t.e.resolve(raw, t.attrPath(name))
+}
+
+func (e *transformEvaluator) resolve(raw json.RawMessage, at string) (*string, error) {
+	trimmed := bytes.TrimSpace(raw)
+	switch {
+	case bytes.Equal(trimmed, []byte("null")):
+		return nil, nil
+	case len(trimmed) > 0 && trimmed[0] == '{':
+		return e.eval(trimmed, at)
+	}
+	var s string
+	if err := json.Unmarshal(trimmed, &s); err != nil {
+		return nil, fmt.Errorf("%s: must be a string or a nested transform", at)
+	}
+	return &s, nil
+}
+
+// str returns a plain string attribute; ok is false when it is absent.
+func (t transformCall) str(name string) (value string, ok bool, err error) {
+	raw, present := t.node.Attributes[name]
+	if !present {
+		return "", false, nil
+	}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", false, fmt.Errorf("%s: must be a string", t.attrPath(name))
+	}
+	return value, true, nil
+}
+
+// requiredStr is str for attributes the transform cannot work without.
+func (t transformCall) requiredStr(name string) (string, error) {
+	value, ok, err := t.str(name)
+	if err == nil && !ok {
+		err = t.errorf("missing required attribute %q", name)
+	}
+	return value, err
+}
+
+// integer returns an integer attribute, accepting numeric strings since the
+// API documents several of them (e.g. pad `length`) as strings.
+func (t transformCall) integer(name string) (value int, ok bool, err error) {
+	raw, present := t.node.Attributes[name]
+	if !present {
+		return 0, false, nil
+	}
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return value, true, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		if value, err := strconv.Atoi(strings.TrimSpace(s)); err == nil {
+			return value, true, nil
+		}
+	}
+	return 0, false, fmt.Errorf("%s: must be an integer", t.attrPath(name))
+}
+
+func (t transformCall) boolean(name string, def bool) (bool, error) {
+	raw, present := t.node.Attributes[name]
+	if !present {
+		return def, nil
+	}
+	var value bool
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return false, fmt.Errorf("%s: must be a boolean", t.attrPath(name))
+	}
+	return value, nil
+}
+
+// variables evaluates every attribute not listed in reserved, for transforms
+// (static, conditional) that expose extra attributes as Velocity variables.
+func (t transformCall) variables(reserved ...string) (map[string]any, error) {
+	vars := map[string]any{}
+	for _, name := range sortedKeys(t.node.Attributes) {
+		if slices.Contains(reserved, name) {
+			continue
+		}
+		value, err := t.value(name)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			vars[name] = *value
+		}
+	}
+	return vars, nil
+}
+
+func mapString(input *string, fn func(string) string) *string {
+	if input == nil {
+		return nil
+	}
+	out := fn(*input)
+	return &out
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func (t transformCall) base64Decode(input *string) (*string, error) {
+	if input == nil {
+		return nil, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(*input)
+	if err != nil {
+		return nil, t.errorf("input is not valid base64: %s", err)
+	}
+	return stringPtr(string(decoded)), nil
+}
+
+func decomposeDiacriticalMarks(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		if !unicode.Is(unicode.Mn, r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// concat joins `values`; null values contribute nothing.
+func (t transformCall) concat() (*string, error) {
+	values, err := t.valueList("values")
+	if err != nil {
+		return nil, err
+	}
+	var b strings.Builder
+	for _, v := range values {
+		if v != nil {
+			b.WriteString(*v)
+		}
+	}
+	return stringPtr(b.String()), nil
+}
+
+// valueList resolves an array of strings and nested transforms.
+func (t transformCall) valueList(name string) ([]*string, error) {
+	raws, err := t.rawList(name)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]*string, len(raws))
+	for i, raw := range raws {
+		if values[i], err = t.e.resolve(raw, fmt.Sprintf("%s[%d]", t.attrPath(name), i)); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+func (t transformCall) rawList(name string) ([]json.RawMessage, error) {
+	raw, ok := t.node.Attributes[name]
+	if !ok {
+		return nil, t.errorf("missing required attribute %q", name)
+	}
+	var raws []json.RawMessage
+	if err := json.Unmarshal(raw, &raws); err != nil {
+		return nil, fmt.Errorf("%s: must be an array", t.attrPath(name))
+	}
+	return raws, nil
+}
+
+// substring mirrors the documented begin/beginOffset/end/endOffset rules:
+// begin -1 starts at the first character and end -1 (or no end) runs to the
+// end of the input; offsets only apply to explicit positions.
+func (t transformCall) substring(input *string) (*string, error) {
+	if input == nil {
+		return nil, nil
+	}
+	begin, ok, err := t.integer("begin")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, t.errorf("missing required attribute %q", "begin")
+	}
+	beginOffset, _, err := t.integer("beginOffset")
+	if err != nil {
+		return nil, err
+	}
+	end, hasEnd, err := t.integer("end")
+	if err != nil {
+		return nil, err
+	}
+	endOffset, _, err := t.integer("endOffset")
+	if err != nil {
+		return nil, err
+	}
+
+	runes := []rune(*input)
+	start := 0
+	if begin != -1 {
+		start = begin + beginOffset
+	}
+	stop := len(runes)
+	if hasEnd && end != -1 {
+		stop = end + endOffset
+	}
+	if start < 0 || stop > len(runes) || start > stop {
+		return nil, t.errorf("range [%d, %d) is out of bounds for input %q of length %d", start, stop, *input, len(runes))
+	}
+	return stringPtr(string(runes[start:stop])), nil
+}
+
+// indexOf returns the character (not byte) position of `substring`, or -1.
+func (t transformCall) indexOf(input *string, find func(string, string) int) (*string, error) {
+	if input == nil {
+		return nil, nil
+	}
+	sub, err := t.requiredStr("substring")
+	if err != nil {
+		return nil, err
+	}
+	idx := find(*input, sub)
+	if idx >= 0 {
+		idx = utf8.RuneCountInString((*input)[:idx])
+	}
+	return stringPtr(strconv.Itoa(idx)), nil
+}
+
+func (t transformCall) getEndOfString(input *string) (*string, error) {
+	if input == nil {
+		return nil, nil
+	}
+	n, ok, err := t.integer("numChars")
+	if err != nil {
+		return nil, err
+	}
+	if !ok || n < 0 {
+		return nil, t.errorf("`numChars` must be a non-negative integer")
+	}
+	runes := []rune(*input)
+	if n >= len(runes) {
+		return input, nil
+	}
+	return stringPtr(string(runes[len(runes)-n:])), nil
+}
+
+func (t transformCall) replace(input *string) (*string, error) {
+	if input == nil {
+		return nil, nil
+	}
+	pattern, err := t.requiredStr("regex")
+	if err != nil {
+		return nil, err
+	}
+	replacement, err := t.requiredStr("replacement")
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, t.errorf("invalid regex %q: %s", pattern, err)
+	}
+	return stringPtr(re.ReplaceAllString(*input, replacement)), nil
+}
+
+// replaceAll applies the `table` patterns in document order, like the
+// insertion-ordered map SailPoint deserializes it into.
+func (t transformCall) replaceAll(input *string) (*string, error) {
+	if input == nil {
+		return nil, nil
+	}
+	raw, ok := t.node.Attributes["table"]
+	if !ok {
+		return nil, t.errorf("missing required attribute %q", "table")
+	}
+	entries, err := decodeOrderedStringMap(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", t.attrPath("table"), err)
+	}
+	out := *input
+	for _, entry := range entries {
+		re, err := regexp.Compile(entry[0])
+		if err != nil {
+			return nil, t.errorf("invalid regex %q: %s", entry[0], err)
+		}
+		out = re.ReplaceAllString(out, entry[1])
+	}
+	return &out, nil
+}
+
+// decodeOrderedStringMap decodes a JSON object of strings into key/value
+// pairs, preserving member order.
+func decodeOrderedStringMap(raw json.RawMessage) ([][2]string, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, errors.New("must be an object of strings")
+	}
+	var entries [][2]string
+	for dec.More() {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value string
+		if err := dec.Decode(&value); err != nil {
+			return nil, fmt.Errorf("value of %q must be a string", keyTok)
+		}
+		entries = append(entries, [2]string{keyTok.(string), value})
+	}
+	return entries, nil
+}
+
+// split splits on the `delimiter` regex, dropping trailing empty fields like
+// Java's String.split. An out-of-range index yields null, or the literal
+// "IndexOutOfBoundsException" when `throws` is true, as documented.
+func (t transformCall) split(input *string) (*string, error) {
+	if input == nil {
+		return nil, nil
+	}
+	delimiter, err := t.requiredStr("delimiter")
+	if err != nil {
+		return nil, err
+	}
+	index, ok, err := t.integer("index")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, t.errorf("missing required attribute %q", "index")
+	}
+	throws, err := t.boolean("throws", false)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(delimiter)
+	if err != nil {
+		return nil, t.errorf("invalid delimiter regex %q: %s", delimiter, err)
+	}
+
+	parts := re.Split(*input, -1)
+	for len(parts) > 1 && parts[len(parts)-1] == "" {
+		parts = parts[:len(parts)-1]
+	}
+	if index < 0 || index >= len(parts) {
+		if throws {
+			return stringPtr("IndexOutOfBoundsException"), nil
+		}
+		return nil, nil
+	}
+	return stringPtr(parts[index]), nil
+}
+
+// pad pads the input to `length` characters with `padding` (default a
+// space), repeating and truncating the padding like StringUtils.leftPad.
+func (t transformCall) pad(input *string, left bool) (*string, error) {
+	if input == nil {
+		return nil, nil
+	}
+	length, ok, err := t.integer("length")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, t.errorf("missing required attribute %q", "length")
+	}
+	padding, hasPadding, err := t.str("padding")
+	if err != nil {
+		return nil, err
+	}
+	if !hasPadding || padding == "" {
+		padding = " "
+	}
+
+	missing := length - utf8.RuneCountInString(*input)
+	if missing <= 0 {
+		return input, nil
+	}
+	fill := []rune(strings.Repeat(padding, missing))[:missing]
+	if left {
+		return stringPtr(string(fill) + *input), nil
+	}
+	return stringPtr(*input + string(fill)), nil
+}
+
+// lookup maps the input through `table`, falling back to its `default` entry.
+func (t transformCall) lookup(input *string) (*string, error) {
+	raw, ok := t.node.Attributes["table"]
+	if !ok {
+		return nil, t.errorf("missing required attribute %q", "table")
+	}
+	var table map[string]string
+	if err := json.Unmarshal(raw, &table); err != nil {
+		return nil, fmt.Errorf("%s: must be an object of strings", t.attrPath("table"))
+	}
+	if input != nil {
+		if value, ok := table[*input]; ok {
+			return &value, nil
+		}
+	}
+	if value, ok := table["default"]; ok {
+		return &value, nil
+	}
+	var key any
+	if input != nil {
+		key = *input
+	}
+	return nil, t.errorf("no table entry for %v and no \"default\" entry", key)
+}
+
+// static renders `value` as a Velocity template whose variables are the
+// transform's other attributes.
+func (t transformCall) static() (*string, error) {
+	value, err := t.requiredStr("value")
+	if err != nil {
+		return nil, err
+	}
+	vars, err := t.variables("value", "input", "requiresPeriodicRefresh")
+	if err != nil {
+		return nil, err
+	}
+	out, err := velocity.Render(value, vars)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", t.attrPath("value"), err)
+	}
+	return &out, nil
+}
+
+// conditional evaluates `expression` ("ValueA eq ValueB", the only operator
+// SailPoint supports) after substituting the transform's extra attributes,
+// then renders the positive or negative condition.
+func (t transformCall) conditional() (*string, error) {
+	expression, err := t.requiredStr("expression")
+	if err != nil {
+		return nil, err
+	}
+	positive, err := t.requiredStr("positiveCondition")
+	if err != nil {
+		return nil, err
+	}
+	negative, err := t.requiredStr("negativeCondition")
+	if err != nil {
+		return nil, err
+	}
+	vars, err := t.variables("expression", "positiveCondition", "negativeCondition", "input", "requiresPeriodicRefresh")
+	if err != nil {
+		return nil, err
+	}
+
+	left, right, ok := strings.Cut(expression, " eq ")
+	if !ok {
+		return nil, fmt.Errorf("%s: expression must have the form \"ValueA eq ValueB\"", t.attrPath("expression"))
+	}
+	leftValue, err := velocity.Render(strings.TrimSpace(left), vars)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", t.attrPath("expression"), err)
+	}
+	rightValue, err := velocity.Render(strings.TrimSpace(right), vars)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", t.attrPath("expression"), err)
+	}
+
+	name, branch := "negativeCondition", negative
+	if leftValue == rightValue {
+		name, branch = "positiveCondition", positive
+	}
+	out, err := velocity.Render(branch, vars)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", t.attrPath(name), err)
+	}
+	return &out, nil
+}
+
+// firstValid returns the first non-null value; with `ignoreErrors`, values
+// whose nested transform fails are skipped instead of failing the transform.
+func (t transformCall) firstValid() (*string, error) {
+	raws, err := t.rawList("values")
+	if err != nil {
+		return nil, err
+	}
+	ignoreErrors, err := t.boolean("ignoreErrors", false)
+	if err != nil {
+		return nil, err
+	}
+	for i, raw := range raws {
+		value, err := t.e.resolve(raw, fmt.Sprintf("%s[%d]", t.attrPath("values"), i))
+		if err != nil {
+			if ignoreErrors {
+				continue
+			}
+			return nil, err
+		}
+		if value != nil {
+			return value, nil
+		}
+	}
+	return nil, nil
+}
+
+func (t transformCall) identityAttribute() (*string, error) {
+	name, err := t.requiredStr("name")
+	if err != nil {
+		return nil, err
+	}
+	return stringifyInput(t.e.inputs.IdentityAttributes[name]), nil
+}
+
+func (t transformCall) accountAttribute() (*string, error) {
+	attribute, err := t.requiredStr("attributeName")
+	if err != nil {
+		return nil, err
+	}
+	source, ok, err := t.str("sourceName")
+	if err == nil && !ok {
+		source, ok, err = t.str("applicationName")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, t.errorf("`sourceName` or `applicationName` is required to evaluate locally")
+	}
+	return stringifyInput(t.e.inputs.AccountAttributes[source][attribute]), nil
+}
+
+// stringifyInput renders an input value the way SailPoint would see it as an
+// attribute value: strings as-is, other scalars in their JSON form.
+func stringifyInput(v any) *string {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case string:
+		return &v
+	case float64:
+		return stringPtr(strconv.FormatFloat(v, 'f', -1, 64))
+	case bool:
+		return stringPtr(strconv.FormatBool(v))
+	}
+	raw, _ := json.Marshal(v)
+	return stringPtr(string(raw))
+}
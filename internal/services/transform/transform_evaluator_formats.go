@@ -0,0 +1,558 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transform
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Named date formats accepted by dateFormat's inputFormat/outputFormat.
+const (
+	dateFormatISO8601        = "ISO8601"
+	dateFormatLDAP           = "LDAP"
+	dateFormatPeopleSoft     = "PEOPLE_SOFT"
+	dateFormatEpochTimeJava  = "EPOCH_TIME_JAVA"
+	dateFormatEpochTimeWin32 = "EPOCH_TIME_WIN32"
+
+	// iso8601Layout is the output layout of ISO8601 dates, matching the
+	// `yyyy-MM-dd'T'HH:mm:ss.SSSX` format SailPoint emits.
+	iso8601Layout = "2006-01-02T15:04:05.000Z07:00"
+
+	// win32EpochOffset is the number of seconds between the Windows FILETIME
+	// epoch (1601-01-01) and the Unix epoch.
+	win32EpochOffset = 11644473600
+)
+
+// iso8601InputLayouts are tried in order when parsing ISO8601 input.
+var iso8601InputLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// parseDate parses a date in one of the named formats or a Java
+// SimpleDateFormat pattern.
+func parseDate(value, format string) (time.Time, error) {
+	switch format {
+	case dateFormatISO8601:
+		for _, layout := range iso8601InputLayouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				return t.UTC(), nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("%q is not an ISO8601 date", value)
+	case dateFormatEpochTimeJava, dateFormatEpochTimeWin32:
+		n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%q is not a %s timestamp", value, format)
+		}
+		if format == dateFormatEpochTimeJava {
+			return time.UnixMilli(n).UTC(), nil
+		}
+		return time.Unix(n/10_000_000-win32EpochOffset, (n%10_000_000)*100).UTC(), nil
+	}
+
+	parts, err := splitDatePattern(namedDatePattern(format))
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := parseDatePattern(value, parts)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q does not match date format %q", value, format)
+	}
+	return t.UTC(), nil
+}
+
+// formatDate renders a date in one of the named formats or a Java
+// SimpleDateFormat pattern, in UTC.
+func formatDate(t time.Time, format string) (string, error) {
+	t = t.UTC()
+	switch format {
+	case dateFormatISO8601:
+		return t.Format(iso8601Layout), nil
+	case dateFormatEpochTimeJava:
+		return strconv.FormatInt(t.UnixMilli(), 10), nil
+	case dateFormatEpochTimeWin32:
+		return strconv.FormatInt((t.Unix()+win32EpochOffset)*10_000_000+int64(t.Nanosecond()/100), 10), nil
+	}
+	parts, err := splitDatePattern(namedDatePattern(format))
+	if err != nil {
+		return "", err
+	}
+	return formatDatePattern(t, parts), nil
+}
+
+func namedDatePattern(format string) string {
+	switch format {
+	case dateFormatLDAP:
+		return "yyyyMMddHHmmss.Z"
+	case dateFormatPeopleSoft:
+		return "MM/dd/yyyy"
+	}
+	return format
+}
+
+// simpleDateFormatLayouts maps Java SimpleDateFormat letter runs to Go
+// reference-time layout elements. Runs longer than any entry fall back to
+// the longest matching one.
+var simpleDateFormatLayouts = map[string]string{
+	"yyyy": "2006",
+	"yyy":  "2006",
+	"yy":   "06",
+	"y":    "2006",
+	"MMMM": "January",
+	"MMM":  "Jan",
+	"MM":   "01",
+	"M":    "1",
+	"dd":   "02",
+	"d":    "2",
+	"HH":   "15",
+	"H":    "15",
+	"hh":   "03",
+	"h":    "3",
+	"mm":   "04",
+	"m":    "4",
+	"ss":   "05",
+	"s":    "5",
+	"SSS":  "000",
+	"SS":   "00",
+	"S":    "0",
+	"a":    "PM",
+	"EEEE": "Monday",
+	"EEE":  "Mon",
+	"EE":   "Mon",
+	"E":    "Mon",
+	"zzz":  "MST",
+	"z":    "MST",
+	"Z":    "-0700",
+	"XXX":  "Z07:00",
+	"XX":   "Z0700",
+	"X":    "Z07",
+}
+
+// dateElementPatterns are the regular expressions matching the text of each
+// Go layout element when parsing a date.
+var dateElementPatterns = map[string]string{
+	"2006":    `\d{4}`,
+	"06":      `\d{2}`,
+	"January": `[A-Za-z]+`,
+	"Jan":     `[A-Za-z]{3}`,
+	"01":      `\d{2}`,
+	"1":       `\d{1,2}`,
+	"02":      `\d{2}`,
+	"2":       `\d{1,2}`,
+	"15":      `\d{1,2}`,
+	"03":      `\d{2}`,
+	"3":       `\d{1,2}`,
+	"04":      `\d{2}`,
+	"4":       `\d{1,2}`,
+	"05":      `\d{2}`,
+	"5":       `\d{1,2}`,
+	"000":     `\d{3}`,
+	"00":      `\d{2}`,
+	"0":       `\d`,
+	"PM":      `[AP]M`,
+	"Monday":  `[A-Za-z]+`,
+	"Mon":     `[A-Za-z]{3}`,
+	"MST":     `[A-Za-z]{3,5}`,
+	"-0700":   `[+-]\d{4}`,
+	"Z07:00":  `Z|[+-]\d{2}:\d{2}`,
+	"Z0700":   `Z|[+-]\d{4}`,
+	"Z07":     `Z|[+-]\d{2}`,
+}
+
+// datePatternPart is a piece of a Java SimpleDateFormat pattern: a date
+// element, as a Go reference-time layout, or literal text.
+type datePatternPart struct {
+	layout  string
+	literal string
+}
+
+// isFractionalSeconds reports whether the part is a fractional seconds
+// element, which Go only recognises after a '.' or ','.
+func (p datePatternPart) isFractionalSeconds() bool {
+	return p.layout == "000" || p.layout == "00" || p.layout == "0"
+}
+
+// splitDatePattern splits a Java SimpleDateFormat pattern into date elements
+// and literal text. Literals are kept apart from the elements so that digits
+// and words in them are never read as Go layout elements.
+func splitDatePattern(pattern string) ([]datePatternPart, error) {
+	var parts []datePatternPart
+	addLiteral := func(s string) {
+		if n := len(parts); n > 0 && parts[n-1].layout == "" {
+			parts[n-1].literal += s
+			return
+		}
+		parts = append(parts, datePatternPart{literal: s})
+	}
+
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == '\'':
+			// Quoted literal; '' is an escaped quote, inside or outside quotes.
+			if i+1 < len(runes) && runes[i+1] == '\'' {
+				addLiteral("'")
+				i += 2
+				continue
+			}
+			var literal strings.Builder
+			j := i + 1
+			for j < len(runes) {
+				if runes[j] == '\'' {
+					if j+1 < len(runes) && runes[j+1] == '\'' {
+						literal.WriteRune('\'')
+						j += 2
+						continue
+					}
+					break
+				}
+				literal.WriteRune(runes[j])
+				j++
+			}
+			addLiteral(literal.String())
+			i = j + 1
+		case (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z'):
+			j := i
+			for j < len(runes) && runes[j] == r {
+				j++
+			}
+			run := string(runes[i:j])
+			element, ok := simpleDateFormatLayouts[run]
+			for !ok && len(run) > 1 {
+				run = run[:len(run)-1]
+				element, ok = simpleDateFormatLayouts[run]
+			}
+			if !ok {
+				return nil, fmt.Errorf("unsupported date pattern letter %q in %q", r, pattern)
+			}
+			parts = append(parts, datePatternPart{layout: element})
+			i = j
+		default:
+			addLiteral(string(r))
+			i++
+		}
+	}
+	return parts, nil
+}
+
+// formatDatePattern formats a date element by element, writing literal text
+// as is.
+func formatDatePattern(t time.Time, parts []datePatternPart) string {
+	var b strings.Builder
+	for _, part := range parts {
+		switch {
+		case part.layout == "":
+			b.WriteString(part.literal)
+		case part.isFractionalSeconds():
+			b.WriteString(t.Format("." + part.layout)[1:])
+		default:
+			b.WriteString(t.Format(part.layout))
+		}
+	}
+	return b.String()
+}
+
+// parseDatePattern parses a date against a split pattern. The literal text
+// must match exactly; the text of the date elements is then parsed with a Go
+// layout made of the elements alone.
+func parseDatePattern(value string, parts []datePatternPart) (time.Time, error) {
+	var expr strings.Builder
+	expr.WriteString("^")
+	for _, part := range parts {
+		if part.layout == "" {
+			expr.WriteString(regexp.QuoteMeta(part.literal))
+			continue
+		}
+		expr.WriteString("(" + dateElementPatterns[part.layout] + ")")
+	}
+	expr.WriteString("$")
+
+	match := regexp.MustCompile(expr.String()).FindStringSubmatch(value)
+	if match == nil {
+		return time.Time{}, fmt.Errorf("%q does not match the date pattern", value)
+	}
+
+	var layout, text []string
+	i := 1
+	for _, part := range parts {
+		if part.layout == "" {
+			continue
+		}
+		if part.isFractionalSeconds() {
+			layout = append(layout, "."+part.layout)
+			text = append(text, "."+match[i])
+		} else {
+			layout = append(layout, part.layout)
+			text = append(text, match[i])
+		}
+		i++
+	}
+	return time.Parse(strings.Join(layout, " "), strings.Join(text, " "))
+}
+
+func (t transformCall) dateFormat(input *string) (*string, error) {
+	if input == nil {
+		return nil, nil
+	}
+	inputFormat, ok, err := t.str("inputFormat")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		inputFormat = dateFormatISO8601
+	}
+	outputFormat, ok, err := t.str("outputFormat")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		outputFormat = dateFormatISO8601
+	}
+
+	parsed, err := parseDate(*input, inputFormat)
+	if err != nil {
+		return nil, t.errorf("%s", err)
+	}
+	out, err := formatDate(parsed, outputFormat)
+	if err != nil {
+		return nil, t.errorf("%s", err)
+	}
+	return &out, nil
+}
+
+// now returns the caller-supplied reference time. It is never taken from the
+// clock so evaluation stays reproducible.
+func (t transformCall) now() (time.Time, error) {
+	if t.e.inputs.Now == nil {
+		return time.Time{}, t.errorf("\"now\" requires the `now` input to be set")
+	}
+	return t.e.inputs.Now.UTC(), nil
+}
+
+var dateMathOperation = regexp.MustCompile(`^([+-])(\d+)([yMwdhms])|^/([yMwdhms])`)
+
+// dateMath applies an expression such as `now-1w/d` or `+3M` to the input
+// (or to the `now` input) and returns an ISO8601 date. Rounding (`/unit`)
+// truncates to the start of the unit, or to its last millisecond with
+// `roundUp`.
+func (t transformCall) dateMath(input *string) (*string, error) {
+	expression, err := t.requiredStr("expression")
+	if err != nil {
+		return nil, err
+	}
+	roundUp, err := t.boolean("roundUp", false)
+	if err != nil {
+		return nil, err
+	}
+
+	var date time.Time
+	rest := expression
+	if after, ok := strings.CutPrefix(expression, "now"); ok {
+		rest = after
+		if date, err = t.now(); err != nil {
+			return nil, err
+		}
+	} else {
+		if input == nil {
+			return nil, nil
+		}
+		if date, err = parseDate(*input, dateFormatISO8601); err != nil {
+			return nil, t.errorf("%s", err)
+		}
+	}
+
+	for rest != "" {
+		m := dateMathOperation.FindStringSubmatch(rest)
+		if m == nil {
+			return nil, t.errorf("invalid expression %q near %q", expression, rest)
+		}
+		rest = rest[len(m[0]):]
+		if m[4] != "" {
+			date = roundDate(date, m[4], roundUp)
+			continue
+		}
+		n, _ := strconv.Atoi(m[2])
+		if m[1] == "-" {
+			n = -n
+		}
+		date = addDate(date, n, m[3])
+	}
+	return stringPtr(date.Format(iso8601Layout)), nil
+}
+
+func addDate(t time.Time, n int, unit string) time.Time {
+	switch unit {
+	case "y":
+		return addMonths(t, 12*n)
+	case "M":
+		return addMonths(t, n)
+	case "w":
+		return t.AddDate(0, 0, 7*n)
+	case "d":
+		return t.AddDate(0, 0, n)
+	case "h":
+		return t.Add(time.Duration(n) * time.Hour)
+	case "m":
+		return t.Add(time.Duration(n) * time.Minute)
+	}
+	return t.Add(time.Duration(n) * time.Second)
+}
+
+// addMonths adds calendar months, clamping the day to the end of the target
+// month like Java does (Jan 31 + 1M is Feb 29, not Mar 2).
+func addMonths(t time.Time, n int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(t.Day(), lastDay)-1)
+}
+
+func roundDate(t time.Time, unit string, up bool) time.Time {
+	var start time.Time
+	switch unit {
+	case "y":
+		start = time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
+	case "M":
+		start = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	case "w":
+		// Weeks start on Monday.
+		offset := (int(t.Weekday()) + 6) % 7
+		start = time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+	case "d":
+		start = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	case "h":
+		start = t.Truncate(time.Hour)
+	case "m":
+		start = t.Truncate(time.Minute)
+	default:
+		start = t.Truncate(time.Second)
+	}
+	if !up {
+		return start
+	}
+	return addDate(start, 1, unit).Add(-time.Millisecond)
+}
+
+// dateCompare compares two ISO8601 dates (or "now") with LT/LTE/GT/GTE and
+// returns the positive or negative condition.
+func (t transformCall) dateCompare() (*string, error) {
+	first, err := t.compareDate("firstDate")
+	if err != nil {
+		return nil, err
+	}
+	second, err := t.compareDate("secondDate")
+	if err != nil {
+		return nil, err
+	}
+	operator, err := t.requiredStr("operator")
+	if err != nil {
+		return nil, err
+	}
+	positive, err := t.requiredStr("positiveCondition")
+	if err != nil {
+		return nil, err
+	}
+	negative, err := t.requiredStr("negativeCondition")
+	if err != nil {
+		return nil, err
+	}
+
+	var result bool
+	switch operator {
+	case "LT":
+		result = first.Before(second)
+	case "LTE":
+		result = !first.After(second)
+	case "GT":
+		result = first.After(second)
+	case "GTE":
+		result = !first.Before(second)
+	default:
+		return nil, t.errorf("unsupported operator %q", operator)
+	}
+	if result {
+		return &positive, nil
+	}
+	return &negative, nil
+}
+
+func (t transformCall) compareDate(name string) (time.Time, error) {
+	value, err := t.value(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if value == nil {
+		return time.Time{}, t.errorf("%q evaluated to null", name)
+	}
+	if *value == "now" {
+		return t.now()
+	}
+	date, err := parseDate(*value, dateFormatISO8601)
+	if err != nil {
+		return time.Time{}, t.errorf("%s: %s", name, err)
+	}
+	return date, nil
+}
+
+// countryCallingCodes covers the regions most commonly used as
+// e164phone `defaultRegion`. Numbers already carrying a "+" or "00"
+// international prefix do not need a region.
+var countryCallingCodes = map[string]string{
+	"US": "1", "CA": "1", "GB": "44", "IE": "353", "FR": "33", "DE": "49",
+	"ES": "34", "IT": "39", "NL": "31", "BE": "32", "CH": "41", "AT": "43",
+	"SE": "46", "NO": "47", "DK": "45", "FI": "358", "PL": "48", "PT": "351",
+	"AU": "61", "NZ": "64", "IN": "91", "JP": "81", "CN": "86", "SG": "65",
+	"BR": "55", "MX": "52", "ZA": "27", "AE": "971", "IL": "972",
+}
+
+var phoneFormatting = regexp.MustCompile(`[\s().\-/]`)
+
+// e164phone normalises a phone number to E.164. This is a structural
+// approximation of libphonenumber: formatting characters are stripped, a
+// national trunk prefix "0" is dropped and the region's calling code is
+// prepended; per-country numbering plans are not checked.
+func (t transformCall) e164phone(input *string) (*string, error) {
+	if input == nil {
+		return nil, nil
+	}
+	region, ok, err := t.str("defaultRegion")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		region = "US"
+	}
+
+	number := phoneFormatting.ReplaceAllString(strings.TrimSpace(*input), "")
+	switch {
+	case strings.HasPrefix(number, "+"):
+		number = number[1:]
+	case strings.HasPrefix(number, "00"):
+		number = number[2:]
+	default:
+		code, ok := countryCallingCodes[strings.ToUpper(region)]
+		if !ok {
+			return nil, t.errorf("unsupported defaultRegion %q for local evaluation", region)
+		}
+		if code == "1" {
+			number = strings.TrimPrefix(number, "1")
+		} else {
+			number = strings.TrimPrefix(number, "0")
+		}
+		number = code + number
+	}
+
+	if len(number) < 8 || len(number) > 15 || strings.Trim(number, "0123456789") != "" {
+		return nil, t.errorf("%q is not a valid phone number", *input)
+	}
+	return stringPtr("+" + number), nil
+}
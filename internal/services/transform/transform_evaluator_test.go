@@ -0,0 +1,236 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transform
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEvaluateTransform(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.March, 14, 15, 9, 26, 0, time.UTC)
+	inputs := transformInputs{
+		Input: stringPtr("  John Smith  "),
+		IdentityAttributes: map[string]any{
+			"firstname":  "José",
+			"lastname":   "O'Neil",
+			"department": "Engineering",
+			"employeeId": float64(4217),
+		},
+		AccountAttributes: map[string]map[string]any{
+			"HR": {"country": "FR", "phone": "01 23 45 67 89"},
+		},
+		Now: &now,
+	}
+
+	tests := map[string]struct {
+		transform string
+		// want is the expected output; wantNull expects a null output instead.
+		want     string
+		wantNull bool
+		// wantErr is a substring expected in the error.
+		wantErr string
+	}{
+		"lower implicit input": {
+			transform: `{"type": "lower"}`,
+			want:      "  john smith  ",
+		},
+		"trim then upper via explicit input": {
+			transform: `{"type": "upper", "attributes": {"input": {"type": "trim"}}}`,
+			want:      "JOHN SMITH",
+		},
+		"concat strings and transforms": {
+			transform: `{"type": "concat", "attributes": {"values": [
+				{"type": "identityAttribute", "attributes": {"name": "firstname"}}, ".",
+				{"type": "identityAttribute", "attributes": {"name": "missing"}},
+				{"type": "identityAttribute", "attributes": {"name": "lastname"}}]}}`,
+			want: "José.O'Neil",
+		},
+		"substring with offsets": {
+			transform: `{"type": "substring", "attributes": {"input": "Engineering", "begin": 0, "beginOffset": 1, "end": 3, "endOffset": 1}}`,
+			want:      "ngi",
+		},
+		"substring begin -1 runs from start": {
+			transform: `{"type": "substring", "attributes": {"input": "Engineering", "begin": -1, "end": 3}}`,
+			want:      "Eng",
+		},
+		"substring out of range": {
+			transform: `{"type": "substring", "attributes": {"input": "abc", "begin": 1, "end": 9}}`,
+			wantErr:   "out of bounds",
+		},
+		"indexOf counts characters": {
+			transform: `{"type": "indexOf", "attributes": {"input": "José Smith", "substring": "Smith"}}`,
+			want:      "5",
+		},
+		"lastIndexOf not found": {
+			transform: `{"type": "lastIndexOf", "attributes": {"input": "abc", "substring": "z"}}`,
+			want:      "-1",
+		},
+		"getEndOfString": {
+			transform: `{"type": "getEndOfString", "attributes": {"input": "123456", "numChars": 4}}`,
+			want:      "3456",
+		},
+		"replace regex": {
+			transform: `{"type": "replace", "attributes": {"input": "a-b-c", "regex": "-", "replacement": "_"}}`,
+			want:      "a_b_c",
+		},
+		"replaceAll keeps table order": {
+			transform: `{"type": "replaceAll", "attributes": {"input": "abc", "table": {"a": "b", "b": "c"}}}`,
+			want:      "ccc",
+		},
+		"split": {
+			transform: `{"type": "split", "attributes": {"input": "john.smith@example.com", "delimiter": "@", "index": 0}}`,
+			want:      "john.smith",
+		},
+		"split out of range is null": {
+			transform: `{"type": "split", "attributes": {"input": "a,b", "delimiter": ",", "index": 5}}`,
+			wantNull:  true,
+		},
+		"split out of range throws": {
+			transform: `{"type": "split", "attributes": {"input": "a,b", "delimiter": ",", "index": "5", "throws": true}}`,
+			want:      "IndexOutOfBoundsException",
+		},
+		"leftPad with string length": {
+			transform: `{"type": "leftPad", "attributes": {"input": {"type": "identityAttribute", "attributes": {"name": "employeeId"}}, "length": "8", "padding": "0"}}`,
+			want:      "00004217",
+		},
+		"rightPad default padding": {
+			transform: `{"type": "rightPad", "attributes": {"input": "ab", "length": 4}}`,
+			want:      "ab  ",
+		},
+		"lookup with default": {
+			transform: `{"type": "lookup", "attributes": {"input": "DE", "table": {"FR": "France", "default": "Other"}}}`,
+			want:      "Other",
+		},
+		"lookup without match": {
+			transform: `{"type": "lookup", "attributes": {"input": "DE", "table": {"FR": "France"}}}`,
+			wantErr:   "no \"default\" entry",
+		},
+		"static with velocity": {
+			transform: `{"type": "static", "attributes": {
+				"value": "#if($dept == 'Engineering')${fn.substring(0,1).toLowerCase()}$ln#{else}other#end",
+				"dept": {"type": "identityAttribute", "attributes": {"name": "department"}},
+				"fn": {"type": "identityAttribute", "attributes": {"name": "firstname"}},
+				"ln": "smith"}}`,
+			want: "jsmith",
+		},
+		"conditional": {
+			transform: `{"type": "conditional", "attributes": {
+				"expression": "$department eq Engineering",
+				"positiveCondition": "tech-$department", "negativeCondition": "other",
+				"department": {"type": "identityAttribute", "attributes": {"name": "department"}}}}`,
+			want: "tech-Engineering",
+		},
+		"firstValid skips null": {
+			transform: `{"type": "firstValid", "attributes": {"values": [
+				{"type": "accountAttribute", "attributes": {"sourceName": "AD", "attributeName": "mail"}},
+				{"type": "accountAttribute", "attributes": {"sourceName": "HR", "attributeName": "country"}}]}}`,
+			want: "FR",
+		},
+		"firstValid ignoreErrors": {
+			transform: `{"type": "firstValid", "attributes": {"ignoreErrors": true, "values": [{"type": "uuid"}, "fallback"]}}`,
+			want:      "fallback",
+		},
+		"dateFormat named formats": {
+			transform: `{"type": "dateFormat", "attributes": {"input": "1710428966000", "inputFormat": "EPOCH_TIME_JAVA", "outputFormat": "PEOPLE_SOFT"}}`,
+			want:      "03/14/2024",
+		},
+		"dateFormat java pattern": {
+			transform: `{"type": "dateFormat", "attributes": {"input": "14/03/2024", "inputFormat": "dd/MM/yyyy", "outputFormat": "yyyy-MM-dd'T'HH:mm"}}`,
+			want:      "2024-03-14T00:00",
+		},
+		"dateFormat quoted digits": {
+			transform: `{"type": "dateFormat", "attributes": {"input": "2024-03-14T15:09:26Z", "outputFormat": "'Q1' yyyy'01'"}}`,
+			want:      "Q1 202401",
+		},
+		"dateFormat quoted month and day names": {
+			transform: `{"type": "dateFormat", "attributes": {"input": "2024-03-14T15:09:26Z", "outputFormat": "'Jan Mon PM MST' dd MMM EEE"}}`,
+			want:      "Jan Mon PM MST 14 Mar Thu",
+		},
+		"dateFormat unquoted digits and escaped quote": {
+			transform: `{"type": "dateFormat", "attributes": {"input": "2024-03-14T15:09:26Z", "outputFormat": "h 'o''clock' 1/2"}}`,
+			want:      "3 o'clock 1/2",
+		},
+		"dateFormat fractional seconds without separator": {
+			transform: `{"type": "dateFormat", "attributes": {"input": "2024-03-14T15:09:26.042Z", "outputFormat": "HHmmssSSS"}}`,
+			want:      "150926042",
+		},
+		"dateFormat parses around quoted literals": {
+			transform: `{"type": "dateFormat", "attributes": {"input": "Q1 2024 Jan-03-14", "inputFormat": "'Q1' yyyy 'Jan'-MM-dd", "outputFormat": "yyyyMMdd"}}`,
+			want:      "20240314",
+		},
+		"dateFormat literal mismatch": {
+			transform: `{"type": "dateFormat", "attributes": {"input": "Q2 2024", "inputFormat": "'Q1' yyyy"}}`,
+			wantErr:   `does not match date format`,
+		},
+		"dateMath from now with rounding": {
+			transform: `{"type": "dateMath", "attributes": {"expression": "now+1w/d"}}`,
+			want:      "2024-03-21T00:00:00.000Z",
+		},
+		"dateMath round up": {
+			transform: `{"type": "dateMath", "attributes": {"input": "2024-01-31T10:00:00Z", "expression": "+1M/M", "roundUp": true}}`,
+			want:      "2024-02-29T23:59:59.999Z",
+		},
+		"dateCompare": {
+			transform: `{"type": "dateCompare", "attributes": {"firstDate": "2024-01-01T00:00:00Z", "secondDate": "now", "operator": "LT", "positiveCondition": "past", "negativeCondition": "future"}}`,
+			want:      "past",
+		},
+		"base64 round trip": {
+			transform: `{"type": "base64Decode", "attributes": {"input": {"type": "base64Encode", "attributes": {"input": "héllo"}}}}`,
+			want:      "héllo",
+		},
+		"decomposeDiacriticalMarks": {
+			transform: `{"type": "decomposeDiacriticalMarks", "attributes": {"input": "Élodie Müller"}}`,
+			want:      "Elodie Muller",
+		},
+		"e164phone with region": {
+			transform: `{"type": "e164phone", "attributes": {"defaultRegion": "FR", "input": {"type": "accountAttribute", "attributes": {"sourceName": "HR", "attributeName": "phone"}}}}`,
+			want:      "+33123456789",
+		},
+		"e164phone US default": {
+			transform: `{"type": "e164phone", "attributes": {"input": "(512) 555-0100"}}`,
+			want:      "+15125550100",
+		},
+		"null input propagates": {
+			transform: `{"type": "upper", "attributes": {"input": {"type": "identityAttribute", "attributes": {"name": "missing"}}}}`,
+			wantNull:  true,
+		},
+		"unsupported type": {
+			transform: `{"type": "rule", "attributes": {"name": "Generate Username"}}`,
+			wantErr:   `"rule" transform cannot be evaluated locally`,
+		},
+		"error path points at nested transform": {
+			transform: `{"type": "concat", "attributes": {"values": ["a", {"type": "substring", "attributes": {"input": "ab"}}]}}`,
+			wantErr:   "$.attributes.values[1]: substring transform: missing required attribute \"begin\"",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := evaluateTransform([]byte(tc.transform), inputs)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("error = %v, want it to contain %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			switch {
+			case tc.wantNull && got != nil:
+				t.Errorf("got %q, want null", *got)
+			case !tc.wantNull && got == nil:
+				t.Errorf("got null, want %q", tc.want)
+			case !tc.wantNull && *got != tc.want:
+				t.Errorf("got %q, want %q", *got, tc.want)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package transform
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -20,9 +21,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &transformResource{}
-	_ resource.ResourceWithConfigure   = &transformResource{}
-	_ resource.ResourceWithImportState = &transformResource{}
+	_ resource.Resource                   = &transformResource{}
+	_ resource.ResourceWithConfigure      = &transformResource{}
+	_ resource.ResourceWithImportState    = &transformResource{}
+	_ resource.ResourceWithIdentity       = &transformResource{}
+	_ resource.ResourceWithValidateConfig = &transformResource{}
 )
 
 type transformResource struct {
@@ -76,7 +79,7 @@ func (r *transformResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"attributes": schema.StringAttribute{
-				MarkdownDescription: "A JSON object containing the transform-specific configuration attributes.",
+				MarkdownDescription: "A JSON object containing the transform-specific configuration attributes. For documented transform types the object, including nested transforms, is validated at plan time against that type's attribute schema; unknown types are passed through unchecked.",
 				Optional:            true,
 				Computed:            true,
 				CustomType:          jsontypes.NormalizedType{},
@@ -85,6 +88,56 @@ func (r *transformResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (r *transformResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IDIdentitySchema()
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig. It checks the
+// attributes JSON against the embedded schema for the transform type so
+// misspelled or mistyped attributes are reported at plan time rather than by
+// SailPoint at apply time.
+func (r *transformResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config transformModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to check until the type and attributes are known.
+	if config.Type.IsNull() || config.Type.IsUnknown() || config.Attributes.IsUnknown() {
+		return
+	}
+
+	var attributes any
+	if !config.Attributes.IsNull() {
+		if err := json.Unmarshal([]byte(config.Attributes.ValueString()), &attributes); err != nil {
+			// Invalid JSON is reported by the custom type's own validation.
+			return
+		}
+	}
+
+	schemas, err := transformSchemas()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Loading Transform Schemas",
+			fmt.Sprintf("Could not load the embedded transform attribute schemas: %s", err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Validating transform attributes against schema", map[string]any{
+		"type": config.Type.ValueString(),
+	})
+	for _, schemaErr := range schemas.validateAttributes(config.Type.ValueString(), attributes) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("attributes"),
+			"Invalid Transform Attributes",
+			fmt.Sprintf("Attributes of %q transform: %s", config.Type.ValueString(), schemaErr.Error()),
+		)
+	}
+}
+
 // Create implements resource.Resource.
 func (r *transformResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan transformModel
@@ -148,6 +201,7 @@ func (r *transformResource) Create(ctx context.Context, req resource.CreateReque
 		"name": plan.Name.ValueString(),
 	})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -213,6 +267,7 @@ func (r *transformResource) Read(ctx context.Context, req resource.ReadRequest,
 		"id": state.ID.ValueString(),
 	})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -289,6 +344,7 @@ func (r *transformResource) Update(ctx context.Context, req resource.UpdateReque
 		"id": newState.ID.ValueString(),
 	})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: newState.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -335,7 +391,7 @@ func (r *transformResource) ImportState(ctx context.Context, req resource.Import
 		"id": req.ID,
 	})
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 
 	tflog.Info(ctx, "Successfully imported SailPoint Transform resource", map[string]any{
 		"id": req.ID,
@@ -0,0 +1,363 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transform
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// schemaFS holds one JSON schema per documented Seaspray transform type,
+// describing the transform's `attributes` object, plus definitions.json with
+// the shared `$defs`. File names (minus the extension) are transform types.
+//
+//go:embed schemas/*.json
+var schemaFS embed.FS
+
+const (
+	schemaDefinitionsFile = "definitions.json"
+	schemaRefPrefix       = "#/$defs/"
+	// schemaRefTransform marks a nested transform: an object with a `type` and
+	// optional `attributes`, the latter validated against the schema of that type.
+	schemaRefTransform = schemaRefPrefix + "transform"
+)
+
+// commonAttributeSchemas are accepted by every transform type on top of the
+// type-specific attributes, so they are merged into each embedded schema
+// rather than repeated in every file.
+var commonAttributeSchemas = map[string]*attributeSchema{
+	"input":                   {Ref: schemaRefTransform},
+	"requiresPeriodicRefresh": {Type: schemaTypes{"boolean"}},
+}
+
+// attributeSchema is the subset of JSON Schema used by the embedded transform
+// schemas: type, enum, properties/required/additionalProperties, items,
+// minItems, minProperties, anyOf and local `$ref`s into definitions.json.
+type attributeSchema struct {
+	Type                 schemaTypes                 `json:"type"`
+	Enum                 []any                       `json:"enum"`
+	Properties           map[string]*attributeSchema `json:"properties"`
+	Required             []string                    `json:"required"`
+	AdditionalProperties *additionalProperties       `json:"additionalProperties"`
+	Items                *attributeSchema            `json:"items"`
+	MinItems             *int                        `json:"minItems"`
+	MinProperties        *int                        `json:"minProperties"`
+	AnyOf                []*attributeSchema          `json:"anyOf"`
+	Ref                  string                      `json:"$ref"`
+	Defs                 map[string]*attributeSchema `json:"$defs"`
+}
+
+// schemaTypes accepts both `"type": "string"` and `"type": ["string", "integer"]`.
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = schemaTypes{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*t = many
+	return nil
+}
+
+// additionalProperties is either a boolean or a schema for the extra members.
+type additionalProperties struct {
+	Allowed bool
+	Schema  *attributeSchema
+}
+
+func (a *additionalProperties) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &a.Allowed); err == nil {
+		return nil
+	}
+	a.Allowed = true
+	return json.Unmarshal(data, &a.Schema)
+}
+
+// transformSchemaError is one validation failure, located by the JSON path of
+// the offending value relative to the transform's `attributes` object.
+type transformSchemaError struct {
+	Path    string
+	Message string
+}
+
+func (e transformSchemaError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// transformSchemaSet is the parsed content of schemaFS.
+type transformSchemaSet struct {
+	byType map[string]*attributeSchema
+	defs   map[string]*attributeSchema
+}
+
+var (
+	loadSchemasOnce sync.Once
+	loadedSchemas   *transformSchemaSet
+	loadSchemasErr  error
+)
+
+// transformSchemas parses the embedded schemas once and caches the result.
+func transformSchemas() (*transformSchemaSet, error) {
+	loadSchemasOnce.Do(func() {
+		loadedSchemas, loadSchemasErr = parseTransformSchemas()
+	})
+	return loadedSchemas, loadSchemasErr
+}
+
+func parseTransformSchemas() (*transformSchemaSet, error) {
+	entries, err := schemaFS.ReadDir("schemas")
+	if err != nil {
+		return nil, err
+	}
+
+	set := &transformSchemaSet{byType: make(map[string]*attributeSchema, len(entries))}
+	for _, entry := range entries {
+		raw, err := schemaFS.ReadFile(path.Join("schemas", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var schema attributeSchema
+		if err := json.Unmarshal(raw, &schema); err != nil {
+			return nil, fmt.Errorf("parsing transform schema %s: %w", entry.Name(), err)
+		}
+
+		if entry.Name() == schemaDefinitionsFile {
+			set.defs = schema.Defs
+			continue
+		}
+
+		if schema.Properties == nil {
+			schema.Properties = map[string]*attributeSchema{}
+		}
+		for name, common := range commonAttributeSchemas {
+			if _, ok := schema.Properties[name]; !ok {
+				schema.Properties[name] = common
+			}
+		}
+		set.byType[strings.TrimSuffix(entry.Name(), ".json")] = &schema
+	}
+	return set, nil
+}
+
+// validateAttributes validates the attributes of a transform of the given
+// type. Types without an embedded schema are accepted as-is so new or
+// undocumented transform types keep working.
+func (s *transformSchemaSet) validateAttributes(transformType string, attributes any) []transformSchemaError {
+	schema, ok := s.byType[transformType]
+	if !ok {
+		return nil
+	}
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+	return s.validate(schema, attributes, "$")
+}
+
+func (s *transformSchemaSet) validate(schema *attributeSchema, value any, at string) []transformSchemaError {
+	if schema.Ref == schemaRefTransform {
+		return s.validateNestedTransform(value, at)
+	}
+	if schema.Ref != "" {
+		def, ok := s.defs[strings.TrimPrefix(schema.Ref, schemaRefPrefix)]
+		if !ok {
+			return []transformSchemaError{{Path: at, Message: fmt.Sprintf("unresolved schema reference %q", schema.Ref)}}
+		}
+		return s.validate(def, value, at)
+	}
+
+	if len(schema.AnyOf) > 0 {
+		return s.validateAnyOf(schema.AnyOf, value, at)
+	}
+
+	if len(schema.Type) > 0 && !slices.ContainsFunc(schema.Type, func(t string) bool { return jsonTypeMatches(t, value) }) {
+		return []transformSchemaError{{Path: at, Message: fmt.Sprintf("must be of type %s, got %s", strings.Join(schema.Type, " or "), jsonTypeName(value))}}
+	}
+
+	if len(schema.Enum) > 0 && !slices.Contains(schema.Enum, value) {
+		return []transformSchemaError{{Path: at, Message: fmt.Sprintf("must be one of %s, got %v", formatEnum(schema.Enum), value)}}
+	}
+
+	var errs []transformSchemaError
+	switch v := value.(type) {
+	case map[string]any:
+		errs = append(errs, s.validateObject(schema, v, at)...)
+	case []any:
+		if schema.MinItems != nil && len(v) < *schema.MinItems {
+			errs = append(errs, transformSchemaError{Path: at, Message: fmt.Sprintf("must contain at least %d item(s)", *schema.MinItems)})
+		}
+		if schema.Items != nil {
+			for i, item := range v {
+				errs = append(errs, s.validate(schema.Items, item, fmt.Sprintf("%s[%d]", at, i))...)
+			}
+		}
+	}
+	return errs
+}
+
+func (s *transformSchemaSet) validateObject(schema *attributeSchema, obj map[string]any, at string) []transformSchemaError {
+	var errs []transformSchemaError
+
+	for _, name := range schema.Required {
+		if _, ok := obj[name]; !ok {
+			errs = append(errs, transformSchemaError{Path: at, Message: fmt.Sprintf("missing required attribute %q", name)})
+		}
+	}
+	if schema.MinProperties != nil && len(obj) < *schema.MinProperties {
+		errs = append(errs, transformSchemaError{Path: at, Message: fmt.Sprintf("must contain at least %d entries", *schema.MinProperties)})
+	}
+
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		childPath := at + "." + name
+		if prop, ok := schema.Properties[name]; ok {
+			errs = append(errs, s.validate(prop, obj[name], childPath)...)
+			continue
+		}
+		switch {
+		case schema.AdditionalProperties == nil:
+		case !schema.AdditionalProperties.Allowed:
+			errs = append(errs, transformSchemaError{Path: childPath, Message: fmt.Sprintf("unsupported attribute; expected one of %s", strings.Join(sortedKeys(schema.Properties), ", "))})
+		case schema.AdditionalProperties.Schema != nil:
+			errs = append(errs, s.validate(schema.AdditionalProperties.Schema, obj[name], childPath)...)
+		}
+	}
+	return errs
+}
+
+// validateAnyOf accepts the value when any branch matches. When none does,
+// the errors of the branch whose top-level type matched are reported, since
+// they are the most specific; otherwise a single type mismatch is reported.
+func (s *transformSchemaSet) validateAnyOf(branches []*attributeSchema, value any, at string) []transformSchemaError {
+	var expected []string
+	for _, branch := range branches {
+		errs := s.validate(branch, value, at)
+		if len(errs) == 0 {
+			return nil
+		}
+		if s.branchTypeMatches(branch, value) {
+			return errs
+		}
+		expected = append(expected, s.branchTypeName(branch))
+	}
+	return []transformSchemaError{{Path: at, Message: fmt.Sprintf("must be %s, got %s", strings.Join(expected, " or "), jsonTypeName(value))}}
+}
+
+func (s *transformSchemaSet) branchTypeMatches(branch *attributeSchema, value any) bool {
+	if branch.Ref == schemaRefTransform {
+		_, ok := value.(map[string]any)
+		return ok
+	}
+	return slices.ContainsFunc(branch.Type, func(t string) bool { return jsonTypeMatches(t, value) })
+}
+
+func (s *transformSchemaSet) branchTypeName(branch *attributeSchema) string {
+	if branch.Ref == schemaRefTransform {
+		return "a nested transform object"
+	}
+	return "of type " + strings.Join(branch.Type, " or ")
+}
+
+// validateNestedTransform checks the `{type, attributes}` shape of a nested
+// transform and recurses into its attributes.
+func (s *transformSchemaSet) validateNestedTransform(value any, at string) []transformSchemaError {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return []transformSchemaError{{Path: at, Message: fmt.Sprintf("must be a nested transform object, got %s", jsonTypeName(value))}}
+	}
+	transformType, ok := obj["type"].(string)
+	if !ok {
+		return []transformSchemaError{{Path: at, Message: "nested transform must have a string \"type\""}}
+	}
+	attributes, present := obj["attributes"]
+	if present {
+		if _, ok := attributes.(map[string]any); !ok {
+			return []transformSchemaError{{Path: at + ".attributes", Message: fmt.Sprintf("must be of type object, got %s", jsonTypeName(attributes))}}
+		}
+	}
+	schema, known := s.byType[transformType]
+	if !known {
+		return nil
+	}
+	if !present {
+		attributes = map[string]any{}
+	}
+	return s.validate(schema, attributes, at+".attributes")
+}
+
+func jsonTypeMatches(schemaType string, value any) bool {
+	switch schemaType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		f, ok := value.(float64)
+		return ok && f == math.Trunc(f)
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "null":
+		return value == nil
+	}
+	return false
+}
+
+func jsonTypeName(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case nil:
+		return "null"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func formatEnum(values []any) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprintf("%v", v)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transform
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestTransformSchemasValidateAttributes(t *testing.T) {
+	t.Parallel()
+
+	schemas, err := transformSchemas()
+	if err != nil {
+		t.Fatalf("loading transform schemas: %v", err)
+	}
+
+	tests := map[string]struct {
+		transformType string
+		attributes    string
+		// wantErrors lists substrings expected in the reported errors, in order.
+		wantErrors []string
+	}{
+		"no attributes for lower": {
+			transformType: "lower",
+		},
+		"unknown type passes through": {
+			transformType: "somethingNew",
+			attributes:    `{"anything": [1, 2, 3]}`,
+		},
+		"valid substring": {
+			transformType: "substring",
+			attributes:    `{"begin": 0, "end": 3}`,
+		},
+		"misspelled attribute": {
+			transformType: "substring",
+			attributes:    `{"begin": 0, "ned": 3}`,
+			wantErrors:    []string{"$.ned: unsupported attribute"},
+		},
+		"missing required attribute": {
+			transformType: "substring",
+			wantErrors:    []string{`$: missing required attribute "begin"`},
+		},
+		"wrong type": {
+			transformType: "substring",
+			attributes:    `{"begin": "0"}`,
+			wantErrors:    []string{"$.begin: must be of type integer, got string"},
+		},
+		"non-integer number": {
+			transformType: "substring",
+			attributes:    `{"begin": 1.5}`,
+			wantErrors:    []string{"$.begin: must be of type integer"},
+		},
+		"common attributes accepted": {
+			transformType: "lower",
+			attributes:    `{"requiresPeriodicRefresh": true, "input": {"type": "trim"}}`,
+		},
+		"nested transform validated": {
+			transformType: "concat",
+			attributes:    `{"values": ["a", {"type": "substring", "attributes": {"begin": 0, "length": 2}}]}`,
+			wantErrors:    []string{"$.values[1].attributes.length: unsupported attribute"},
+		},
+		"nested input validated": {
+			transformType: "upper",
+			attributes:    `{"input": {"type": "accountAttribute", "attributes": {"sourceName": "HR"}}}`,
+			wantErrors:    []string{`$.input.attributes: missing required attribute "attributeName"`},
+		},
+		"nested transform without type": {
+			transformType: "firstValid",
+			attributes:    `{"values": [{"attributes": {}}]}`,
+			wantErrors:    []string{`$.values[0]: nested transform must have a string "type"`},
+		},
+		"value neither string nor transform": {
+			transformType: "concat",
+			attributes:    `{"values": [42]}`,
+			wantErrors:    []string{"$.values[0]: must be of type string or a nested transform object, got number"},
+		},
+		"empty values": {
+			transformType: "concat",
+			attributes:    `{"values": []}`,
+			wantErrors:    []string{"$.values: must contain at least 1 item(s)"},
+		},
+		"lookup table values must be strings": {
+			transformType: "lookup",
+			attributes:    `{"table": {"US": "United States", "default": false}}`,
+			wantErrors:    []string{"$.table.default: must be of type string, got boolean"},
+		},
+		"static allows velocity variables": {
+			transformType: "static",
+			attributes:    `{"value": "$first $last", "first": {"type": "identityAttribute", "attributes": {"name": "firstname"}}, "last": "x"}`,
+		},
+		"rule allows arbitrary arguments": {
+			transformType: "rule",
+			attributes:    `{"name": "Generate Username", "operation": "generate", "retries": 3}`,
+		},
+		"every error reported": {
+			transformType: "conditional",
+			attributes:    `{"expression": "$a eq b"}`,
+			wantErrors: []string{
+				`missing required attribute "positiveCondition"`,
+				`missing required attribute "negativeCondition"`,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var attributes any
+			if tc.attributes != "" {
+				if err := json.Unmarshal([]byte(tc.attributes), &attributes); err != nil {
+					t.Fatalf("invalid test attributes JSON: %v", err)
+				}
+			}
+
+			errs := schemas.validateAttributes(tc.transformType, attributes)
+			if len(errs) != len(tc.wantErrors) {
+				t.Fatalf("got %d errors, want %d: %v", len(errs), len(tc.wantErrors), errs)
+			}
+			for i, want := range tc.wantErrors {
+				if !strings.Contains(errs[i].Error(), want) {
+					t.Errorf("error %d = %q, want it to contain %q", i, errs[i].Error(), want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package verified_from_address
+
+import (
+	"context"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// verifiedFromAddressModel represents the Terraform state for the Verified From Address resource.
+type verifiedFromAddressModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Email              types.String `tfsdk:"email"`
+	VerificationStatus types.String `tfsdk:"verification_status"`
+	Verified           types.Bool   `tfsdk:"verified"`
+}
+
+// FromAPI maps the API response into the Terraform state.
+func (m *verifiedFromAddressModel) FromAPI(_ context.Context, api *client.VerifiedFromAddressAPI) diag.Diagnostics {
+	m.ID = types.StringValue(api.ID)
+	m.Email = types.StringValue(api.Email)
+	m.VerificationStatus = common.StringOrNullIfEmpty(api.VerificationStatus)
+	m.Verified = types.BoolValue(api.VerificationStatus == client.VerifiedFromAddressStatusSuccess)
+	return nil
+}
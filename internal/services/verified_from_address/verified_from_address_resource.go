@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package verified_from_address
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &verifiedFromAddressResource{}
+	_ resource.ResourceWithConfigure   = &verifiedFromAddressResource{}
+	_ resource.ResourceWithImportState = &verifiedFromAddressResource{}
+)
+
+// emailPattern is a loose check that catches obvious typos; SailPoint does the real validation.
+var emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+type verifiedFromAddressResource struct {
+	client *client.Client
+}
+
+// NewVerifiedFromAddressResource creates a new Verified From Address resource.
+func NewVerifiedFromAddressResource() resource.Resource {
+	return &verifiedFromAddressResource{}
+}
+
+func (r *verifiedFromAddressResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_verified_from_address"
+}
+
+func (r *verifiedFromAddressResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "verified from address resource")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = c
+}
+
+func (r *verifiedFromAddressResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource for a SailPoint verified sender email address.",
+		MarkdownDescription: "Resource for a SailPoint verified sender email address, which notification templates can use as their `from` address. " +
+			"SailPoint emails a verification link to the address when it is added; the address can only be used once the link has been followed. " +
+			"`verification_status` is refreshed on every plan.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the sender address.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The sender email address.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(emailPattern, "must be an email address"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"verification_status": schema.StringAttribute{
+				MarkdownDescription: "The verification status: `PENDING`, `SUCCESS` or `FAILED`.",
+				Computed:            true,
+			},
+			"verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the address has been verified and can be used as a sender.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *verifiedFromAddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan verifiedFromAddressModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := plan.Email.ValueString()
+	apiResp, err := r.client.CreateVerifiedFromAddress(ctx, email)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SailPoint Verified From Address",
+			fmt.Sprintf("Could not create SailPoint Verified From Address %q: %s", email, err.Error()),
+		)
+		return
+	}
+	if apiResp == nil {
+		resp.Diagnostics.AddError("Error Creating SailPoint Verified From Address", "Received nil response from SailPoint API")
+		return
+	}
+
+	var state verifiedFromAddressModel
+	resp.Diagnostics.Append(state.FromAPI(ctx, apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *verifiedFromAddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state verifiedFromAddressModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := state.Email.ValueString()
+	addresses, err := r.client.ListVerifiedFromAddresses(ctx, fmt.Sprintf("email eq %q", email))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Verified From Address",
+			fmt.Sprintf("Could not read SailPoint Verified From Address %q: %s", email, err.Error()),
+		)
+		return
+	}
+
+	var address *client.VerifiedFromAddressAPI
+	for i := range addresses {
+		if strings.EqualFold(addresses[i].Email, email) {
+			address = &addresses[i]
+			break
+		}
+	}
+	if address == nil {
+		tflog.Info(ctx, "Verified from address not found, removing from state", map[string]any{"email": email})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(state.FromAPI(ctx, address)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Keep the configured spelling of the address.
+	state.Email = types.StringValue(email)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called: the only configurable attribute requires replacement.
+func (r *verifiedFromAddressResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Error Updating SailPoint Verified From Address",
+		"Verified from addresses cannot be updated; changing the email address replaces the resource.",
+	)
+}
+
+func (r *verifiedFromAddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state verifiedFromAddressModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteVerifiedFromAddress(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting SailPoint Verified From Address",
+			fmt.Sprintf("Could not delete SailPoint Verified From Address %q: %s", state.Email.ValueString(), err.Error()),
+		)
+		return
+	}
+	tflog.Info(ctx, "Successfully deleted verified from address", map[string]any{"email": state.Email.ValueString()})
+}
+
+// ImportState imports a sender address by its email address.
+func (r *verifiedFromAddressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("email"), req, resp)
+}
@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Step types the graph validation treats specially. `success` and `failure`
+// end a workflow run; every path through the graph must reach one of them.
+const (
+	workflowStepTypeSuccess = "success"
+	workflowStepTypeFailure = "failure"
+	workflowStepTypeChoice  = "choice"
+)
+
+// workflowStep is the subset of a step definition the graph validation needs.
+// Everything else (actionId, attributes, displayName, ...) is opaque here.
+type workflowStep struct {
+	Type        string `json:"type"`
+	NextStep    string `json:"nextStep"`
+	DefaultStep string `json:"defaultStep"`
+	ChoiceList  []struct {
+		NextStep string `json:"nextStep"`
+	} `json:"choiceList"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+// workflowEdge is a reference from one step to another, labelled with the
+// JSON path of the field holding the reference so diagnostics can point at it.
+type workflowEdge struct {
+	target   string
+	jsonPath string
+}
+
+func (s workflowStep) isTerminal() bool {
+	return s.Type == workflowStepTypeSuccess || s.Type == workflowStepTypeFailure
+}
+
+func (s workflowStep) edges(stepPath string) []workflowEdge {
+	var edges []workflowEdge
+	if s.NextStep != "" {
+		edges = append(edges, workflowEdge{target: s.NextStep, jsonPath: stepPath + ".nextStep"})
+	}
+	for i, choice := range s.ChoiceList {
+		if choice.NextStep != "" {
+			edges = append(edges, workflowEdge{target: choice.NextStep, jsonPath: fmt.Sprintf("%s.choiceList[%d].nextStep", stepPath, i)})
+		}
+	}
+	if s.DefaultStep != "" {
+		edges = append(edges, workflowEdge{target: s.DefaultStep, jsonPath: stepPath + ".defaultStep"})
+	}
+	return edges
+}
+
+// nestedDefinition returns the inner definition of a loop step, whose
+// attributes carry their own `start` and `steps` in the same shape as the
+// top-level definition.
+func (s workflowStep) nestedDefinition() (*client.WorkflowDefinitionAPI, bool) {
+	start, ok := s.Attributes["start"].(string)
+	if !ok {
+		return nil, false
+	}
+	steps, ok := s.Attributes["steps"].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	return &client.WorkflowDefinitionAPI{Start: start, Steps: steps}, true
+}
+
+// validateWorkflowDefinition builds the step graph of a workflow definition
+// and reports structural problems that SailPoint would otherwise only reject
+// at apply time: a missing or dangling `start`, `nextStep`/`defaultStep`/
+// `choiceList[].nextStep` references to steps that do not exist, choice steps
+// without a `defaultStep`, definitions without a `success`/`failure` end
+// step, steps unreachable from `start`, and steps (including cycles) from
+// which no end step can be reached.
+//
+// startPath and stepsPath are the attribute paths of `definition.start` and
+// `definition.steps`; problems inside the steps JSON are reported on
+// stepsPath with the offending JSON path in the message. Loop steps carrying
+// a nested definition in their attributes are validated recursively.
+func validateWorkflowDefinition(def client.WorkflowDefinitionAPI, startPath, stepsPath path.Path) diag.Diagnostics {
+	return validateWorkflowGraph(def, "$", startPath, stepsPath)
+}
+
+func validateWorkflowGraph(def client.WorkflowDefinitionAPI, jsonRoot string, startPath, stepsPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	steps := make(map[string]workflowStep, len(def.Steps))
+	for _, name := range sortedStepNames(def.Steps) {
+		raw, err := json.Marshal(def.Steps[name])
+		if err == nil {
+			var step workflowStep
+			err = json.Unmarshal(raw, &step)
+			steps[name] = step
+		}
+		if err != nil {
+			diags.AddAttributeError(stepsPath, "Invalid Workflow Step",
+				fmt.Sprintf("Step %s must be a JSON object with string `type`, `nextStep` and `defaultStep` fields: %s", stepJSONPath(jsonRoot, name), err))
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if def.Start == "" {
+		diags.AddAttributeError(startPath, "Missing Workflow Start Step",
+			fmt.Sprintf("The workflow definition at %s must name its first step in `start`.", jsonRoot))
+		return diags
+	}
+	if _, ok := steps[def.Start]; !ok {
+		diags.AddAttributeError(startPath, "Unknown Workflow Start Step",
+			fmt.Sprintf("`start` references step %q, which is not defined in %s.steps. Defined steps: %s.",
+				def.Start, jsonRoot, strings.Join(sortedStepNames(def.Steps), ", ")))
+		return diags
+	}
+
+	names := sortedStepNames(def.Steps)
+	hasTerminal := false
+	for _, name := range names {
+		step := steps[name]
+		stepPath := stepJSONPath(jsonRoot, name)
+
+		if step.isTerminal() {
+			hasTerminal = true
+		}
+		if step.Type == workflowStepTypeChoice && step.DefaultStep == "" {
+			diags.AddAttributeError(stepsPath, "Missing Workflow Default Step",
+				fmt.Sprintf("Choice step %s must set `defaultStep` so the workflow can continue when no choice matches.", stepPath))
+		}
+		if !step.isTerminal() && len(step.edges(stepPath)) == 0 {
+			diags.AddAttributeError(stepsPath, "Missing Workflow Next Step",
+				fmt.Sprintf("Step %s is not a `success` or `failure` step and must set `nextStep`.", stepPath))
+		}
+		for _, edge := range step.edges(stepPath) {
+			if _, ok := steps[edge.target]; !ok {
+				diags.AddAttributeError(stepsPath, "Unknown Workflow Step Reference",
+					fmt.Sprintf("%s references step %q, which is not defined in %s.steps.", edge.jsonPath, edge.target, jsonRoot))
+			}
+		}
+		if nested, ok := step.nestedDefinition(); ok {
+			diags.Append(validateWorkflowGraph(*nested, stepPath+".attributes", stepsPath, stepsPath)...)
+		}
+	}
+
+	if !hasTerminal {
+		diags.AddAttributeError(stepsPath, "Missing Workflow End Step",
+			fmt.Sprintf("%s.steps must contain at least one step with `type` set to `success` or `failure`.", jsonRoot))
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	reachable := reachableSteps(steps, def.Start, jsonRoot)
+	for _, name := range names {
+		if !reachable[name] {
+			diags.AddAttributeError(stepsPath, "Unreachable Workflow Step",
+				fmt.Sprintf("Step %s cannot be reached from `start` (%q).", stepJSONPath(jsonRoot, name), def.Start))
+		}
+	}
+
+	canFinish := stepsReachingEnd(steps, jsonRoot)
+	for _, name := range names {
+		if reachable[name] && !canFinish[name] {
+			diags.AddAttributeError(stepsPath, "Workflow Cycle Without Exit",
+				fmt.Sprintf("Step %s is part of a cycle from which no `success` or `failure` step can be reached.", stepJSONPath(jsonRoot, name)))
+		}
+	}
+
+	return diags
+}
+
+// reachableSteps returns the set of steps reachable from start.
+func reachableSteps(steps map[string]workflowStep, start, jsonRoot string) map[string]bool {
+	seen := map[string]bool{start: true}
+	queue := []string{start}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, edge := range steps[name].edges(stepJSONPath(jsonRoot, name)) {
+			if _, ok := steps[edge.target]; ok && !seen[edge.target] {
+				seen[edge.target] = true
+				queue = append(queue, edge.target)
+			}
+		}
+	}
+	return seen
+}
+
+// stepsReachingEnd returns the set of steps from which a terminal step can be
+// reached, by walking the graph backwards from every terminal step.
+func stepsReachingEnd(steps map[string]workflowStep, jsonRoot string) map[string]bool {
+	predecessors := make(map[string][]string, len(steps))
+	var queue []string
+	seen := make(map[string]bool, len(steps))
+	for name, step := range steps {
+		for _, edge := range step.edges(stepJSONPath(jsonRoot, name)) {
+			predecessors[edge.target] = append(predecessors[edge.target], name)
+		}
+		if step.isTerminal() {
+			seen[name] = true
+			queue = append(queue, name)
+		}
+	}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, pred := range predecessors[name] {
+			if !seen[pred] {
+				seen[pred] = true
+				queue = append(queue, pred)
+			}
+		}
+	}
+	return seen
+}
+
+func sortedStepNames(steps map[string]any) []string {
+	names := make([]string, 0, len(steps))
+	for name := range steps {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// stepJSONPath renders the JSON path of a step, quoting the name since step
+// names are free-form display strings such as "Send Email".
+func stepJSONPath(jsonRoot, name string) string {
+	return fmt.Sprintf("%s.steps[%q]", jsonRoot, name)
+}
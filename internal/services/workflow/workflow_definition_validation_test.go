@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workflow
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestValidateWorkflowDefinition(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		start string
+		steps string
+		// wantSummaries lists the expected diagnostic summaries, in order.
+		wantSummaries []string
+		// wantDetail is a substring expected in the first diagnostic detail.
+		wantDetail string
+	}{
+		"linear workflow": {
+			start: "Send Email",
+			steps: `{
+				"Send Email": {"actionId": "sp:send-email", "type": "action", "nextStep": "success"},
+				"success": {"type": "success"}
+			}`,
+		},
+		"choice with default": {
+			start: "Compare",
+			steps: `{
+				"Compare": {"type": "choice", "choiceList": [{"comparator": "StringEquals", "nextStep": "success"}], "defaultStep": "failure"},
+				"success": {"type": "success"},
+				"failure": {"type": "failure"}
+			}`,
+		},
+		"cycle with exit": {
+			start: "Wait",
+			steps: `{
+				"Wait": {"type": "action", "nextStep": "Check"},
+				"Check": {"type": "choice", "choiceList": [{"nextStep": "Wait"}], "defaultStep": "success"},
+				"success": {"type": "success"}
+			}`,
+		},
+		"unknown start": {
+			start:         "Missing",
+			steps:         `{"success": {"type": "success"}}`,
+			wantSummaries: []string{"Unknown Workflow Start Step"},
+			wantDetail:    `"Missing"`,
+		},
+		"dangling nextStep": {
+			start:         "A",
+			steps:         `{"A": {"type": "action", "nextStep": "B"}, "success": {"type": "success"}}`,
+			wantSummaries: []string{"Unknown Workflow Step Reference"},
+			wantDetail:    `$.steps["A"].nextStep references step "B"`,
+		},
+		"dangling choice nextStep": {
+			start: "A",
+			steps: `{
+				"A": {"type": "choice", "choiceList": [{"nextStep": "success"}, {"nextStep": "Nope"}], "defaultStep": "success"},
+				"success": {"type": "success"}
+			}`,
+			wantSummaries: []string{"Unknown Workflow Step Reference"},
+			wantDetail:    `$.steps["A"].choiceList[1].nextStep`,
+		},
+		"choice without default": {
+			start:         "A",
+			steps:         `{"A": {"type": "choice", "choiceList": [{"nextStep": "success"}]}, "success": {"type": "success"}}`,
+			wantSummaries: []string{"Missing Workflow Default Step"},
+		},
+		"non-terminal step without nextStep": {
+			start:         "A",
+			steps:         `{"A": {"type": "action"}, "success": {"type": "success"}}`,
+			wantSummaries: []string{"Missing Workflow Next Step"},
+		},
+		"no end step": {
+			start:         "A",
+			steps:         `{"A": {"type": "action", "nextStep": "B"}, "B": {"type": "action", "nextStep": "A"}}`,
+			wantSummaries: []string{"Missing Workflow End Step"},
+		},
+		"unreachable step": {
+			start: "A",
+			steps: `{
+				"A": {"type": "action", "nextStep": "success"},
+				"Orphan": {"type": "action", "nextStep": "success"},
+				"success": {"type": "success"}
+			}`,
+			wantSummaries: []string{"Unreachable Workflow Step"},
+			wantDetail:    `$.steps["Orphan"]`,
+		},
+		"cycle without exit": {
+			start: "A",
+			steps: `{
+				"A": {"type": "choice", "choiceList": [{"nextStep": "B"}], "defaultStep": "success"},
+				"B": {"type": "action", "nextStep": "C"},
+				"C": {"type": "action", "nextStep": "B"},
+				"success": {"type": "success"}
+			}`,
+			wantSummaries: []string{"Workflow Cycle Without Exit", "Workflow Cycle Without Exit"},
+			wantDetail:    `$.steps["B"]`,
+		},
+		"nested loop definition is validated": {
+			start: "Loop",
+			steps: `{
+				"Loop": {"actionId": "sp:loop:iterator", "type": "action", "nextStep": "success", "attributes": {
+					"start": "Inner",
+					"steps": {"Inner": {"type": "action", "nextStep": "Gone"}, "end": {"type": "success"}}
+				}},
+				"success": {"type": "success"}
+			}`,
+			wantSummaries: []string{"Unknown Workflow Step Reference"},
+			wantDetail:    `$.steps["Loop"].attributes.steps["Inner"].nextStep`,
+		},
+	}
+
+	startPath := path.Root("definition").AtName("start")
+	stepsPath := path.Root("definition").AtName("steps")
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var steps map[string]any
+			if err := json.Unmarshal([]byte(tc.steps), &steps); err != nil {
+				t.Fatalf("invalid test steps JSON: %v", err)
+			}
+
+			diags := validateWorkflowDefinition(client.WorkflowDefinitionAPI{Start: tc.start, Steps: steps}, startPath, stepsPath)
+
+			var got []string
+			for _, d := range diags {
+				got = append(got, d.Summary())
+			}
+			if strings.Join(got, "|") != strings.Join(tc.wantSummaries, "|") {
+				t.Fatalf("diagnostics = %q, want %q\n%v", got, tc.wantSummaries, diags)
+			}
+			if tc.wantDetail != "" && !strings.Contains(diags[0].Detail(), tc.wantDetail) {
+				t.Errorf("detail %q does not contain %q", diags[0].Detail(), tc.wantDetail)
+			}
+		})
+	}
+}
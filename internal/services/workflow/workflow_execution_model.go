@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workflow
+
+import (
+	"encoding/json"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Attributes of a failure event naming the failing step and describing the error, in order of preference.
+var (
+	failureStepAttributes  = []string{"displayName", "stepName", "stepId"}
+	failureErrorAttributes = []string{"error", "failure", "reason", "message"}
+)
+
+// workflowExecutionModel represents an execution of a workflow.
+type workflowExecutionModel struct {
+	ID         types.String `tfsdk:"id"`
+	Status     types.String `tfsdk:"status"`
+	StartTime  types.String `tfsdk:"start_time"`
+	CloseTime  types.String `tfsdk:"close_time"`
+	FailedStep types.String `tfsdk:"failed_step"`
+	Error      types.String `tfsdk:"error"`
+}
+
+// FromAPI maps an execution into the model. history is only needed for failed executions and may be nil.
+func (m *workflowExecutionModel) FromAPI(api *client.WorkflowExecutionAPI, history []client.WorkflowExecutionEventAPI) {
+	m.ID = types.StringValue(api.ID)
+	m.Status = types.StringValue(api.Status)
+	m.StartTime = common.StringOrNullIfEmpty(api.StartTime)
+	m.CloseTime = common.StringOrNullIfEmpty(api.CloseTime)
+
+	step, message := executionFailure(history)
+	m.FailedStep = common.StringOrNullIfEmpty(step)
+	m.Error = common.StringOrNullIfEmpty(message)
+}
+
+// executionFailure returns the step that failed and the error reported, from the history of a failed
+// execution. The last failed step wins; the error of the execution itself is used when the step has none.
+func executionFailure(history []client.WorkflowExecutionEventAPI) (step, message string) {
+	var executionError string
+	for _, event := range history {
+		switch event.Type {
+		case client.WorkflowExecutionEventActivityTaskFailed:
+			step = firstAttribute(event.Attributes, failureStepAttributes)
+			message = firstAttribute(event.Attributes, failureErrorAttributes)
+		case client.WorkflowExecutionEventWorkflowExecutionFailed:
+			executionError = firstAttribute(event.Attributes, failureErrorAttributes)
+		}
+	}
+	if message == "" {
+		message = executionError
+	}
+	return step, message
+}
+
+// firstAttribute returns the first of keys set in attributes, as text. Values that are not strings are
+// encoded as JSON.
+func firstAttribute(attributes map[string]any, keys []string) string {
+	for _, key := range keys {
+		switch value := attributes[key].(type) {
+		case nil:
+			continue
+		case string:
+			if value != "" {
+				return value
+			}
+		default:
+			if encoded, err := json.Marshal(value); err == nil {
+				return string(encoded)
+			}
+		}
+	}
+	return ""
+}
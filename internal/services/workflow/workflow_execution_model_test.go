@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workflow
+
+import (
+	"testing"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+)
+
+func TestExecutionFailure(t *testing.T) {
+	t.Parallel()
+
+	event := func(eventType string, attributes map[string]any) client.WorkflowExecutionEventAPI {
+		return client.WorkflowExecutionEventAPI{Type: eventType, Attributes: attributes}
+	}
+
+	tests := map[string]struct {
+		history     []client.WorkflowExecutionEventAPI
+		wantStep    string
+		wantMessage string
+	}{
+		"no history": {},
+		"completed": {
+			history: []client.WorkflowExecutionEventAPI{
+				event("WorkflowExecutionStarted", nil),
+				event("ActivityTaskCompleted", map[string]any{"displayName": "Send Email"}),
+				event("WorkflowExecutionCompleted", nil),
+			},
+		},
+		"failed step": {
+			history: []client.WorkflowExecutionEventAPI{
+				event("ActivityTaskCompleted", map[string]any{"displayName": "Get Identity"}),
+				event(client.WorkflowExecutionEventActivityTaskFailed, map[string]any{"displayName": "HTTP Request", "error": "status code 500"}),
+				event(client.WorkflowExecutionEventWorkflowExecutionFailed, map[string]any{"error": "step failed"}),
+			},
+			wantStep:    "HTTP Request",
+			wantMessage: "status code 500",
+		},
+		"error from execution": {
+			history: []client.WorkflowExecutionEventAPI{
+				event(client.WorkflowExecutionEventActivityTaskFailed, map[string]any{"stepId": "http-request"}),
+				event(client.WorkflowExecutionEventWorkflowExecutionFailed, map[string]any{"reason": "step failed"}),
+			},
+			wantStep:    "http-request",
+			wantMessage: "step failed",
+		},
+		"structured error": {
+			history: []client.WorkflowExecutionEventAPI{
+				event(client.WorkflowExecutionEventActivityTaskFailed, map[string]any{
+					"displayName": "HTTP Request",
+					"error":       map[string]any{"code": 500},
+				}),
+			},
+			wantStep:    "HTTP Request",
+			wantMessage: `{"code":500}`,
+		},
+		"last failed step wins": {
+			history: []client.WorkflowExecutionEventAPI{
+				event(client.WorkflowExecutionEventActivityTaskFailed, map[string]any{"displayName": "First", "error": "retrying"}),
+				event(client.WorkflowExecutionEventActivityTaskFailed, map[string]any{"displayName": "Second", "error": "gave up"}),
+			},
+			wantStep:    "Second",
+			wantMessage: "gave up",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			step, message := executionFailure(tc.history)
+			if step != tc.wantStep {
+				t.Errorf("got step %q, want %q", step, tc.wantStep)
+			}
+			if message != tc.wantMessage {
+				t.Errorf("got message %q, want %q", message, tc.wantMessage)
+			}
+		})
+	}
+}
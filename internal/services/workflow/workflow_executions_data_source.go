@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultExecutionsLimit is how many executions are returned when no limit is configured.
+const defaultExecutionsLimit = 10
+
+var (
+	_ datasource.DataSource              = &workflowExecutionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &workflowExecutionsDataSource{}
+)
+
+type workflowExecutionsDataSource struct {
+	client *client.Client
+}
+
+// workflowExecutionsDataSourceModel represents the Terraform state for the Workflow Executions data source.
+type workflowExecutionsDataSourceModel struct {
+	WorkflowID types.String             `tfsdk:"workflow_id"`
+	Status     types.String             `tfsdk:"status"`
+	Limit      types.Int64              `tfsdk:"limit"`
+	Executions []workflowExecutionModel `tfsdk:"executions"`
+}
+
+// NewWorkflowExecutionsDataSource creates a new data source for the executions of a SailPoint Workflow.
+func NewWorkflowExecutionsDataSource() datasource.DataSource {
+	return &workflowExecutionsDataSource{}
+}
+
+// Metadata implements datasource.DataSource.
+func (d *workflowExecutionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_executions"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *workflowExecutionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "workflow executions data source")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = c
+}
+
+// Schema implements datasource.DataSource.
+func (d *workflowExecutionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the recent executions of a SailPoint Workflow.",
+		MarkdownDescription: "Retrieves the recent executions of a SailPoint Workflow, newest first. " +
+			"Failed executions include the step that failed and its error, so a pipeline can check the outcome of a run.",
+		Attributes: map[string]schema.Attribute{
+			"workflow_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workflow.",
+				Required:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return executions with this status (e.g., `Failed`).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of executions to return, between 1 and 250. Defaults to `%d`.", defaultExecutionsLimit),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 250),
+				},
+			},
+			"executions": schema.ListNestedAttribute{
+				MarkdownDescription: "The executions, newest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the execution.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the execution (e.g., `Completed`, `Failed`, `Canceled`).",
+							Computed:            true,
+						},
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "The date and time the execution started.",
+							Computed:            true,
+						},
+						"close_time": schema.StringAttribute{
+							MarkdownDescription: "The date and time the execution ended, if it has.",
+							Computed:            true,
+						},
+						"failed_step": schema.StringAttribute{
+							MarkdownDescription: "The step that failed, for failed executions.",
+							Computed:            true,
+						},
+						"error": schema.StringAttribute{
+							MarkdownDescription: "The error reported by the failed step or execution.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read implements datasource.DataSource.
+func (d *workflowExecutionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config workflowExecutionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflowID := config.WorkflowID.ValueString()
+	tflog.Debug(ctx, "Reading workflow executions data source", map[string]any{
+		"workflow_id": workflowID,
+	})
+
+	limit := defaultExecutionsLimit
+	if !config.Limit.IsNull() {
+		limit = int(config.Limit.ValueInt64())
+	}
+	filters := ""
+	if !config.Status.IsNull() {
+		filters = fmt.Sprintf("status eq %q", config.Status.ValueString())
+	}
+
+	executions, err := d.client.ListWorkflowExecutions(ctx, workflowID, limit, filters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SailPoint Workflow Executions",
+			fmt.Sprintf("Could not list executions of SailPoint Workflow %q: %s", workflowID, err.Error()),
+		)
+		return
+	}
+
+	state := config
+	state.Executions = make([]workflowExecutionModel, 0, len(executions))
+	for i := range executions {
+		var history []client.WorkflowExecutionEventAPI
+		if executions[i].Status == client.WorkflowExecutionStatusFailed {
+			history, err = d.client.GetWorkflowExecutionHistory(ctx, executions[i].ID)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading SailPoint Workflow Executions",
+					fmt.Sprintf("Could not read the history of execution %q: %s", executions[i].ID, err.Error()),
+				)
+				return
+			}
+		}
+
+		var execution workflowExecutionModel
+		execution.FromAPI(&executions[i], history)
+		state.Executions = append(state.Executions, execution)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
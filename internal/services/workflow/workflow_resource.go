@@ -12,6 +12,7 @@ import (
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,13 +20,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &workflowResource{}
-	_ resource.ResourceWithConfigure   = &workflowResource{}
-	_ resource.ResourceWithImportState = &workflowResource{}
+	_ resource.Resource                   = &workflowResource{}
+	_ resource.ResourceWithConfigure      = &workflowResource{}
+	_ resource.ResourceWithImportState    = &workflowResource{}
+	_ resource.ResourceWithIdentity       = &workflowResource{}
+	_ resource.ResourceWithValidateConfig = &workflowResource{}
 )
 
 type workflowResource struct {
@@ -154,6 +159,9 @@ func (r *workflowResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					"type": schema.StringAttribute{
 						MarkdownDescription: "The type of the owner (e.g., `IDENTITY`).",
 						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.OwnerTypes...),
+						},
 					},
 					"id": schema.StringAttribute{
 						MarkdownDescription: "The ID of the owner.",
@@ -166,7 +174,7 @@ func (r *workflowResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"definition": schema.SingleNestedAttribute{
-				MarkdownDescription: "The workflow definition containing the steps to execute. If not specified, the workflow will have no definition.",
+				MarkdownDescription: "The workflow definition containing the steps to execute. If not specified, the workflow will have no definition. The step graph is checked at plan time: `start` and every `nextStep`, `defaultStep` and `choiceList[].nextStep` must name a defined step, choice steps need a `defaultStep`, at least one `success` or `failure` step must exist, and every step must be reachable from `start` and able to reach an end step.",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"start": schema.StringAttribute{
@@ -195,6 +203,46 @@ func (r *workflowResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (r *workflowResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IDIdentitySchema()
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig. It parses the
+// workflow definition and checks the step graph so broken definitions are
+// rejected at plan time rather than by SailPoint at apply time.
+func (r *workflowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var start types.String
+	var steps workflowStepsValue
+	startPath := path.Root("definition").AtName("start")
+	stepsPath := path.Root("definition").AtName("steps")
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, startPath, &start)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, stepsPath, &steps)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to check when the definition is omitted or not yet known
+	// (e.g. built from another resource's attributes).
+	if start.IsNull() || start.IsUnknown() || steps.IsNull() || steps.IsUnknown() {
+		return
+	}
+
+	stepsMap, diags := common.UnmarshalJSONField[map[string]interface{}](steps.Normalized)
+	if diags.HasError() || stepsMap == nil {
+		// Invalid JSON is reported by the custom type's own validation.
+		return
+	}
+
+	tflog.Debug(ctx, "Validating workflow definition step graph", map[string]any{
+		"start": start.ValueString(),
+		"steps": len(*stepsMap),
+	})
+	def := client.WorkflowDefinitionAPI{Start: start.ValueString(), Steps: *stepsMap}
+	resp.Diagnostics.Append(validateWorkflowDefinition(def, startPath, stepsPath)...)
+}
+
 // Create implements resource.Resource.
 func (r *workflowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan workflowModel
@@ -256,6 +304,7 @@ func (r *workflowResource) Create(ctx context.Context, req resource.CreateReques
 		"name": plan.Name.ValueString(),
 	})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -321,6 +370,7 @@ func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, r
 		"id": state.ID.ValueString(),
 	})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -405,6 +455,7 @@ func (r *workflowResource) Update(ctx context.Context, req resource.UpdateReques
 		"id": newState.ID.ValueString(),
 	})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.IDIdentityModel{ID: newState.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -480,7 +531,7 @@ func (r *workflowResource) ImportState(ctx context.Context, req resource.ImportS
 		"id": req.ID,
 	})
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 
 	tflog.Info(ctx, "Successfully imported SailPoint Workflow resource", map[string]any{
 		"id": req.ID,
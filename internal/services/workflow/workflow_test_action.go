@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
+	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// executionPollInterval is the delay between two reads of a workflow execution.
+const executionPollInterval = 5 * time.Second
+
+var (
+	_ action.Action              = &workflowTestAction{}
+	_ action.ActionWithConfigure = &workflowTestAction{}
+)
+
+type workflowTestAction struct {
+	client *client.Client
+}
+
+// workflowTestActionModel represents the configuration of the workflow test action.
+type workflowTestActionModel struct {
+	WorkflowID types.String         `tfsdk:"workflow_id"`
+	Input      jsontypes.Normalized `tfsdk:"input"`
+	Timeout    types.String         `tfsdk:"timeout"`
+}
+
+// NewWorkflowTestAction creates a new action running a SailPoint Workflow with test input.
+func NewWorkflowTestAction() action.Action {
+	return &workflowTestAction{}
+}
+
+// Metadata implements action.Action.
+func (a *workflowTestAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_test"
+}
+
+// Configure implements action.ActionWithConfigure.
+func (a *workflowTestAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	c, diags := common.ConfigureClient(ctx, req.ProviderData, "workflow test action")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	a.client = c
+}
+
+// Schema implements action.Action.
+func (a *workflowTestAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	timeout := common.TaskTimeoutAttribute()
+	timeout.MarkdownDescription = fmt.Sprintf("How long to wait for the execution to finish, as a duration such as `5m` or `1h`. Defaults to `%s`.", common.DefaultTaskTimeout)
+
+	resp.Schema = schema.Schema{
+		Description: "Runs a SailPoint Workflow with test input and waits for the execution to finish.",
+		MarkdownDescription: "Runs a SailPoint Workflow with test input, as if it had been sent by the workflow's trigger, and waits for the execution to finish. " +
+			"The action fails when the execution fails or is canceled, reporting the failing step and its error. " +
+			"The run is live: its steps take effect in the tenant.",
+		Attributes: map[string]schema.Attribute{
+			"workflow_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workflow to run.",
+				Required:            true,
+			},
+			"input": schema.StringAttribute{
+				MarkdownDescription: "The input of the workflow as a JSON object, shaped like the payload of its trigger. Use `jsonencode()` to build it.",
+				Required:            true,
+				CustomType:          jsontypes.NormalizedType{},
+			},
+			"timeout": timeout,
+		},
+	}
+}
+
+// Invoke implements action.Action.
+func (a *workflowTestAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config workflowTestActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := common.TaskTimeout(config.Timeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflowID := config.WorkflowID.ValueString()
+
+	executionID, err := a.client.TestWorkflow(ctx, workflowID, json.RawMessage(config.Input.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Testing SailPoint Workflow",
+			fmt.Sprintf("Could not run SailPoint Workflow %q with the test input: %s", workflowID, err.Error()),
+		)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Started workflow %s (execution %s)", workflowID, executionID)})
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	execution, err := a.client.WaitForWorkflowExecution(waitCtx, executionID, executionPollInterval, func(execution *client.WorkflowExecutionAPI) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Execution %s is %s", executionID, execution.Status)})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for SailPoint Workflow Execution",
+			fmt.Sprintf("Could not wait for execution %q of SailPoint Workflow %q: %s", executionID, workflowID, err.Error()),
+		)
+		return
+	}
+
+	if execution.Status != client.WorkflowExecutionStatusCompleted {
+		detail := fmt.Sprintf("Execution %q of SailPoint Workflow %q ended with status %s.", executionID, workflowID, execution.Status)
+
+		history, err := a.client.GetWorkflowExecutionHistory(ctx, executionID)
+		if err != nil {
+			tflog.Warn(ctx, "Could not read workflow execution history", map[string]any{
+				"execution_id": executionID,
+				"error":        err.Error(),
+			})
+		}
+		step, message := executionFailure(history)
+		if step != "" {
+			detail += fmt.Sprintf(" Failed step: %s.", step)
+		}
+		if message != "" {
+			detail += fmt.Sprintf(" Error: %s", message)
+		}
+
+		resp.Diagnostics.AddError("SailPoint Workflow Execution Failed", detail)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Execution %s of workflow %s completed", executionID, workflowID)})
+	tflog.Info(ctx, "Successfully tested SailPoint Workflow", map[string]any{
+		"workflow_id":  workflowID,
+		"execution_id": executionID,
+	})
+}
@@ -10,11 +10,13 @@ import (
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/client"
 	"github.com/AnasSahel/terraform-provider-sailpoint-isc-community/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -63,6 +65,9 @@ func (r *workflowTriggerResource) Schema(_ context.Context, _ resource.SchemaReq
 			"type": schema.StringAttribute{
 				MarkdownDescription: "The type of trigger. Valid values are `EVENT`, `EXTERNAL`, or `SCHEDULED`.",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.WorkflowTriggerTypes...),
+				},
 			},
 			"display_name": schema.StringAttribute{
 				MarkdownDescription: "The display name of the trigger.",